@@ -12,6 +12,7 @@ import (
 
 type SimVar struct {
 	DefineID   simconnect.DWord
+	RequestID  simconnect.DWord
 	Name, Unit string
 }
 
@@ -47,9 +48,10 @@ func main() {
 	simVars = make([]*SimVar, 0)
 	nameUnitMapping := map[string]string{"AIRSPEED INDICATED": "knot", "INDICATED ALTITUDE": "feet", "PLANE LATITUDE": "degrees", "PLANE LONGITUDE": "degrees"}
 	for name, unit := range nameUnitMapping {
-		defineID := simconnect.NewDefineID()
+		defineID := simConnect.IDs().Define.New()
+		requestID := simConnect.IDs().Request.New()
 		simConnect.AddToDataDefinition(defineID, name, unit, simconnect.DataTypeFloat64)
-		simVars = append(simVars, &SimVar{defineID, name, unit})
+		simVars = append(simVars, &SimVar{defineID, requestID, name, unit})
 	}
 
 	done := make(chan bool, 1)
@@ -93,7 +95,7 @@ func HandleEvents(done chan bool) {
 		case <-reqDataTicker.C:
 			fmt.Println("\nRequesting data...")
 			for _, simVar := range simVars {
-				simConnect.RequestDataOnSimObjectType(simconnect.NewRequestID(), simVar.DefineID, radius, simObjectType)
+				simConnect.RequestDataOnSimObjectType(simVar.RequestID, simVar.DefineID, radius, simObjectType)
 			}
 
 		case <-recvDataTicker.C:
@@ -55,11 +55,13 @@ func (app *App) run() {
 	defer close(app.done)
 
 	app.eventListener = &simconnect.EventListener{
-		OnOpen:      app.OnOpen,
-		OnQuit:      app.OnQuit,
-		OnDataReady: app.OnDataReady,
-		OnEventID:   app.OnEventID,
-		OnException: app.OnException,
+		OnOpen:           app.OnOpen,
+		OnQuit:           app.OnQuit,
+		OnDataReady:      app.OnDataReady,
+		OnEventID:        app.OnEventID,
+		OnError:          app.OnError,
+		OnPause:          app.OnPause,
+		OnAircraftLoaded: app.OnAircraftLoaded,
 	}
 
 	app.mate = simconnect.NewSimMate()
@@ -128,8 +130,20 @@ func (app *App) OnEventID(eventID simconnect.DWord) {
 	fmt.Println("Received event ID", eventID)
 }
 
-func (app *App) OnException(exceptionCode simconnect.DWord) {
-	fmt.Printf("Exception (code: %d)\n", exceptionCode)
+func (app *App) OnPause(paused bool) {
+	if paused {
+		fmt.Println("Paused.")
+	} else {
+		fmt.Println("Unpaused.")
+	}
+}
+
+func (app *App) OnAircraftLoaded(path string) {
+	fmt.Println("Aircraft loaded:", path)
+}
+
+func (app *App) OnError(err *simconnect.SimConnectError) {
+	fmt.Println(err)
 }
 
 func (app *App) OnDataReady() {
@@ -11,11 +11,12 @@ import (
     "time"
     "unsafe"
 
-    "github.com/grumpypixel/msfs2020-simconnect-go/examples/02_simmate/simconnect"
+    "github.com/grumpypixel/msfs2020-simconnect-go/simconnect"
 )
 
 type SimVar struct {
 	DefineID   simconnect.DWord
+	RequestID  simconnect.DWord
 	Name, Unit string
 }
 
@@ -51,9 +52,10 @@ func main() {
 		"COM STANDBY FREQUENCY:2": "MHz",
 	}
 	for name, unit := range nameUnitMapping {
-		defineID := simconnect.NewDefineID()
+		defineID := simConnect.IDs().Define.New()
+		requestID := simConnect.IDs().Request.New()
 		simConnect.AddToDataDefinition(defineID, name, unit, simconnect.DataTypeFloat64)
-		simVars = append(simVars, &SimVar{defineID, name, unit})
+		simVars = append(simVars, &SimVar{defineID, requestID, name, unit})
 	}
 
 	done := make(chan bool, 1)
@@ -73,8 +75,8 @@ func main() {
 		freqHz := uint32(freq * 1000000)
 
         // Map client event to sim event
-        eventID := simconnect.NewEventID()
-        groupID := simconnect.DWord(0) // Use a simple group ID instead of NewGroupID
+        eventID := simConnect.IDs().Event.New()
+        groupID := simConnect.IDs().NotificationGroup.New()
         
         simConnect.MapClientEventToSimEvent(eventID, "COM_STBY_RADIO_SET_HZ")
         simConnect.AddClientEventToNotificationGroup(groupID, eventID, false)
@@ -132,7 +134,7 @@ func HandleEvents(done chan bool) {
 		select {
 		case <-reqDataTicker.C:
 			for _, simVar := range simVars {
-				simConnect.RequestDataOnSimObjectType(simconnect.NewRequestID(), simVar.DefineID, radius, simObjectType)
+				simConnect.RequestDataOnSimObjectType(simVar.RequestID, simVar.DefineID, radius, simObjectType)
 			}
 
 		case <-recvDataTicker.C:
@@ -12,6 +12,7 @@ import (
     "unsafe"
 
     "github.com/grumpypixel/msfs2020-simconnect-go/simconnect"
+    "github.com/grumpypixel/msfs2020-simconnect-go/simconnect/events"
 )
 
 type SimVar struct {
@@ -56,8 +57,8 @@ func main() {
 	
 	// Setup data definitions and subscribe to automatic updates
 	for _, pair := range nameUnitPairs {
-		defineID := simconnect.NewDefineID()
-		requestID := simconnect.NewRequestID()
+		defineID := simConnect.IDs().Define.New()
+		requestID := simConnect.IDs().Request.New()
 		
 		// Add the data definition
 		simConnect.AddToDataDefinition(defineID, pair.name, pair.unit, simconnect.DataTypeFloat64)
@@ -85,7 +86,8 @@ func main() {
 
 	fmt.Println("\n=== Event-Driven Mode (High Frequency) ===")
 	fmt.Println("Data will be automatically pushed from SimConnect every visual frame (~60 FPS).")
-	fmt.Println("No polling required!\n")
+	fmt.Println("No polling required!")
+	fmt.Println()
 
 	// Set COM1 Standby via command line
 	reader := bufio.NewReader(os.Stdin)
@@ -94,31 +96,14 @@ func main() {
 	input = strings.TrimSpace(input)
 	freq, err := strconv.ParseFloat(input, 64)
 	if err == nil {
-		// COM_STBY_RADIO_SET_HZ expects frequency in Hz as a plain integer
-		// Example: 123.450 MHz -> 123450000 Hz
-		freqHz := uint32(freq * 1000000)
-
-        // Map client event to sim event
-        eventID := simconnect.NewEventID()
-        groupID := simconnect.DWord(0) // Use a simple group ID instead of NewGroupID
-        
-        simConnect.MapClientEventToSimEvent(eventID, "COM_STBY_RADIO_SET_HZ")
-        simConnect.AddClientEventToNotificationGroup(groupID, eventID, false)
-        simConnect.SetNotificationGroupPriority(groupID, simconnect.GroupPriorityHighest)
-
-        // Transmit the event with frequency in Hz
-        err := simConnect.TransmitClientEvent(
-            uint32(simconnect.ObjectIDUser),
-            uint32(eventID),
-            simconnect.DWord(freqHz),
-            groupID,
-            simconnect.EventFlagGroupIDIsPriority,
-        )
-        if err != nil {
-            fmt.Printf("Failed to set COM1 Standby: %v\n", err)
-        } else {
-            fmt.Printf("Set COM1 Standby to %.3f MHz (%d Hz)\n", freq, freqHz)
-        }
+		// COM_STBY_RADIO_SET_HZ expects the frequency in Hz: 123.450 MHz -> 123450000 Hz.
+		// Send maps the event on first use and transmits it to the user aircraft.
+		freqHz := freq * 1000000
+		if err := simConnect.Send(events.ComStbyRadioSetHz, freqHz); err != nil {
+			fmt.Printf("Failed to set COM1 Standby: %v\n", err)
+		} else {
+			fmt.Printf("Set COM1 Standby to %.3f MHz (%.0f Hz)\n", freq, freqHz)
+		}
     } else {
         fmt.Println("Invalid frequency input, skipping set.")
     }
@@ -1,6 +1,6 @@
 module github.com/grumpypixel/msfs2020-simconnect-go
 
-go 1.17
+go 1.18
 
 require github.com/sirupsen/logrus v1.8.1
 
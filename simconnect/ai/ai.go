@@ -0,0 +1,262 @@
+// Package ai creates AI objects and keeps track of them: aircraft flying with or without ATC, parked aircraft
+// and other simulated objects. A Manager returns an Object handle for each object once the simulator has assigned
+// its ID, and removes all objects it has created when it is closed or the connection is closed.
+package ai
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/grumpypixel/msfs2020-simconnect-go/simconnect"
+)
+
+type DWord = simconnect.DWord
+
+var ErrRemoved = errors.New("ai: object has been removed")
+
+// State is the state of an Object.
+type State int
+
+const (
+	Active   State = iota // controlled by the simulator's AI
+	Released              // AI control has been released, the client moves the object
+	Removed               // removed by the client or the simulator
+)
+
+func (state State) String() string {
+	switch state {
+	case Active:
+		return "active"
+	case Released:
+		return "released"
+	case Removed:
+		return "removed"
+	}
+	return fmt.Sprintf("State(%d)", int(state))
+}
+
+// Manager creates AI objects on a connection and keeps track of them. It is safe for concurrent use.
+type Manager struct {
+	simco *simconnect.SimConnect
+
+	lock        sync.Mutex
+	objects     map[DWord]*Object // by object ID, removed objects are dropped
+	unhook      []func()
+	unsubscribe func()
+}
+
+// Object is an AI object created by a Manager.
+type Object struct {
+	ID    DWord  // the object ID assigned by the simulator, for RequestDataOnSimObject and friends
+	Title string // the title of the container, e.g. "Airbus A320 Neo Asobo"
+
+	manager *Manager
+	lock    sync.Mutex
+	state   State
+}
+
+// NewManager returns a Manager which creates objects through simco. It removes its objects when simco is closed.
+// When RunSession loses the connection, the simulator has dropped the objects, so they are marked as removed.
+// It subscribes to the "ObjectRemoved" system event, so objects removed by the simulator are marked as such while
+// Run, RunSession or SimMate.Run dispatch messages.
+func NewManager(simco *simconnect.SimConnect) (*Manager, error) {
+	manager := &Manager{
+		simco:   simco,
+		objects: make(map[DWord]*Object),
+	}
+	unsubscribe, err := simco.OnSystemEvent(simconnect.SystemEventObjectRemoved, func(msg simconnect.Message) {
+		if event, ok := msg.(*simconnect.RecvEventObjectAddRemove); ok {
+			manager.ObjectRemoved(event.Data)
+		}
+	})
+	if err != nil {
+		return nil, fmt.Errorf("ai: %w", err)
+	}
+	manager.unsubscribe = unsubscribe
+	closed := simco.OnClose(func() {
+		manager.unsubscribe()
+		manager.removeAll()
+		// The handles are of no use once the connection is closed, even if an object could not be removed.
+		manager.setAllRemoved()
+	})
+	// The IDs of the old connection mean nothing after a reconnect.
+	disconnected := simco.OnDisconnect(func(err error) {
+		manager.setAllRemoved()
+	})
+	manager.unhook = []func(){closed, disconnected}
+	return manager, nil
+}
+
+// CreateNonATCAircraft creates an aircraft which is not under ATC control at initPos.
+// Like the other Create functions, it blocks until the simulator has assigned the object ID or refused to create
+// the object, see SimConnect.CreateAIObject.
+func (manager *Manager) CreateNonATCAircraft(ctx context.Context, title, tailNumber string, initPos simconnect.InitPosition) (*Object, error) {
+	return manager.create(ctx, title, func(requestID DWord) error {
+		return manager.simco.AICreateNonATCAircraft(title, tailNumber, initPos, requestID)
+	})
+}
+
+// CreateParkedATCAircraft creates an aircraft under ATC control which is parked at the airport with the given ICAO.
+func (manager *Manager) CreateParkedATCAircraft(ctx context.Context, title, tailNumber, airportID string) (*Object, error) {
+	return manager.create(ctx, title, func(requestID DWord) error {
+		return manager.simco.AICreateParkedATCAircraft(title, tailNumber, airportID, requestID)
+	})
+}
+
+// CreateEnrouteATCAircraft creates an aircraft under ATC control which flies the flight plan at flightPlanPath,
+// starting at flightPlanPosition (0 is the start of the first leg, 1.5 halfway along the second leg).
+func (manager *Manager) CreateEnrouteATCAircraft(ctx context.Context, title, tailNumber string, flightNumber int, flightPlanPath string, flightPlanPosition float64, touchAndGo bool) (*Object, error) {
+	return manager.create(ctx, title, func(requestID DWord) error {
+		return manager.simco.AICreateEnrouteATCAircraft(title, tailNumber, flightNumber, flightPlanPath, flightPlanPosition, touchAndGo, requestID)
+	})
+}
+
+// CreateSimulatedObject creates an object other than an aircraft, e.g. a vehicle or an animal, at initPos.
+func (manager *Manager) CreateSimulatedObject(ctx context.Context, title string, initPos simconnect.InitPosition) (*Object, error) {
+	return manager.create(ctx, title, func(requestID DWord) error {
+		return manager.simco.AICreateSimulatedObject(title, initPos, requestID)
+	})
+}
+
+func (manager *Manager) create(ctx context.Context, title string, create func(requestID DWord) error) (*Object, error) {
+	objectID, err := manager.simco.CreateAIObject(ctx, create)
+	if err != nil {
+		return nil, fmt.Errorf("ai: creating %q: %w", title, err)
+	}
+	object := &Object{ID: objectID, Title: title, manager: manager}
+	manager.lock.Lock()
+	manager.objects[objectID] = object
+	manager.lock.Unlock()
+	return object, nil
+}
+
+// Object returns the object with the given ID, unless it has been removed.
+func (manager *Manager) Object(objectID DWord) (*Object, bool) {
+	manager.lock.Lock()
+	defer manager.lock.Unlock()
+	object, ok := manager.objects[objectID]
+	return object, ok
+}
+
+// Objects returns the objects which have not been removed, ordered by ID.
+func (manager *Manager) Objects() []*Object {
+	manager.lock.Lock()
+	objects := make([]*Object, 0, len(manager.objects))
+	for _, object := range manager.objects {
+		objects = append(objects, object)
+	}
+	manager.lock.Unlock()
+	sort.Slice(objects, func(i, j int) bool {
+		return objects[i].ID < objects[j].ID
+	})
+	return objects
+}
+
+// ObjectRemoved marks an object as removed by the simulator, e.g. after a crash. The Manager calls it for the
+// "ObjectRemoved" system event. Objects the Manager did not create are ignored.
+func (manager *Manager) ObjectRemoved(objectID DWord) {
+	if object, ok := manager.Object(objectID); ok {
+		object.setRemoved()
+	}
+}
+
+// Close removes all objects and detaches the Manager from the connection.
+func (manager *Manager) Close() error {
+	for _, unhook := range manager.unhook {
+		unhook()
+	}
+	manager.unsubscribe()
+	return manager.removeAll()
+}
+
+func (manager *Manager) removeAll() error {
+	var err error
+	for _, object := range manager.Objects() {
+		if e := object.Remove(); e != nil && err == nil {
+			err = e
+		}
+	}
+	return err
+}
+
+func (manager *Manager) setAllRemoved() {
+	for _, object := range manager.Objects() {
+		object.setRemoved()
+	}
+}
+
+// State returns the state of the object.
+func (object *Object) State() State {
+	object.lock.Lock()
+	defer object.lock.Unlock()
+	return object.state
+}
+
+// Remove removes the object from the simulation.
+func (object *Object) Remove() error {
+	object.lock.Lock()
+	if object.state == Removed {
+		object.lock.Unlock()
+		return ErrRemoved
+	}
+	if err := object.call(object.manager.simco.AIRemoveObject); err != nil {
+		object.lock.Unlock()
+		return err
+	}
+	object.state = Removed
+	object.lock.Unlock()
+	object.manager.forget(object)
+	return nil
+}
+
+// ReleaseControl releases the object from AI control, so that the client can move it with SetDataOnSimObject.
+func (object *Object) ReleaseControl() error {
+	object.lock.Lock()
+	defer object.lock.Unlock()
+	if object.state == Removed {
+		return ErrRemoved
+	}
+	if err := object.call(object.manager.simco.AIReleaseControl); err != nil {
+		return err
+	}
+	object.state = Released
+	return nil
+}
+
+// SetFlightPlan sets or changes the flight plan of an AI controlled aircraft. flightPlanPath is the path of the
+// .pln file without the extension.
+func (object *Object) SetFlightPlan(flightPlanPath string) error {
+	if object.State() == Removed {
+		return ErrRemoved
+	}
+	return object.call(func(objectID, requestID DWord) error {
+		return object.manager.simco.AISetAircraftFlightPlan(objectID, requestID, flightPlanPath)
+	})
+}
+
+// call makes an AI call for the object. The simulator does not answer these calls,
+// so the request ID is only needed for the call itself.
+func (object *Object) call(aiCall func(objectID, requestID DWord) error) error {
+	ids := object.manager.simco.IDs()
+	requestID := ids.Request.New()
+	defer ids.Request.Release(requestID)
+	return aiCall(object.ID, requestID)
+}
+
+func (object *Object) setRemoved() {
+	object.lock.Lock()
+	object.state = Removed
+	object.lock.Unlock()
+	object.manager.forget(object)
+}
+
+func (manager *Manager) forget(object *Object) {
+	manager.lock.Lock()
+	defer manager.lock.Unlock()
+	if manager.objects[object.ID] == object {
+		delete(manager.objects, object.ID)
+	}
+}
@@ -0,0 +1,271 @@
+package ai_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/grumpypixel/msfs2020-simconnect-go/simconnect"
+	"github.com/grumpypixel/msfs2020-simconnect-go/simconnect/ai"
+	"github.com/grumpypixel/msfs2020-simconnect-go/simconnect/simconnecttest"
+)
+
+const testTimeout = 2 * time.Second
+
+// openManager connects to a fake simulator, dispatches its messages until the test ends and returns a Manager on it.
+func openManager(t *testing.T) (*ai.Manager, *simconnect.SimConnect, *simconnecttest.Sim) {
+	t.Helper()
+	sim := simconnecttest.NewSim()
+	simco := simconnect.NewSimConnectWithTransport(sim)
+	if err := simco.Open("AI Test"); err != nil {
+		t.Fatal(err)
+	}
+	manager, err := ai.NewManager(simco)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		simco.Run(ctx, nil)
+	}()
+	t.Cleanup(func() {
+		cancel()
+		<-done
+		simco.Close()
+	})
+	return manager, simco, sim
+}
+
+func withTimeout(t *testing.T) context.Context {
+	ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
+	t.Cleanup(cancel)
+	return ctx
+}
+
+// eventually fails the test unless condition holds within testTimeout.
+func eventually(t *testing.T, what string, condition func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(testTimeout)
+	for !condition() {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for %s", what)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestCreate(t *testing.T) {
+	manager, _, sim := openManager(t)
+	ctx := withTimeout(t)
+	initPos := simconnect.InitPosition{Latitude: 47.45, Longitude: -122.31, Altitude: 1000}
+
+	cessna, err := manager.CreateNonATCAircraft(ctx, "Cessna 152 Asobo", "N152AI", initPos)
+	if err != nil {
+		t.Fatal(err)
+	}
+	parked, err := manager.CreateParkedATCAircraft(ctx, "Airbus A320 Neo Asobo", "DLH123", "KSEA")
+	if err != nil {
+		t.Fatal(err)
+	}
+	enroute, err := manager.CreateEnrouteATCAircraft(ctx, "Boeing 747-8i Asobo", "N747AI", 42, "plans/KSEA-KPDX", 0.5, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	truck, err := manager.CreateSimulatedObject(ctx, "ASO_Fuel_Truck", initPos)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []simconnecttest.AIObject{
+		{ObjectID: cessna.ID, ProcName: "SimConnect_AICreateNonATCAircraft", ObjectType: simconnect.SimObjectTypeAircraft, Title: "Cessna 152 Asobo", TailNumber: "N152AI"},
+		{ObjectID: parked.ID, ProcName: "SimConnect_AICreateParkedATCAircraft", ObjectType: simconnect.SimObjectTypeAircraft, Title: "Airbus A320 Neo Asobo", TailNumber: "DLH123", AirportID: "KSEA"},
+		{ObjectID: enroute.ID, ProcName: "SimConnect_AICreateEnrouteATCAircraft", ObjectType: simconnect.SimObjectTypeAircraft, Title: "Boeing 747-8i Asobo", TailNumber: "N747AI", FlightPlan: "plans/KSEA-KPDX"},
+		{ObjectID: truck.ID, ProcName: "SimConnect_AICreateSimulatedObject", ObjectType: simconnect.SimObjectTypeGround, Title: "ASO_Fuel_Truck"},
+	}
+	got := sim.AIObjects()
+	if len(got) != len(want) {
+		t.Fatalf("got %+v", got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("object %d: got %+v, want %+v", i, got[i], want[i])
+		}
+	}
+	if cessna.ID != simconnecttest.FirstAIObjectID || cessna.Title != "Cessna 152 Asobo" || cessna.State() != ai.Active {
+		t.Errorf("cessna: got %d %q %s", cessna.ID, cessna.Title, cessna.State())
+	}
+	if objects := manager.Objects(); len(objects) != 4 || objects[0] != cessna || objects[3] != truck {
+		t.Errorf("Objects: got %v", objects)
+	}
+	if object, ok := manager.Object(parked.ID); !ok || object != parked {
+		t.Errorf("Object: got %v, %v", object, ok)
+	}
+}
+
+func TestCreateFails(t *testing.T) {
+	manager, _, sim := openManager(t)
+	_, err := manager.CreateSimulatedObject(withTimeout(t), "", simconnect.InitPosition{})
+	var simErr *simconnect.SimConnectError
+	if !errors.As(err, &simErr) || simErr.Exception != simconnect.ExceptionCreateObjectFailed {
+		t.Fatalf("got %v, want CREATE_OBJECT_FAILED", err)
+	}
+	if simErr.ProcName != "SimConnect_AICreateSimulatedObject" {
+		t.Errorf("the exception is not resolved to the call: %+v", simErr)
+	}
+	if len(manager.Objects()) != 0 || len(sim.AIObjects()) != 0 {
+		t.Error("a failed creation left an object behind")
+	}
+}
+
+func TestObject(t *testing.T) {
+	manager, _, sim := openManager(t)
+	object, err := manager.CreateParkedATCAircraft(withTimeout(t), "Airbus A320 Neo Asobo", "DLH123", "KSEA")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := object.SetFlightPlan("plans/KSEA-KPDX"); err != nil {
+		t.Fatal(err)
+	}
+	if err := object.ReleaseControl(); err != nil {
+		t.Fatal(err)
+	}
+	if object.State() != ai.Released {
+		t.Errorf("state: got %s", object.State())
+	}
+	if got := sim.AIObjects()[0]; got.FlightPlan != "plans/KSEA-KPDX" || !got.Released {
+		t.Errorf("got %+v", got)
+	}
+
+	if err := object.Remove(); err != nil {
+		t.Fatal(err)
+	}
+	if object.State() != ai.Removed || len(sim.AIObjects()) != 0 || len(manager.Objects()) != 0 {
+		t.Error("the object has not been removed")
+	}
+	if err := object.Remove(); !errors.Is(err, ai.ErrRemoved) {
+		t.Errorf("Remove: got %v, want ErrRemoved", err)
+	}
+	if err := object.ReleaseControl(); !errors.Is(err, ai.ErrRemoved) {
+		t.Errorf("ReleaseControl: got %v, want ErrRemoved", err)
+	}
+	if err := object.SetFlightPlan("plans/KPDX-KSEA"); !errors.Is(err, ai.ErrRemoved) {
+		t.Errorf("SetFlightPlan: got %v, want ErrRemoved", err)
+	}
+}
+
+func TestObjectRemovedBySimulator(t *testing.T) {
+	manager, _, sim := openManager(t)
+	if calls := sim.CallsTo("SimConnect_SubscribeToSystemEvent"); len(calls) != 1 || calls[0].Args[1] != simconnect.SystemEventObjectRemoved {
+		t.Fatalf("NewManager did not subscribe to ObjectRemoved: %+v", calls)
+	}
+	ctx := withTimeout(t)
+	crashed, err := manager.CreateNonATCAircraft(ctx, "Cessna 152 Asobo", "N152AI", simconnect.InitPosition{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, err := manager.CreateNonATCAircraft(ctx, "Cessna 152 Asobo", "N153AI", simconnect.InitPosition{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sim.RemoveAIObject(crashed.ID)
+	eventually(t, "the object to be removed", func() bool { return crashed.State() == ai.Removed })
+	if objects := manager.Objects(); len(objects) != 1 || objects[0] != other {
+		t.Errorf("Objects: got %v", objects)
+	}
+	if other.State() != ai.Active {
+		t.Errorf("other: got %s", other.State())
+	}
+}
+
+func TestClose(t *testing.T) {
+	manager, _, sim := openManager(t)
+	ctx := withTimeout(t)
+	cessna, err := manager.CreateNonATCAircraft(ctx, "Cessna 152 Asobo", "N152AI", simconnect.InitPosition{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	truck, err := manager.CreateSimulatedObject(ctx, "ASO_Fuel_Truck", simconnect.InitPosition{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := manager.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if len(sim.AIObjects()) != 0 || cessna.State() != ai.Removed || truck.State() != ai.Removed {
+		t.Errorf("Close left %+v", sim.AIObjects())
+	}
+	if len(sim.CallsTo("SimConnect_AIRemoveObject")) != 2 {
+		t.Error("each object is removed once")
+	}
+}
+
+func TestCloseConnection(t *testing.T) {
+	manager, simco, sim := openManager(t)
+	object, err := manager.CreateNonATCAircraft(withTimeout(t), "Cessna 152 Asobo", "N152AI", simconnect.InitPosition{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := simco.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if len(sim.AIObjects()) != 0 || object.State() != ai.Removed || len(manager.Objects()) != 0 {
+		t.Errorf("closing the connection left %+v", sim.AIObjects())
+	}
+}
+
+func TestDisconnect(t *testing.T) {
+	sim := simconnecttest.NewSim()
+	simco := simconnect.NewSimConnectWithTransport(sim)
+	if err := simco.Open("AI Test"); err != nil {
+		t.Fatal(err)
+	}
+	manager, err := ai.NewManager(simco)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	reconnected := make(chan struct{}, 1)
+	session := &simconnect.Session{
+		Name:        "AI Test",
+		MinBackoff:  time.Millisecond,
+		MaxBackoff:  time.Millisecond,
+		OnReconnect: func() { reconnected <- struct{}{} },
+	}
+	go func() {
+		defer close(done)
+		simco.RunSession(ctx, session, nil)
+	}()
+	t.Cleanup(func() {
+		cancel()
+		<-done
+		simco.Close()
+	})
+
+	object, err := manager.CreateNonATCAircraft(withTimeout(t), "Cessna 152 Asobo", "N152AI", simconnect.InitPosition{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sim.SetOffline(true)
+	eventually(t, "the object to be removed", func() bool { return object.State() == ai.Removed })
+	if len(manager.Objects()) != 0 {
+		t.Errorf("the manager kept %+v", manager.Objects())
+	}
+	sim.SetOffline(false)
+	select {
+	case <-reconnected:
+	case <-time.After(testTimeout):
+		t.Fatal("no reconnect")
+	}
+	if err := object.Remove(); !errors.Is(err, ai.ErrRemoved) {
+		t.Errorf("Remove after the reconnect: got %v, want ErrRemoved", err)
+	}
+	if calls := sim.CallsTo("SimConnect_AIRemoveObject"); len(calls) != 0 {
+		t.Errorf("the stale object ID is removed: %+v", calls)
+	}
+}
@@ -1,7 +1,7 @@
 package simconnect
 
 import (
-	"syscall"
+	"fmt"
 	"unsafe"
 )
 
@@ -16,28 +16,20 @@ func (simco *SimConnect) Open(name string) error {
 	// 	HANDLE hEventHandle,
 	// 	DWORD ConfigIndex)
 
-	const hwnd DWord = 0
-	const userEventWin32 = WmUserSimConnect
-	const eventHandle DWord = 0
 	const configIndex DWord = 0 // TODO: make this a function parameter
 
-	var namePtr *uint16
-	namePtr, namePtrErr := syscall.UTF16PtrFromString(name)
-	if namePtrErr != nil {
-		return namePtrErr
+	if simco.transport == nil {
+		return ErrNoTransport
 	}
-
-	args := []uintptr{
-		uintptr(unsafe.Pointer(&simco.handle)),
-		uintptr(unsafe.Pointer(namePtr)),
-		uintptr(hwnd),
-		uintptr(userEventWin32),
-		uintptr(eventHandle),
-		uintptr(configIndex),
-	}
-	err := callProc(scOpen, args...)
+	err := simco.transport.Open(name, configIndex)
 	if err == nil {
-		simco.connected = true
+		simco.setConnected(true)
+		// Data definitions and event mappings do not survive the connection they were made on.
+		simco.resetDataDefinitions()
+		simco.resetClientEvents()
+		simco.resetSystemEvents()
+		simco.journal.reset()
+		simco.sent.reset()
 	}
 	return err
 }
@@ -48,12 +40,14 @@ func (simco *SimConnect) Close() error {
 	// SimConnect_Close(
 	//  HANDLE hSimConnect)
 
-	args := []uintptr{
-		uintptr(simco.handle),
+	if simco.transport == nil {
+		return ErrNoTransport
 	}
-	err := callProc(scClose, args...)
+	simco.runCloseHooks()
+	err := simco.transport.Close()
 	if err == nil {
-		simco.connected = false
+		simco.setConnected(false)
+		simco.failPending(ErrDisconnected)
 	}
 	return err
 }
@@ -70,14 +64,17 @@ func (simco *SimConnect) GetNextDispatch() (unsafe.Pointer, int32, error) {
 	// 	SIMCONNECT_RECV ** ppData,
 	// 	DWORD * pcbData)
 
-	var ppData unsafe.Pointer
-	var ppDataLength DWord
-	r1, _, err := procs[scGetNextDispatch].Call(
-		uintptr(simco.handle),
-		uintptr(unsafe.Pointer(&ppData)),
-		uintptr(unsafe.Pointer(&ppDataLength)),
-	)
-	return ppData, int32(r1), err
+	if simco.transport == nil {
+		return nil, toHResult(EUnexpected), ErrNoTransport
+	}
+	ppData, _, err := simco.transport.GetNextDispatch()
+	if err != nil {
+		return nil, hresultOf(err), err
+	}
+	if ppData == nil {
+		return nil, toHResult(EFail), nil
+	}
+	return ppData, 0, nil
 }
 
 // SimConnect_RequestSystemState: Used to request information from a number of Flight Simulator system components.
@@ -88,12 +85,11 @@ func (simco *SimConnect) RequestSystemState(requestID DWord, state string) error
 	//  SIMCONNECT_DATA_REQUEST_ID RequestID,
 	//  const char * szState)
 
-	args := []uintptr{
-		uintptr(simco.handle),
-		uintptr(requestID),
-		uintptr(toCharPtr(state)),
+	args := []interface{}{
+		requestID,
+		state,
 	}
-	return callProc(scRequestSystemState, args...)
+	return simco.call(scRequestSystemState, args...)
 }
 
 // SimConnect_MapClientEventToSimEvent: Used to associate a client defined event ID with a Flight Simulator event name.
@@ -104,12 +100,11 @@ func (simco *SimConnect) MapClientEventToSimEvent(eventID DWord, eventName strin
 	//  SIMCONNECT_CLIENT_EVENT_ID EventID,
 	//  const char * EventName = "")
 
-	args := []uintptr{
-		uintptr(simco.handle),
-		uintptr(eventID),
-		toCharPtr(eventName),
+	args := []interface{}{
+		eventID,
+		eventName,
 	}
-	return callProc(scMapClientEventToSimEvent, args...)
+	return simco.call(scMapClientEventToSimEvent, args...)
 }
 
 // SimConnect_SubscribeToSystemEvent: Used to request that a specific system event is notified to the client.
@@ -120,12 +115,11 @@ func (simco *SimConnect) SubscribeToSystemEvent(eventID DWord, systemEventName s
 	//  SIMCONNECT_CLIENT_EVENT_ID EventID,
 	//  const char * SystemEventName)
 
-	args := []uintptr{
-		uintptr(simco.handle),
-		uintptr(eventID),
-		toCharPtr(systemEventName),
+	args := []interface{}{
+		eventID,
+		systemEventName,
 	}
-	return callProc(scSubscribeToSystemEvent, args...)
+	return simco.call(scSubscribeToSystemEvent, args...)
 }
 
 // SimConnect_SetSystemEventState: Used to turn requests for event information from the server on and off.
@@ -136,12 +130,11 @@ func (simco *SimConnect) SetSystemEventState(eventID, state DWord) error {
 	//  SIMCONNECT_CLIENT_EVENT_ID EventID,
 	//  SIMCONNECT_STATE dwState)
 
-	args := []uintptr{
-		uintptr(simco.handle),
-		uintptr(eventID),
-		uintptr(state),
+	args := []interface{}{
+		eventID,
+		state,
 	}
-	return callProc(scSetSystemEventState, args...)
+	return simco.call(scSetSystemEventState, args...)
 }
 
 // SimConnect_UnsubscribeFromSystemEvent: Used to request that notifications are no longer received for the specified system event.
@@ -151,11 +144,10 @@ func (simco *SimConnect) UnsubscribeFromSystemEvent(eventID DWord) error {
 	//  HANDLE hSimConnect,
 	//  SIMCONNECT_CLIENT_EVENT_ID EventID)
 
-	args := []uintptr{
-		uintptr(simco.handle),
-		uintptr(eventID),
+	args := []interface{}{
+		eventID,
 	}
-	return callProc(scUnsubscribeFromSystemEvent, args...)
+	return simco.call(scUnsubscribeFromSystemEvent, args...)
 }
 
 // SimConnect_SetNotificationGroupPriority: Used to set the priority of a notification group.
@@ -166,12 +158,11 @@ func (simco *SimConnect) SetNotificationGroupPriority(groupID, priority DWord) e
 	//  SIMCONNECT_NOTIFICATION_GROUP_ID GroupID,
 	//  DWORD uPriority)
 
-	args := []uintptr{
-		uintptr(simco.handle),
-		uintptr(groupID),
-		uintptr(priority),
+	args := []interface{}{
+		groupID,
+		priority,
 	}
-	return callProc(scSetNotificationGroupPriority, args...)
+	return simco.call(scSetNotificationGroupPriority, args...)
 }
 
 // SimConnect_Text: Displays text to the user. (This function is not currently available for use.)
@@ -185,16 +176,15 @@ func (simco *SimConnect) Text(text string, textType DWord, timeSeconds float32,
 	// 	DWORD cbUnitSize,
 	// 	void * pDataSet)
 
-	size := len(text)
-	args := []uintptr{
-		uintptr(simco.handle),
-		uintptr(textType),
-		uintptr(timeSeconds),
-		uintptr(eventID),
-		uintptr(DWord(size)),
-		toCharPtr(text),
+	data := toNullTerminatedBytes(text)
+	args := []interface{}{
+		textType,
+		timeSeconds,
+		eventID,
+		DWord(len(data)),
+		data,
 	}
-	return callProc(scText, args...)
+	return simco.call(scText, args...)
 }
 
 // Event And Data functions:
@@ -202,6 +192,13 @@ func (simco *SimConnect) Text(text string, textType DWord, timeSeconds float32,
 // SimConnect_RequestDataOnSimObject: Used to request when the SimConnect client is to receive data values for a specific object.
 // https://docs.flightsimulator.com/html/Programming_Tools/SimConnect/API_Reference/Events_And_Data/SimConnect_RequestDataOnSimObject.htm
 func (simco *SimConnect) RequestDataOnSimObject(requestID, defineID, objectID, period, flags DWord) error {
+	return simco.RequestDataOnSimObjectEx(requestID, defineID, objectID, period, flags, 0, 0, 0)
+}
+
+// RequestDataOnSimObjectEx is RequestDataOnSimObject with the optional parameters of SimConnect_RequestDataOnSimObject:
+// origin is the number of periods to wait before the first transmission, interval the number of periods
+// between two transmissions, and limit the number of transmissions after which the request ends (0 for no limit).
+func (simco *SimConnect) RequestDataOnSimObjectEx(requestID, defineID, objectID, period, flags, origin, interval, limit DWord) error {
 	// SimConnect_RequestDataOnSimObject(
 	//  HANDLE hSimConnect,
 	//  SIMCONNECT_DATA_REQUEST_ID RequestID,
@@ -213,22 +210,17 @@ func (simco *SimConnect) RequestDataOnSimObject(requestID, defineID, objectID, p
 	//  DWORD interval = 0,
 	//  DWORD limit = 0)
 
-	const origin DWord = 0
-	const interval DWord = 0
-	const limit DWord = 0
-
-	args := []uintptr{
-		uintptr(simco.handle),
-		uintptr(requestID),
-		uintptr(defineID),
-		uintptr(objectID),
-		uintptr(period),
-		uintptr(flags),
-		uintptr(origin),
-		uintptr(interval),
-		uintptr(limit),
+	args := []interface{}{
+		requestID,
+		defineID,
+		objectID,
+		period,
+		flags,
+		origin,
+		interval,
+		limit,
 	}
-	return callProc(scRequestDataOnSimObject, args...)
+	return simco.call(scRequestDataOnSimObject, args...)
 }
 
 // SimConnect_RequestDataOnSimObjectType: Used to retrieve information about simulation objects of a given type that are within a specified radius of the user's aircraft.
@@ -241,14 +233,13 @@ func (simco *SimConnect) RequestDataOnSimObjectType(requestID, defineID, radius,
 	// 	DWORD dwRadiusMeters,
 	// 	SIMCONNECT_SIMOBJECT_TYPE type)
 
-	args := []uintptr{
-		uintptr(simco.handle),
-		uintptr(requestID),
-		uintptr(defineID),
-		uintptr(radius),
-		uintptr(simobjectType),
+	args := []interface{}{
+		requestID,
+		defineID,
+		radius,
+		simobjectType,
 	}
-	return callProc(scRequestDataOnSimObjectType, args...)
+	return simco.call(scRequestDataOnSimObjectType, args...)
 }
 
 // SimConnect_AddClientEventToNotificationGroup: Used to add an individual client defined event to a notification group.
@@ -260,13 +251,12 @@ func (simco *SimConnect) AddClientEventToNotificationGroup(groupID, eventID DWor
 	//  SIMCONNECT_CLIENT_EVENT_ID EventID,
 	//  BOOL bMaskable = FALSE)
 
-	args := []uintptr{
-		uintptr(simco.handle),
-		uintptr(groupID),
-		uintptr(eventID),
-		uintptr(toBoolPtr(maskable)),
+	args := []interface{}{
+		groupID,
+		eventID,
+		maskable,
 	}
-	return callProc(scAddClientEventToNotificationGroup, args...)
+	return simco.call(scAddClientEventToNotificationGroup, args...)
 }
 
 // SimConnect_RemoveClientEvent: Used to remove a client defined event from a notification group.
@@ -277,12 +267,11 @@ func (simco *SimConnect) RemoveClientEvent(groupID, eventID DWord) error {
 	//  SIMCONNECT_NOTIFICATION_GROUP_ID GroupID,
 	//  SIMCONNECT_CLIENT_EVENT_ID EventID)
 
-	args := []uintptr{
-		uintptr(simco.handle),
-		uintptr(groupID),
-		uintptr(eventID),
+	args := []interface{}{
+		groupID,
+		eventID,
 	}
-	return callProc(scRemoveClientEvent, args...)
+	return simco.call(scRemoveClientEvent, args...)
 }
 
 // SimConnect_TransmitClientEvent: Used to request that the Flight Simulator server transmit to all SimConnect clients the specified client event.
@@ -296,15 +285,49 @@ func (simco *SimConnect) TransmitClientEvent(objectID uint32, eventID uint32, da
 	//  SIMCONNECT_NOTIFICATION_GROUP_ID GroupID,
 	//  SIMCONNECT_EVENT_FLAG Flags)
 
-	args := []uintptr{
-		uintptr(simco.handle),
-		uintptr(objectID),
-		uintptr(eventID),
-		uintptr(data),
-		uintptr(groupID),
-		uintptr(flags),
+	args := []interface{}{
+		DWord(objectID),
+		DWord(eventID),
+		data,
+		groupID,
+		flags,
 	}
-	return callProc(scTransmitClientEvent, args...)
+	return simco.call(scTransmitClientEvent, args...)
+}
+
+// SimConnect_TransmitClientEvent_EX1: Used to request that the Flight Simulator server transmit to all SimConnect clients the specified client event,
+// with up to five parameters. Missing parameters are sent as 0.
+// https://docs.flightsimulator.com/html/Programming_Tools/SimConnect/API_Reference/Events_And_Data/SimConnect_TransmitClientEvent_EX1.htm
+func (simco *SimConnect) TransmitClientEventEx1(objectID, eventID, groupID, flags DWord, data ...DWord) error {
+	// SimConnect_TransmitClientEvent_EX1(
+	//  HANDLE hSimConnect,
+	//  SIMCONNECT_OBJECT_ID ObjectID,
+	//  SIMCONNECT_CLIENT_EVENT_ID EventID,
+	//  SIMCONNECT_NOTIFICATION_GROUP_ID GroupID,
+	//  SIMCONNECT_EVENT_FLAG Flags,
+	//  DWORD dwData0,
+	//  DWORD dwData1,
+	//  DWORD dwData2,
+	//  DWORD dwData3,
+	//  DWORD dwData4)
+
+	if len(data) > 5 {
+		return fmt.Errorf("%s: at most 5 parameters, got %d", scTransmitClientEventEx1, len(data))
+	}
+	args := []interface{}{
+		objectID,
+		eventID,
+		groupID,
+		flags,
+	}
+	for i := 0; i < 5; i++ {
+		var value DWord
+		if i < len(data) {
+			value = data[i]
+		}
+		args = append(args, value)
+	}
+	return simco.call(scTransmitClientEventEx1, args...)
 }
 
 // SimConnect_MapClientDataNameToID: Used to associate an ID with a named client date area.
@@ -315,12 +338,11 @@ func (simco *SimConnect) MapClientDataNameToID(clientDataName string, clientData
 	//  const char * szClientDataName,
 	//  SIMCONNECT_CLIENT_DATA_ID ClientDataID)
 
-	args := []uintptr{
-		uintptr(simco.handle),
-		toCharPtr(clientDataName),
-		uintptr(clientDataID),
+	args := []interface{}{
+		clientDataName,
+		clientDataID,
 	}
-	return callProc(scMapClientDataNameToID, args...)
+	return simco.call(scMapClientDataNameToID, args...)
 }
 
 // SimConnect_RequestClientData: Used to request that the data in an area created by another client be sent to this client.
@@ -341,18 +363,17 @@ func (simco *SimConnect) RequestClientData(clientDataID, requestID, defineID, pe
 	const interval DWord = 0
 	const limit DWord = 0
 
-	args := []uintptr{
-		uintptr(simco.handle),
-		uintptr(clientDataID),
-		uintptr(requestID),
-		uintptr(defineID),
-		uintptr(period),
-		uintptr(flags),
-		uintptr(origin),
-		uintptr(interval),
-		uintptr(limit),
+	args := []interface{}{
+		clientDataID,
+		requestID,
+		defineID,
+		period,
+		flags,
+		origin,
+		interval,
+		limit,
 	}
-	return callProc(scRequestClientData, args...)
+	return simco.call(scRequestClientData, args...)
 }
 
 // SimConnect_CreateClientData: Used to request the creation of a reserved data area for this client.
@@ -364,13 +385,15 @@ func (simco *SimConnect) CreateClientData(clientDataID, size, flags DWord) error
 	//  DWORD dwSize,
 	//  SIMCONNECT_CREATE_CLIENT_DATA_FLAG Flags)
 
-	args := []uintptr{
-		uintptr(simco.handle),
-		uintptr(clientDataID),
-		uintptr(size),
-		uintptr(flags),
+	if size > ClientDataMaxSize {
+		return fmt.Errorf("%w: %d bytes", ErrClientDataTooLarge, size)
+	}
+	args := []interface{}{
+		clientDataID,
+		size,
+		flags,
 	}
-	return callProc(scCreateClientData, args...)
+	return simco.call(scCreateClientData, args...)
 }
 
 // SimConnect_AddToClientDataDefinition: Used to add an offset and a size in bytes, or a type, to a client data definition.
@@ -387,15 +410,18 @@ func (simco *SimConnect) AddToClientDataDefinition(defineID, offset, sizeOrType
 	const epsilon float32 = 0
 	const datumID = Unused
 
-	args := []uintptr{
-		uintptr(simco.handle),
-		uintptr(defineID),
-		uintptr(offset),
-		uintptr(sizeOrType),
-		uintptr(epsilon),
-		uintptr(datumID),
+	return simco.addToClientDataDefinition(defineID, offset, sizeOrType, epsilon, datumID)
+}
+
+func (simco *SimConnect) addToClientDataDefinition(defineID, offset, sizeOrType DWord, epsilon float32, datumID DWord) error {
+	args := []interface{}{
+		defineID,
+		offset,
+		sizeOrType,
+		epsilon,
+		datumID,
 	}
-	return callProc(scAddToClientDataDefinition, args...)
+	return simco.call(scAddToClientDataDefinition, args...)
 }
 
 // SimConnect_AddToDataDefinition: Used to add a Flight Simulator simulation variable name to a client defined object definition.
@@ -410,24 +436,33 @@ func (simco *SimConnect) AddToDataDefinition(defineID DWord, datumName string, u
 	// 	float fEpsilon = 0,
 	// 	DWORD DatumID = SIMCONNECT_UNUSED)
 
-	var unitArg uintptr = 0
-	if len(unitName) > 0 {
-		unitArg = toCharPtr(unitName)
-	}
-
 	const epsilon float32 = 0
 	const datumID = Unused
 
-	args := []uintptr{
-		uintptr(simco.handle),
-		uintptr(defineID),
-		toCharPtr(datumName),
+	return simco.addToDataDefinition(defineID, datumName, unitName, datumType, epsilon, datumID)
+}
+
+func (simco *SimConnect) addToDataDefinition(defineID DWord, datumName string, unitName string, datumType DWord, epsilon float32, datumID DWord) error {
+	_, err := simco.addDatum(false, defineID, datumName, unitName, datumType, epsilon, datumID)
+	return err
+}
+
+// addDatum calls SimConnect_AddToDataDefinition and returns the packet ID of the call if needID is set.
+func (simco *SimConnect) addDatum(needID bool, defineID DWord, datumName string, unitName string, datumType DWord, epsilon float32, datumID DWord) (DWord, error) {
+	var unitArg interface{}
+	if len(unitName) > 0 {
+		unitArg = unitName
+	}
+
+	args := []interface{}{
+		defineID,
+		datumName,
 		unitArg,
-		uintptr(datumType),
-		uintptr(epsilon),
-		uintptr(datumID),
+		datumType,
+		epsilon,
+		datumID,
 	}
-	return callProc(scAddToDataDefinition, args...)
+	return simco.makeCall(scAddToDataDefinition, needID, args)
 }
 
 // SimConnect_SetClientData: Used to write one or more units of data to a client data area.
@@ -443,16 +478,15 @@ func (simco *SimConnect) SetClientData(clientDataID, defineID, flags DWord, unit
 	//  void * pDataSet)
 
 	const reserved DWord = 0
-	args := []uintptr{
-		uintptr(simco.handle),
-		uintptr(clientDataID),
-		uintptr(defineID),
-		uintptr(flags),
-		uintptr(reserved),
-		uintptr(unitSize),
-		uintptr(buf),
+	args := []interface{}{
+		clientDataID,
+		defineID,
+		flags,
+		reserved,
+		unitSize,
+		unsafe.Slice((*byte)(buf), unitSize),
 	}
-	return callProc(scSetClientData, args...)
+	return simco.call(scSetClientData, args...)
 
 }
 
@@ -468,16 +502,19 @@ func (simco *SimConnect) SetDataOnSimObject(defineID, objectID, flags, arrayCoun
 	// 	DWORD cbUnitSize,
 	// 	void * pDataSet)
 
-	args := []uintptr{
-		uintptr(simco.handle),
-		uintptr(defineID),
-		uintptr(objectID),
-		uintptr(flags),
-		uintptr(arrayCount),
-		uintptr(unitSize),
-		uintptr(buf),
+	count := arrayCount
+	if count == 0 {
+		count = 1
 	}
-	return callProc(scSetDataOnSimObject, args...)
+	args := []interface{}{
+		defineID,
+		objectID,
+		flags,
+		arrayCount,
+		unitSize,
+		unsafe.Slice((*byte)(buf), count*unitSize),
+	}
+	return simco.call(scSetDataOnSimObject, args...)
 }
 
 // SimConnect_ClearClientDataDefinition: Used to clear the definition of the specified client data.
@@ -487,11 +524,10 @@ func (simco *SimConnect) ClearClientDataDefinition(defineID DWord) error {
 	//  HANDLE hSimConnect,
 	//  SIMCONNECT_CLIENT_DATA_DEFINITION_ID DefineID)
 
-	args := []uintptr{
-		uintptr(simco.handle),
-		uintptr(defineID),
+	args := []interface{}{
+		defineID,
 	}
-	return callProc(scClearClientDataDefinition, args...)
+	return simco.call(scClearClientDataDefinition, args...)
 }
 
 // SimConnect_ClearDataDefinition: Used to remove all simulation variables from a client defined object.
@@ -501,11 +537,10 @@ func (simco *SimConnect) ClearDataDefinition(defineID DWord) error {
 	// 	HANDLE hSimConnect,
 	// 	SIMCONNECT_DATA_DEFINITION_ID DefineID)
 
-	args := []uintptr{
-		uintptr(simco.handle),
-		uintptr(defineID),
+	args := []interface{}{
+		defineID,
 	}
-	return callProc(scClearDataDefinition, args...)
+	return simco.call(scClearDataDefinition, args...)
 }
 
 // SimConnect_MapInputEventToClientEvent: Used to connect input events (such as keystrokes, joystick or mouse movements) with the sending of appropriate event notifications.
@@ -526,17 +561,16 @@ func (simco *SimConnect) MapInputEventToClientEvent(groupID DWord, inputDefiniti
 	const upValue DWord = 0
 	const maskable = false
 
-	args := []uintptr{
-		uintptr(simco.handle),
-		uintptr(groupID),
-		toCharPtr(inputDefinition),
-		uintptr(downEventID),
-		uintptr(downValue),
-		uintptr(upEventID),
-		uintptr(upValue),
-		toBoolPtr(maskable),
+	args := []interface{}{
+		groupID,
+		inputDefinition,
+		downEventID,
+		downValue,
+		upEventID,
+		upValue,
+		maskable,
 	}
-	return callProc(scMapInputEventToClientEvent, args...)
+	return simco.call(scMapInputEventToClientEvent, args...)
 }
 
 // SimConnect_RequestNotificationGroup: Used to request events from a notification group when the simulation is in Dialog Mode.
@@ -551,13 +585,12 @@ func (simco *SimConnect) RequestNotificationGroup(groupID DWord) error {
 	const reserved DWord = 0
 	const flags DWord = 0
 
-	args := []uintptr{
-		uintptr(simco.handle),
-		uintptr(groupID),
-		uintptr(reserved),
-		uintptr(flags),
+	args := []interface{}{
+		groupID,
+		reserved,
+		flags,
 	}
-	return callProc(scRequestNotificationGroup, args...)
+	return simco.call(scRequestNotificationGroup, args...)
 }
 
 // SimConnect_ClearInputGroup: Used to remove all the input events from a specified input group object.
@@ -567,11 +600,10 @@ func (simco *SimConnect) ClearInputGroup(groupID DWord) error {
 	//  HANDLE hSimConnect,
 	//  SIMCONNECT_INPUT_GROUP_ID GroupID)
 
-	args := []uintptr{
-		uintptr(simco.handle),
-		uintptr(groupID),
+	args := []interface{}{
+		groupID,
 	}
-	return callProc(scClearInputGroup, args...)
+	return simco.call(scClearInputGroup, args...)
 }
 
 // SimConnect_ClearNotificationGroup: Used to remove all the client defined events from a notification group.
@@ -581,11 +613,10 @@ func (simco *SimConnect) ClearNotificationGroup(groupID DWord) error {
 	//  HANDLE hSimConnect,
 	//  SIMCONNECT_NOTIFICATION_GROUP_ID GroupID)
 
-	args := []uintptr{
-		uintptr(simco.handle),
-		uintptr(groupID),
+	args := []interface{}{
+		groupID,
 	}
-	return callProc(scClearNotificationGroup, args...)
+	return simco.call(scClearNotificationGroup, args...)
 }
 
 // SimConnect_RequestReservedKey: Used to request a specific keyboard TAB-key combination applies only to this client.
@@ -608,12 +639,11 @@ func (simco *SimConnect) SetInputGroupPriority(groupID, priority DWord) error {
 	//  SIMCONNECT_INPUT_GROUP_ID GroupID,
 	//  DWORD uPriority)
 
-	args := []uintptr{
-		uintptr(simco.handle),
-		uintptr(groupID),
-		uintptr(priority),
+	args := []interface{}{
+		groupID,
+		priority,
 	}
-	return callProc(scSetInputGroupPriority, args...)
+	return simco.call(scSetInputGroupPriority, args...)
 }
 
 // SimConnect_SetInputGroupState: Used to turn requests for input event information from the server on and off.
@@ -624,12 +654,11 @@ func (simco *SimConnect) SetInputGroupState(groupID, state DWord) error {
 	//  SIMCONNECT_INPUT_GROUP_ID GroupID,
 	//  DWORD dwState)
 
-	args := []uintptr{
-		uintptr(simco.handle),
-		uintptr(groupID),
-		uintptr(state),
+	args := []interface{}{
+		groupID,
+		state,
 	}
-	return callProc(scSetInputGroupState, args...)
+	return simco.call(scSetInputGroupState, args...)
 }
 
 // SimConnect_RemoveInputEvent: Used to remove an input event from a specified input group object.
@@ -640,19 +669,18 @@ func (simco *SimConnect) RemoveInputEvent(groupID DWord, inputDefinition string)
 	//  SIMCONNECT_INPUT_GROUP_ID GroupID,
 	//  const char * szInputDefinition)
 
-	args := []uintptr{
-		uintptr(simco.handle),
-		uintptr(groupID),
-		toCharPtr(inputDefinition),
+	args := []interface{}{
+		groupID,
+		inputDefinition,
 	}
-	return callProc(scRemoveInputEvent, args...)
+	return simco.call(scRemoveInputEvent, args...)
 }
 
 // AI Object functions:
 
 // SimConnect_AICreateEnrouteATCAircraft: Used to create an AI controlled aircraft that is about to start or is already underway on its flight plan.
 // https://docs.flightsimulator.com/html/Programming_Tools/SimConnect/API_Reference/AI_Object/SimConnect_AICreateEnrouteATCAircraft.htm
-func (simco *SimConnect) AICreateEnrouteATCAircraft(containerTitle, tailNumber string, flightNumber int, flightPlanPath string, flightPlanPosition float64, touchAndGo bool, requestID uint32) error {
+func (simco *SimConnect) AICreateEnrouteATCAircraft(containerTitle, tailNumber string, flightNumber int, flightPlanPath string, flightPlanPosition float64, touchAndGo bool, requestID DWord) error {
 	// SimConnect_AICreateEnrouteATCAircraft(
 	//  HANDLE hSimConnect,
 	//  const char * szContainerTitle,
@@ -663,17 +691,16 @@ func (simco *SimConnect) AICreateEnrouteATCAircraft(containerTitle, tailNumber s
 	//  BOOL bTouchAndGo,
 	//  SIMCONNECT_DATA_REQUEST_ID RequestID)
 
-	args := []uintptr{
-		uintptr(simco.handle),
-		uintptr(toCharPtr(containerTitle)),
-		uintptr(toCharPtr(tailNumber)),
-		uintptr(flightNumber),
-		uintptr(toCharPtr(flightPlanPath)),
-		uintptr(flightPlanPosition),
-		uintptr(toBoolPtr(touchAndGo)),
-		uintptr(requestID),
+	args := []interface{}{
+		containerTitle,
+		tailNumber,
+		int32(flightNumber),
+		flightPlanPath,
+		flightPlanPosition,
+		touchAndGo,
+		requestID,
 	}
-	return callProc(scAICreateEnrouteATCAircraft, args...)
+	return simco.call(scAICreateEnrouteATCAircraft, args...)
 }
 
 // SimConnect_AICreateNonATCAircraft: Used to create an aircraft that is not flying under ATC control (so is typically flying under VFR rules).
@@ -686,14 +713,13 @@ func (simco *SimConnect) AICreateNonATCAircraft(containerTitle, tailNumber strin
 	//  SIMCONNECT_DATA_INITPOSITION InitPos,
 	//  SIMCONNECT_DATA_REQUEST_ID RequestID)
 
-	args := []uintptr{
-		uintptr(simco.handle),
-		uintptr(toCharPtr(containerTitle)),
-		uintptr(toCharPtr(tailNumber)),
-		uintptr(unsafe.Pointer(&initPos)),
-		uintptr(requestID),
+	args := []interface{}{
+		containerTitle,
+		tailNumber,
+		initPos,
+		requestID,
 	}
-	return callProc(scAICreateNonATCAircraft, args...)
+	return simco.call(scAICreateNonATCAircraft, args...)
 }
 
 // SimConnect_AICreateParkedATCAircraft: Used to create an AI controlled aircraft that is currently parked and does not have a flight plan.
@@ -706,14 +732,13 @@ func (simco *SimConnect) AICreateParkedATCAircraft(containerTitle, tailNumber, a
 	//  const char * szAirportID,
 	//  SIMCONNECT_DATA_REQUEST_ID RequestID)
 
-	args := []uintptr{
-		uintptr(simco.handle),
-		uintptr(toCharPtr(containerTitle)),
-		uintptr(toCharPtr(tailNumber)),
-		uintptr(toCharPtr(airportID)),
-		uintptr(requestID),
+	args := []interface{}{
+		containerTitle,
+		tailNumber,
+		airportID,
+		requestID,
 	}
-	return callProc(scAICreateParkedATCAircraft, args...)
+	return simco.call(scAICreateParkedATCAircraft, args...)
 }
 
 // SimConnect_AICreateSimulatedObject: Used to create AI controlled objects other than aircraft.
@@ -725,13 +750,12 @@ func (simco *SimConnect) AICreateSimulatedObject(containerTitle string, initPos
 	//  SIMCONNECT_DATA_INITPOSITION InitPos,
 	//  SIMCONNECT_DATA_REQUEST_ID RequestID)
 
-	args := []uintptr{
-		uintptr(simco.handle),
-		uintptr(toCharPtr(containerTitle)),
-		uintptr(unsafe.Pointer(&initPos)),
-		uintptr(requestID),
+	args := []interface{}{
+		containerTitle,
+		initPos,
+		requestID,
 	}
-	return callProc(scAICreateSimulatedObject, args...)
+	return simco.call(scAICreateSimulatedObject, args...)
 }
 
 // SimConnect_AIReleaseControl: Used to clear the AI control of a simulated object, typically an aircraft, in order for it to be controlled by a SimConnect client.
@@ -742,12 +766,11 @@ func (simco *SimConnect) AIReleaseControl(objectID, requestID DWord) error {
 	//  SIMCONNECT_OBJECT_ID ObjectID,
 	//  SIMCONNECT_DATA_REQUEST_ID RequestID)
 
-	args := []uintptr{
-		uintptr(simco.handle),
-		uintptr(objectID),
-		uintptr(requestID),
+	args := []interface{}{
+		objectID,
+		requestID,
 	}
-	return callProc(scAIReleaseControl, args...)
+	return simco.call(scAIReleaseControl, args...)
 }
 
 // SimConnect_AIRemoveObject: Used to remove any object created by the client using one of the AI creation functions.
@@ -758,12 +781,11 @@ func (simco *SimConnect) AIRemoveObject(objectID, requestID DWord) error {
 	//  SIMCONNECT_OBJECT_ID ObjectID,
 	//  SIMCONNECT_DATA_REQUEST_ID RequestID)
 
-	args := []uintptr{
-		uintptr(simco.handle),
-		uintptr(objectID),
-		uintptr(requestID),
+	args := []interface{}{
+		objectID,
+		requestID,
 	}
-	return callProc(scAIRemoveObject, args...)
+	return simco.call(scAIRemoveObject, args...)
 }
 
 // SimConnect_AISetAircraftFlightPlan: Used to set or change the flight plan of an AI controlled aircraft.
@@ -775,13 +797,12 @@ func (simco *SimConnect) AISetAircraftFlightPlan(objectID, requestID DWord, flig
 	//  const char * szFlightPlanPath,
 	//  SIMCONNECT_DATA_REQUEST_ID RequestID)
 
-	args := []uintptr{
-		uintptr(simco.handle),
-		uintptr(objectID),
-		uintptr(toCharPtr(flightPlanPath)),
-		uintptr(requestID),
+	args := []interface{}{
+		objectID,
+		flightPlanPath,
+		requestID,
 	}
-	return callProc(scAISetAircraftFlightPlan, args...)
+	return simco.call(scAISetAircraftFlightPlan, args...)
 }
 
 // Flights functions:
@@ -793,11 +814,10 @@ func (simco *SimConnect) FlightLoad(fileName string) error {
 	//  HANDLE hSimConnect,
 	//  const char * szFileName)
 
-	args := []uintptr{
-		uintptr(simco.handle),
-		uintptr(toCharPtr(fileName)),
+	args := []interface{}{
+		fileName,
 	}
-	return callProc(scFlightLoad, args...)
+	return simco.call(scFlightLoad, args...)
 }
 
 // SimConnect_FlightSave: Used to save the current state of a flight to a flight file.
@@ -810,14 +830,13 @@ func (simco *SimConnect) FlightSave(fileName, title, description string, flags D
 	//  const char * szDescription,
 	//  DWORD Flags)
 
-	args := []uintptr{
-		uintptr(simco.handle),
-		uintptr(toCharPtr(fileName)),
-		uintptr(toCharPtr(title)),
-		uintptr(toCharPtr(description)),
-		uintptr(flags),
+	args := []interface{}{
+		fileName,
+		title,
+		description,
+		flags,
 	}
-	return callProc(scFlightSave, args...)
+	return simco.call(scFlightSave, args...)
 }
 
 // SimConnect_FlightPlanLoad: Used to load an existing flight plan.
@@ -828,11 +847,10 @@ func (simco *SimConnect) FlightPlanLoad(fileName string) error {
 	// HANDLE hSimConnect,
 	// const char * szFileName)
 
-	args := []uintptr{
-		uintptr(simco.handle),
-		uintptr(toCharPtr(fileName)),
+	args := []interface{}{
+		fileName,
 	}
-	return callProc(scFlightPlanLoad, args...)
+	return simco.call(scFlightPlanLoad, args...)
 }
 
 // Debug functions:
@@ -844,11 +862,10 @@ func (simco *SimConnect) GetLastSentPacketID(pdwError *DWord) error {
 	//  HANDLE hSimConnect,
 	//  DWORD * pdwError);
 
-	args := []uintptr{
-		uintptr(simco.handle),
-		uintptr(unsafe.Pointer(pdwError)),
+	args := []interface{}{
+		pdwError,
 	}
-	return callProc(scGetLastSentPacketID, args...)
+	return simco.call(scGetLastSentPacketID, args...)
 }
 
 // SimConnect_RequestResponseTimes: Used to provide some data on the performance of the client-server connection.
@@ -873,12 +890,11 @@ func (simco *SimConnect) RequestFacilitiesList(facilityListType, requestID DWord
 	// 	SIMCONNECT_FACILITY_LIST_TYPE type,
 	// 	SIMCONNECT_DATA_REQUEST_ID RequestID)
 
-	args := []uintptr{
-		uintptr(simco.handle),
-		uintptr(facilityListType),
-		uintptr(requestID),
+	args := []interface{}{
+		facilityListType,
+		requestID,
 	}
-	return callProc(scRequestFacilitiesList, args...)
+	return simco.call(scRequestFacilitiesList, args...)
 }
 
 // SimConnect_SubscribeToFacilities: Used to request notifications when a facility of a certain type is added to the facilities cache.
@@ -889,12 +905,30 @@ func (simco *SimConnect) SubscribeToFacilities(facilityListType, requestID DWord
 	// 	SIMCONNECT_FACILITY_LIST_TYPE type,
 	// 	SIMCONNECT_DATA_REQUEST_ID RequestID)
 
-	args := []uintptr{
-		uintptr(simco.handle),
-		uintptr(facilityListType),
-		uintptr(requestID),
+	args := []interface{}{
+		facilityListType,
+		requestID,
+	}
+	return simco.call(scSubscribeToFacilities, args...)
+}
+
+// SimConnect_SubscribeToFacilities_EX1: Used to request notifications when a facility of a certain type enters or leaves the reality bubble.
+// The facilities entering it are sent as lists with newElemInRangeRequestID, starting with all facilities in range,
+// and the facilities leaving it as lists with oldElemOutRangeRequestID.
+// https://docs.flightsimulator.com/html/Programming_Tools/SimConnect/API_Reference/Facilities/SimConnect_SubscribeToFacilities_EX1.htm
+func (simco *SimConnect) SubscribeToFacilitiesEx1(facilityListType, newElemInRangeRequestID, oldElemOutRangeRequestID DWord) error {
+	// SimConnect_SubscribeToFacilities_EX1(
+	// 	HANDLE hSimConnect,
+	// 	SIMCONNECT_FACILITY_LIST_TYPE type,
+	// 	SIMCONNECT_DATA_REQUEST_ID newElemInRangeRequestID,
+	// 	SIMCONNECT_DATA_REQUEST_ID oldElemOutRangeRequestID)
+
+	args := []interface{}{
+		facilityListType,
+		newElemInRangeRequestID,
+		oldElemOutRangeRequestID,
 	}
-	return callProc(scSubscribeToFacilities, args...)
+	return simco.call(scSubscribeToFacilitiesEx1, args...)
 }
 
 // SimConnect_UnsubscribeToFacilities: Used to request that notifications of additions to the facilities cache are not longer sent.
@@ -904,11 +938,10 @@ func (simco *SimConnect) UnsubscribeToFacilities(facilityListType DWord) error {
 	// 	HANDLE hSimConnect,
 	// 	SIMCONNECT_FACILITY_LIST_TYPE type)
 
-	args := []uintptr{
-		uintptr(simco.handle),
-		uintptr(facilityListType),
+	args := []interface{}{
+		facilityListType,
 	}
-	return callProc(scUnsubscribeToFacilities, args...)
+	return simco.call(scUnsubscribeToFacilities, args...)
 }
 
 // Mission functions:
@@ -928,13 +961,12 @@ func (simco *SimConnect) MenuAddItem(menuItem string, menuEventID, data DWord) e
 	//  SIMCONNECT_CLIENT_EVENT_ID MenuEventID,
 	//  DWORD dwData)
 
-	args := []uintptr{
-		uintptr(simco.handle),
-		uintptr(toCharPtr(menuItem)),
-		uintptr(eventID),
-		uintptr(data),
+	args := []interface{}{
+		menuItem,
+		menuEventID,
+		data,
 	}
-	return callProc(scMenuAddItem, args...)
+	return simco.call(scMenuAddItem, args...)
 }
 
 // SimConnect_MenuAddSubItem is mentioned in the docs but there is no further description
@@ -947,14 +979,13 @@ func (simco *SimConnect) MenuAddSubItem(menuEventID DWord, menuItem string, subM
 	//  SIMCONNECT_CLIENT_EVENT_ID SubMenuEventID,
 	//  DWORD dwData)
 
-	args := []uintptr{
-		uintptr(simco.handle),
-		uintptr(menuEventID),
-		uintptr(toCharPtr(menuItem)),
-		uintptr(subMenuEventID),
-		uintptr(data),
+	args := []interface{}{
+		menuEventID,
+		menuItem,
+		subMenuEventID,
+		data,
 	}
-	return callProc(scMenuAddSubItem, args...)
+	return simco.call(scMenuAddSubItem, args...)
 }
 
 // SimConnect_MenuDeleteItem is mentioned in the docs but there is no further description
@@ -964,11 +995,10 @@ func (simco *SimConnect) MenuDeleteItem(menuEventID DWord) error {
 	//  HANDLE hSimConnect,
 	//  SIMCONNECT_CLIENT_EVENT_ID MenuEventID)
 
-	args := []uintptr{
-		uintptr(simco.handle),
-		uintptr(menuEventID),
+	args := []interface{}{
+		menuEventID,
 	}
-	return callProc(scMenuDeleteItem, args...)
+	return simco.call(scMenuDeleteItem, args...)
 }
 
 // SimConnect_MenuDeleteSubItem is mentioned in the docs but there is no further description
@@ -979,12 +1009,11 @@ func (simco *SimConnect) MenuDeleteSubItem(menuEventID, subMenuEventID DWord) er
 	//  SIMCONNECT_CLIENT_EVENT_ID MenuEventID,
 	//  const SIMCONNECT_CLIENT_EVENT_ID SubMenuEventID)
 
-	args := []uintptr{
-		uintptr(simco.handle),
-		uintptr(menuEventID),
-		uintptr(subMenuEventID),
+	args := []interface{}{
+		menuEventID,
+		subMenuEventID,
 	}
-	return callProc(scMenuDeleteSubItem, args...)
+	return simco.call(scMenuDeleteSubItem, args...)
 }
 
 // SimConnect_CameraSetRelative6DOF is not documented (see SimConnect.h)
@@ -998,16 +1027,15 @@ func (simco *SimConnect) CameraSetRelative6DOF(deltaX, deltaY, deltaZ, pitchDeg,
 	// 	float fBankDeg,
 	// 	float fHeadingDeg)
 
-	args := []uintptr{
-		uintptr(simco.handle),
-		uintptr(deltaX),
-		uintptr(deltaY),
-		uintptr(deltaZ),
-		uintptr(pitchDeg),
-		uintptr(bankDeg),
-		uintptr(headingDeg),
+	args := []interface{}{
+		float32(deltaX),
+		float32(deltaY),
+		float32(deltaZ),
+		float32(pitchDeg),
+		float32(bankDeg),
+		float32(headingDeg),
 	}
-	return callProc(scCameraSetRelative6DOF, args...)
+	return simco.call(scCameraSetRelative6DOF, args...)
 }
 
 // SimConnect_SetSystemState is not documented (see SimConnect.h)
@@ -1019,12 +1047,11 @@ func (simco *SimConnect) SetSystemState(state string, integerValue DWord, floatV
 	//  float fFloat,
 	//  const char * szString)
 
-	args := []uintptr{
-		uintptr(simco.handle),
-		uintptr(toCharPtr(state)),
-		uintptr(integerValue),
-		uintptr(floatValue),
-		uintptr(toCharPtr(stringValue)),
+	args := []interface{}{
+		state,
+		integerValue,
+		floatValue,
+		stringValue,
 	}
-	return callProc(scSetSystemState, args...)
+	return simco.call(scSetSystemState, args...)
 }
@@ -0,0 +1,219 @@
+// Package catalog describes the simulation variables listed in references/simvars.txt:
+// their default unit and data type, whether they can be set, whether they take an index and what they are about.
+// It lets callers of SimVarManager.Add, SimMate.AddSimVar and AddToDataDefinition check names and fill in units
+// before anything is sent, instead of finding out from an ExceptionNameUnrecognized at runtime.
+//
+// The metadata is derived from the names by gen.go and is a best effort; run go generate after changing the rules.
+package catalog
+
+//go:generate go run gen.go
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/grumpypixel/msfs2020-simconnect-go/simconnect"
+)
+
+var (
+	ErrUnknownSimVar = errors.New("catalog: unknown simvar")
+	ErrIndex         = errors.New("catalog: bad simvar index")
+	ErrNotSettable   = errors.New("catalog: simvar is not settable")
+)
+
+// Category groups related simvars.
+type Category string
+
+const (
+	Aircraft    Category = "aircraft"
+	ATC         Category = "atc"
+	Autopilot   Category = "autopilot"
+	Controls    Category = "controls"
+	Electrical  Category = "electrical"
+	Engines     Category = "engines"
+	Environment Category = "environment"
+	Flight      Category = "flight"
+	Fuel        Category = "fuel"
+	Instruments Category = "instruments"
+	Lights      Category = "lights"
+	Navigation  Category = "navigation"
+	Radios      Category = "radios"
+	Simulation  Category = "simulation"
+	Systems     Category = "systems"
+)
+
+// SimVar describes a simulation variable.
+type SimVar struct {
+	Name     string
+	Unit     string           // default unit, empty for strings and undocumented structs
+	DataType simconnect.DWord // default data type, DataTypeInvalid for undocumented structs
+	Settable bool             // accepted by SetDataOnSimObject
+	Indexed  bool             // the name takes an index, e.g. "GENERAL ENG RPM:1"
+	Category Category
+}
+
+var byName map[string]int
+
+func init() {
+	byName = make(map[string]int, len(simVars))
+	for i, simVar := range simVars {
+		byName[simVar.Name] = i
+	}
+}
+
+// Lookup returns the description of a simvar. Case and an index suffix such as ":1" are ignored.
+func Lookup(name string) (SimVar, bool) {
+	base, _, _ := split(name)
+	i, ok := byName[base]
+	if !ok {
+		return SimVar{}, false
+	}
+	return simVars[i], true
+}
+
+// Validate checks that name is known and that it has an index if and only if the simvar takes one.
+// An unknown name fails with ErrUnknownSimVar, which suggests the closest known names.
+func Validate(name string) (SimVar, error) {
+	base, index, hasIndex := split(name)
+	i, ok := byName[base]
+	if !ok {
+		if suggestions := Suggest(name, 3); len(suggestions) > 0 {
+			for i, suggestion := range suggestions {
+				suggestions[i] = strconv.Quote(suggestion)
+			}
+			return SimVar{}, fmt.Errorf("%w: %q, did you mean %s?", ErrUnknownSimVar, name, strings.Join(suggestions, " or "))
+		}
+		return SimVar{}, fmt.Errorf("%w: %q", ErrUnknownSimVar, name)
+	}
+	simVar := simVars[i]
+	switch {
+	case hasIndex && !simVar.Indexed:
+		return simVar, fmt.Errorf("%w: %s takes no index", ErrIndex, simVar.Name)
+	case !hasIndex && simVar.Indexed:
+		return simVar, fmt.Errorf("%w: %s needs an index, e.g. %s:1", ErrIndex, simVar.Name, simVar.Name)
+	case hasIndex && index == "":
+		return simVar, fmt.Errorf("%w: %q", ErrIndex, name)
+	case hasIndex:
+		if n, err := strconv.Atoi(index); err != nil || n < 0 {
+			return simVar, fmt.Errorf("%w: %q", ErrIndex, name)
+		}
+	}
+	return simVar, nil
+}
+
+// ValidateSettable is Validate for simvars which are about to be set.
+func ValidateSettable(name string) (SimVar, error) {
+	simVar, err := Validate(name)
+	if err == nil && !simVar.Settable {
+		err = fmt.Errorf("%w: %s", ErrNotSettable, simVar.Name)
+	}
+	return simVar, err
+}
+
+// Resolve validates name and fills in the default unit if unit is empty, and the default data type if dataType is
+// DataTypeInvalid. The results can be passed on to SimVarManager.Add or AddToDataDefinition:
+//
+//	unit, dataType, err := catalog.Resolve("GENERAL ENG RPM:1", "", simconnect.DataTypeInvalid)
+func Resolve(name, unit string, dataType simconnect.DWord) (string, simconnect.DWord, error) {
+	simVar, err := Validate(name)
+	if err != nil {
+		return unit, dataType, err
+	}
+	if unit == "" {
+		unit = simVar.Unit
+	}
+	if dataType == simconnect.DataTypeInvalid {
+		dataType = simVar.DataType
+	}
+	return unit, dataType, nil
+}
+
+// ValidateDefinition validates the simvars of a data definition built by NewDataDefinition.
+func ValidateDefinition(def *simconnect.DataDefinition) error {
+	for _, field := range def.Fields {
+		if _, err := Validate(field.SimVar); err != nil {
+			return fmt.Errorf("field %s: %w", field.Name, err)
+		}
+	}
+	return nil
+}
+
+// All returns all simvars in the order of references/simvars.txt.
+func All() []SimVar {
+	return append([]SimVar(nil), simVars...)
+}
+
+// InCategory returns the simvars of category, sorted by name.
+func InCategory(category Category) []SimVar {
+	var result []SimVar
+	for _, simVar := range simVars {
+		if simVar.Category == category {
+			result = append(result, simVar)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Name < result[j].Name })
+	return result
+}
+
+// Suggest returns up to max known names which are closest to name, closest first.
+// Names which differ in more than a third of their letters are not considered.
+func Suggest(name string, max int) []string {
+	base, _, _ := split(name)
+	type candidate struct {
+		name     string
+		distance int
+	}
+	var candidates []candidate
+	limit := len(base)/3 + 1
+	for _, simVar := range simVars {
+		if d := distance(base, simVar.Name); d <= limit {
+			candidates = append(candidates, candidate{simVar.Name, d})
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool { return candidates[i].distance < candidates[j].distance })
+	var result []string
+	for i := 0; i < len(candidates) && i < max; i++ {
+		result = append(result, candidates[i].name)
+	}
+	return result
+}
+
+// split splits "name:index" into the normalized name and the index.
+func split(name string) (string, string, bool) {
+	name, index, hasIndex := strings.Cut(name, ":")
+	return strings.ToUpper(strings.Join(strings.Fields(name), " ")), strings.TrimSpace(index), hasIndex
+}
+
+// distance returns the Levenshtein distance of a and b.
+func distance(a, b string) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min3(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
+}
+
+func min3(a, b, c int) int {
+	if b < a {
+		a = b
+	}
+	if c < a {
+		a = c
+	}
+	return a
+}
@@ -0,0 +1,45 @@
+package catalog_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/grumpypixel/msfs2020-simconnect-go/simconnect"
+	"github.com/grumpypixel/msfs2020-simconnect-go/simconnect/catalog"
+)
+
+func TestLookup(t *testing.T) {
+	for _, want := range []catalog.SimVar{
+		{Name: "ADF ACTIVE FREQUENCY", Unit: "KHz", DataType: simconnect.DataTypeFloat64, Indexed: true, Category: catalog.Radios},
+		{Name: "ELECTRICAL MASTER BATTERY", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: true, Category: catalog.Electrical},
+		{Name: "AUTOPILOT ALTITUDE LOCK VAR", Unit: "feet", DataType: simconnect.DataTypeFloat64, Settable: true, Category: catalog.Autopilot},
+		{Name: "KOHLSMAN SETTING MB", Unit: "millibars", DataType: simconnect.DataTypeFloat64, Settable: true, Category: catalog.Instruments},
+		{Name: "TITLE", DataType: simconnect.DataTypeString256, Category: catalog.Aircraft},
+	} {
+		got, ok := catalog.Lookup(want.Name)
+		if !ok || got != want {
+			t.Errorf("%s: got %+v, want %+v", want.Name, got, want)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	if _, err := catalog.Validate("PLANE ALTITUDEE"); !errors.Is(err, catalog.ErrUnknownSimVar) {
+		t.Errorf("misspelt name: got %v", err)
+	}
+	if _, err := catalog.Validate("general eng rpm:1"); err != nil {
+		t.Errorf("indexed name: got %v", err)
+	}
+	if _, err := catalog.Validate("GENERAL ENG RPM"); !errors.Is(err, catalog.ErrIndex) {
+		t.Errorf("missing index: got %v", err)
+	}
+	if _, err := catalog.Validate("TITLE:1"); !errors.Is(err, catalog.ErrIndex) {
+		t.Errorf("superfluous index: got %v", err)
+	}
+	if _, err := catalog.ValidateSettable("LIGHT LANDING"); err != nil {
+		t.Errorf("LIGHT LANDING: got %v", err)
+	}
+	if _, err := catalog.ValidateSettable("TITLE"); !errors.Is(err, catalog.ErrNotSettable) {
+		t.Errorf("TITLE: got %v", err)
+	}
+}
@@ -0,0 +1,437 @@
+//go:build ignore
+
+// gen.go generates simvars.go from references/simvars.txt.
+// The reference only lists names, so units, data types, categories and the index flag are derived from the
+// names by the rules below. Where a rule gets it wrong, add the simvar to one of the override tables.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"strings"
+)
+
+const (
+	source = "../../references/simvars.txt"
+	target = "simvars.go"
+)
+
+// units overrides the unit rules.
+var units = map[string]string{
+	"ADF ACTIVE FREQUENCY":              "KHz",
+	"ELECTRICAL MASTER BATTERY":         "Bool",
+	"ADF EXT FREQUENCY":                 "KHz",
+	"ADF FREQUENCY":                     "KHz",
+	"ADF STANDBY FREQUENCY":             "KHz",
+	"AIRSPEED MACH":                     "mach",
+	"AIRSPEED SELECT INDICATED OR TRUE": "Bool",
+	"AUTOPILOT NAV SELECTED":            "number",
+	"DELTA HEADING RATE":                "degrees per second",
+	"FLAP DAMAGE BY SPEED":              "Bool",
+	"FLY BY WIRE ALPHA PROTECTION":      "Bool",
+	"GEAR DAMAGE BY SPEED":              "Bool",
+	"LIGHT ON STATES":                   "mask",
+	"LIGHT POTENTIOMETER":               "percent",
+	"LIGHT STATES":                      "mask",
+	"SIM ON GROUND":                     "Bool",
+	"TURN INDICATOR RATE":               "degrees per second",
+	"AUTOPILOT AIRSPEED HOLD VAR":       "knots",
+	"AUTOPILOT ALTITUDE LOCK VAR":       "feet",
+	"AUTOPILOT HEADING LOCK DIR":        "degrees",
+	"AUTOPILOT MACH HOLD VAR":           "number",
+	"AUTOPILOT MAX BANK":                "degrees",
+	"AUTOPILOT PITCH HOLD REF":          "degrees",
+	"AUTOPILOT RPM HOLD VAR":            "number",
+	"AUTOPILOT VERTICAL HOLD VAR":       "feet per minute",
+	"AUTOPILOT FLIGHT DIRECTOR BANK":    "degrees",
+	"AUTOPILOT FLIGHT DIRECTOR PITCH":   "degrees",
+	"BARBER POLE MACH":                  "mach",
+	"BRAKE LEFT POSITION":               "position",
+	"BRAKE RIGHT POSITION":              "position",
+	"BRAKE INDICATOR":                   "position",
+	"BRAKE PARKING INDICATOR":           "Bool",
+	"BRAKE PARKING POSITION":            "position",
+	"CANOPY OPEN":                       "percent",
+	"CG MAX MACH":                       "mach",
+	"CG MIN MACH":                       "mach",
+	"ELEVATOR TRIM POSITION":            "radians",
+	"EXIT OPEN":                         "percent",
+	"FLAPS HANDLE INDEX":                "number",
+	"FLAPS NUM HANDLE POSITIONS":        "number",
+	"FUEL TANK SELECTOR":                "Enum",
+	"FUEL TOTAL QUANTITY WEIGHT":        "pounds",
+	"FUEL WEIGHT PER GALLON":            "pounds",
+	"GEAR HANDLE POSITION":              "Bool",
+	"GENERAL ENG ELAPSED TIME":          "hours",
+	"KOHLSMAN SETTING HG":               "inHg",
+	"KOHLSMAN SETTING MB":               "millibars",
+	"MACH MAX OPERATE":                  "mach",
+	"NAV DME":                           "nautical miles",
+	"NAV DMESPEED":                      "knots",
+	"HSI DISTANCE":                      "nautical miles",
+	"HSI SPEED":                         "knots",
+	"RECIP ENG MANIFOLD PRESSURE":       "psi",
+	"ENG MANIFOLD PRESSURE":             "inches of mercury",
+	"AMBIENT PRESSURE":                  "inHg",
+	"BAROMETER PRESSURE":                "millibars",
+	"SEA LEVEL PRESSURE":                "millibars",
+	"SURFACE TYPE":                      "Enum",
+	"SURFACE CONDITION":                 "Enum",
+	"TRANSPONDER CODE":                  "Bco16",
+	"ENGINE TYPE":                       "Enum",
+	"ENGINE CONTROL SELECT":             "mask",
+	"ATC TAXIPATH DISTANCE":             "meters",
+	"GPS POSITION ALT":                  "meters",
+	"GPS WP NEXT ALT":                   "meters",
+	"GPS WP PREV ALT":                   "meters",
+	"GPS TARGET ALTITUDE":               "meters",
+	"GPS FLIGHTPLAN TOTAL DISTANCE":     "meters",
+	"PUSHBACK STATE":                    "Enum",
+	"ROTOR TEMPERATURE":                 "celsius",
+	"YOKE X POSITION":                   "position",
+	"YOKE Y POSITION":                   "position",
+	"AILERON POSITION":                  "position",
+	"ELEVATOR POSITION":                 "position",
+	"RUDDER POSITION":                   "position",
+	"RUDDER PEDAL POSITION":             "position",
+	"STEER INPUT CONTROL":               "percent over 100",
+}
+
+// stringVars are the simvars which return text. They have no unit.
+var stringVars = set(
+	"ADF IDENT", "ADF NAME", "ATC AIRLINE", "ATC FLIGHT NUMBER", "ATC ID", "ATC MODEL", "ATC RUNWAY AIRPORT NAME",
+	"ATC TYPE", "CATEGORY", "DROPPABLE OBJECTS UI NAME", "GPS APPROACH AIRPORT ID", "GPS APPROACH APPROACH ID",
+	"GPS APPROACH TRANSITION ID", "GPS WP NEXT ID", "GPS WP PREV ID", "HSI STATION IDENT", "NAV IDENT", "NAV NAME",
+	"PAYLOAD STATION NAME", "TITLE",
+)
+
+// positions are the simvars which return a SIMCONNECT_DATA_LATLONALT.
+var positions = set(
+	"ADF LATLONALT", "INNER MARKER LATLONALT", "MIDDLE MARKER LATLONALT", "NAV DME LATLONALT", "NAV GS LATLONALT",
+	"NAV GS LLAF64", "NAV VOR LATLONALT", "NAV VOR LLAF64", "OUTER MARKER LATLONALT", "STRUCT LATLONALT",
+)
+
+// vectors are the simvars which return a SIMCONNECT_DATA_XYZ.
+var vectors = set(
+	"EYEPOINT POSITION", "STRUCT AMBIENT WIND", "STRUCT BODY ROTATION ACCELERATION", "STRUCT BODY ROTATION VELOCITY",
+	"STRUCT BODY VELOCITY", "STRUCT ENGINE POSITION", "STRUCT EYEPOINT DYNAMIC ANGLE", "STRUCT EYEPOINT DYNAMIC OFFSET",
+	"STRUCT SURFACE RELATIVE VELOCITY", "STRUCT WORLD ACCELERATION", "STRUCT WORLD ROTATION VELOCITY",
+	"STRUCT WORLDVELOCITY",
+)
+
+// settable are the simvars which SetDataOnSimObject accepts.
+var settable = set(
+	"ACCELERATION BODY X", "ACCELERATION BODY Y", "ACCELERATION BODY Z", "ACCELERATION WORLD X",
+	"ACCELERATION WORLD Y", "ACCELERATION WORLD Z", "ADF CARD", "AILERON POSITION", "AILERON TRIM", "AILERON TRIM PCT",
+	"APU GENERATOR SWITCH", "AUTOPILOT AIRSPEED HOLD VAR", "AUTOPILOT ALTITUDE LOCK VAR", "AUTOPILOT HEADING LOCK DIR",
+	"AUTOPILOT MACH HOLD VAR", "AUTOPILOT PITCH HOLD REF", "AUTOPILOT RPM HOLD VAR", "AUTOPILOT VERTICAL HOLD VAR",
+	"AVIONICS MASTER SWITCH", "BRAKE LEFT POSITION", "BRAKE PARKING POSITION", "BRAKE RIGHT POSITION",
+	"CABIN NO SMOKING ALERT SWITCH", "CABIN SEATBELTS ALERT SWITCH", "CANOPY OPEN", "COLLECTIVE POSITION",
+	"DECISION ALTITUDE MSL", "DECISION HEIGHT", "DELEGATE CONTROLS TO AI", "ELECTRICAL MASTER BATTERY",
+	"ELEVATOR POSITION", "ELEVATOR TRIM PCT", "ELEVATOR TRIM POSITION", "ENG ANTI ICE", "ENG COMBUSTION",
+	"ENG ON FIRE", "ENGINE CONTROL SELECT", "EXIT OPEN", "FLAPS HANDLE INDEX", "FLAPS HANDLE PERCENT",
+	"FOLDING WING HANDLE POSITION", "FOLDING WING LEFT PERCENT", "FOLDING WING RIGHT PERCENT",
+	"FUEL TANK CENTER LEVEL", "FUEL TANK CENTER QUANTITY", "FUEL TANK CENTER2 LEVEL", "FUEL TANK CENTER2 QUANTITY",
+	"FUEL TANK CENTER3 LEVEL", "FUEL TANK CENTER3 QUANTITY", "FUEL TANK EXTERNAL1 LEVEL",
+	"FUEL TANK EXTERNAL1 QUANTITY", "FUEL TANK EXTERNAL2 LEVEL", "FUEL TANK EXTERNAL2 QUANTITY",
+	"FUEL TANK LEFT AUX LEVEL", "FUEL TANK LEFT AUX QUANTITY", "FUEL TANK LEFT MAIN LEVEL",
+	"FUEL TANK LEFT MAIN QUANTITY", "FUEL TANK LEFT TIP LEVEL", "FUEL TANK LEFT TIP QUANTITY",
+	"FUEL TANK RIGHT AUX LEVEL", "FUEL TANK RIGHT AUX QUANTITY", "FUEL TANK RIGHT MAIN LEVEL",
+	"FUEL TANK RIGHT MAIN QUANTITY", "FUEL TANK RIGHT TIP LEVEL", "FUEL TANK RIGHT TIP QUANTITY", "GEAR AUX POSITION",
+	"GEAR CENTER POSITION", "GEAR HANDLE POSITION", "GEAR LEFT POSITION", "GEAR RIGHT POSITION", "GEAR TAIL POSITION",
+	"GENERAL ENG ANTI ICE POSITION", "GENERAL ENG COMBUSTION", "GENERAL ENG DAMAGE PERCENT",
+	"GENERAL ENG FUEL PUMP SWITCH", "GENERAL ENG FUEL VALVE", "GENERAL ENG GENERATOR SWITCH",
+	"GENERAL ENG MASTER ALTERNATOR", "GENERAL ENG MIXTURE LEVER POSITION", "GENERAL ENG PROPELLER LEVER POSITION",
+	"GENERAL ENG STARTER", "GENERAL ENG THROTTLE LEVER POSITION", "GYRO DRIFT ERROR", "IS SLEW ACTIVE",
+	"KOHLSMAN SETTING HG", "KOHLSMAN SETTING MB", "LAUNCHBAR SWITCH", "LEADING EDGE FLAPS LEFT PERCENT",
+	"LEADING EDGE FLAPS RIGHT PERCENT", "LIGHT BEACON", "LIGHT CABIN", "LIGHT GLARESHIELD", "LIGHT LANDING",
+	"LIGHT LOGO", "LIGHT NAV", "LIGHT PANEL", "LIGHT PEDESTRAL", "LIGHT RECOGNITION", "LIGHT STATES", "LIGHT STROBE",
+	"LIGHT TAXI", "LIGHT WING", "MANUAL FUEL PUMP HANDLE", "NAV OBS", "PANEL ANTI ICE SWITCH", "PARTIAL PANEL ADF",
+	"PARTIAL PANEL AIRSPEED", "PARTIAL PANEL ALTIMETER", "PARTIAL PANEL ATTITUDE", "PARTIAL PANEL AVIONICS",
+	"PARTIAL PANEL COMM", "PARTIAL PANEL COMPASS", "PARTIAL PANEL ELECTRICAL", "PARTIAL PANEL ENGINE",
+	"PARTIAL PANEL FUEL INDICATOR", "PARTIAL PANEL HEADING", "PARTIAL PANEL NAV", "PARTIAL PANEL PITOT",
+	"PARTIAL PANEL TRANSPONDER", "PARTIAL PANEL TURN COORDINATOR", "PARTIAL PANEL VACUUM",
+	"PARTIAL PANEL VERTICAL VELOCITY", "PAYLOAD STATION WEIGHT", "PITOT HEAT", "PLANE ALTITUDE", "PLANE BANK DEGREES",
+	"PLANE HEADING DEGREES GYRO", "PLANE HEADING DEGREES MAGNETIC", "PLANE HEADING DEGREES TRUE", "PLANE LATITUDE",
+	"PLANE LONGITUDE", "PLANE PITCH DEGREES", "PROP DEICE SWITCH", "PROP RPM", "PUSHBACK ANGLE", "PUSHBACK STATE",
+	"PUSHBACK WAIT", "REALISM", "RECIP ENG ALTERNATE AIR POSITION", "RECIP ENG COWL FLAP POSITION",
+	"RECIP ENG LEFT MAGNETO", "RECIP ENG PRIMER", "RECIP ENG RIGHT MAGNETO", "RECIP MIXTURE RATIO",
+	"ROTATION VELOCITY BODY X", "ROTATION VELOCITY BODY Y", "ROTATION VELOCITY BODY Z", "ROTOR BRAKE HANDLE POS",
+	"ROTOR CLUTCH SWITCH POS", "ROTOR GOV SWITCH POS", "ROTOR LATERAL TRIM PCT", "RUDDER PEDAL POSITION",
+	"RUDDER POSITION", "RUDDER TRIM", "RUDDER TRIM PCT", "SIM DISABLED", "SMOKE ENABLE", "SPOILERS ARMED",
+	"SPOILERS HANDLE POSITION", "SPOILERS LEFT POSITION", "SPOILERS RIGHT POSITION", "STEER INPUT CONTROL",
+	"STRUCT LATLONALT", "STRUCTURAL DEICE SWITCH", "TAILHOOK POSITION", "TOW RELEASE HANDLE",
+	"TRAILING EDGE FLAPS LEFT PERCENT", "TRAILING EDGE FLAPS RIGHT PERCENT", "TRANSPONDER CODE",
+	"TURB ENG AFTERBURNER", "TURB ENG IGNITION SWITCH", "TURB ENG MASTER STARTER SWITCH", "TURB ENG N1", "TURB ENG N2",
+	"TURB ENG PRIMARY NOZZLE PERCENT", "VELOCITY BODY X", "VELOCITY BODY Y", "VELOCITY BODY Z", "VELOCITY WORLD X",
+	"VELOCITY WORLD Y", "VELOCITY WORLD Z", "WATER BALLAST VALVE", "WATER RUDDER HANDLE POSITION", "WING FLEX PCT",
+	"YOKE X POSITION", "YOKE Y POSITION",
+)
+
+// indexed lists the prefixes of simvars which take an index, and notIndexed the exceptions.
+var (
+	indexed = []string{
+		"ADF ", "ALTERNATOR ", "BATTERY BREAKER", "BATTERY CONNECTION", "BLEED AIR ENGINE", "BUS ", "CIRCUIT BREAKER",
+		"CIRCUIT CONNECTION", "CIRCUIT ON", "CIRCUIT SWITCH ON", "COM ", "ENG ", "EXIT ", "EXTERNAL POWER ",
+		"FUEL TANK SELECTOR", "FUEL TRANSFER PUMP", "GENERAL ENG ", "LIGHT POTENTIOMETER", "NAV ", "PAYLOAD STATION ",
+		"PROP ", "RECIP ", "SLING ", "TRANSPONDER ", "TURB ENG ",
+	}
+	notIndexed = set(
+		"COM RECEIVE ALL", "COM RECIEVE ALL", "PAYLOAD STATION COUNT", "PROP TYPE AVAILABLE", "SLING OBJECT ATTACHED",
+	)
+)
+
+// categories maps name prefixes to categories; the longest matching prefix wins.
+var categories = map[string]string{
+	"ACCELERATION": "Flight", "ADF": "Radios", "AI CONTROLS": "Simulation", "AILERON": "Controls", "AIRCRAFT WIND": "Environment",
+	"AIRSPEED": "Flight", "ALTERNATE STATIC": "Instruments", "ALTERNATOR": "Electrical", "AMBIENT": "Environment",
+	"ANEMOMETER": "Instruments", "ANGLE OF ATTACK": "Instruments", "ANNUNCIATOR": "Instruments",
+	"ANTISKID": "Controls", "APPLY HEAT": "Systems", "APU": "Engines", "ARTIFICIAL GROUND": "Environment",
+	"ASSISTANCE": "ATC", "ATC": "ATC", "ATTITUDE": "Instruments", "AUDIO PANEL": "Radios", "AUTO BRAKE": "Controls",
+	"AUTO COORDINATION": "Simulation", "AUTOBRAKES": "Controls", "AUTOPILOT": "Autopilot",
+	"AUTOTHROTTLE": "Autopilot", "AUX WHEEL": "Controls", "AVIONICS MASTER": "Electrical",
+	"BAROMETER": "Environment", "BARBER POLE": "Flight", "BATTERY": "Electrical", "BETA DOT": "Flight",
+	"BLAST SHIELD": "Controls", "BLEED AIR": "Systems", "BRAKE": "Controls", "BREAKER": "Electrical",
+	"BUS": "Electrical", "CABIN": "Systems", "CABLE CAUGHT": "Controls", "CAMERA": "Simulation",
+	"CANOPY": "Controls", "CARB HEAT": "Engines", "CATAPULT": "Controls", "CENTER WHEEL": "Controls",
+	"CIRCUIT": "Electrical", "COLLECTIVE": "Controls", "COM": "Radios", "CONCORDE": "Controls",
+	"CONTROLLABLE": "Simulation", "COPILOT TRANSMIT": "Radios", "COWL FLAPS": "Engines", "CRASH": "Simulation",
+	"DECISION": "Instruments", "DELEGATE": "Simulation", "DELTA HEADING": "Flight", "DISK": "Controls",
+	"DME": "Radios", "DYNAMIC PRESSURE": "Flight", "ELECTRICAL": "Electrical", "ELEVATOR": "Controls",
+	"ELEVON": "Controls", "ELT": "Radios", "ENG": "Engines", "ENGINE": "Engines", "ESTIMATED FUEL": "Fuel",
+	"EXIT": "Controls", "EXTERNAL POWER": "Electrical", "EYEPOINT": "Simulation", "FIRE": "Systems",
+	"FLAP": "Controls", "FLY BY WIRE": "Autopilot", "FOLDING WING": "Controls", "FUEL": "Fuel",
+	"FULL THROTTLE": "Engines", "G FORCE": "Flight", "GEAR": "Controls", "GENERAL ENG": "Engines",
+	"GPS": "Navigation", "GPS DRIVES NAV1": "Autopilot", "GPWS": "Instruments", "GROUND ALTITUDE": "Environment",
+	"GROUND VELOCITY": "Flight", "GYRO": "Instruments", "HEADING INDICATOR": "Instruments",
+	"HOLDBACK": "Controls", "HSI": "Navigation", "HYDRAULIC": "Systems", "INCIDENCE": "Flight",
+	"INDICATED ALTITUDE": "Instruments", "INDUCTOR COMPASS": "Instruments", "INNER MARKER": "Radios",
+	"INSTRUMENTS AVAILABLE": "Instruments", "INTERCOM": "Radios", "IS ALTITUDE FREEZE": "Simulation",
+	"IS ANY INTERIOR LIGHT": "Lights", "IS ATTACHED TO SLING": "Controls", "IS ATTITUDE FREEZE": "Simulation",
+	"IS GEAR": "Controls", "IS LATITUDE": "Simulation", "IS SLEW": "Simulation", "IS USER SIM": "Simulation",
+	"KOHLSMAN": "Instruments", "LANDING LIGHT": "Lights", "LAUNCHBAR": "Controls", "LEADING EDGE": "Controls",
+	"LEFT WHEEL": "Controls", "LIGHT": "Lights", "MACH MAX": "Flight", "MAGNETIC COMPASS": "Instruments",
+	"MAGVAR": "Navigation", "MANUAL FUEL PUMP": "Fuel", "MANUAL INSTRUMENT LIGHTS": "Lights", "MARKER": "Radios",
+	"MASTER IGNITION": "Engines", "MAX G FORCE": "Flight", "MAX RATED ENGINE": "Engines",
+	"MIDDLE MARKER": "Radios", "MIN DRAG": "Flight", "MIN G FORCE": "Flight", "NAV": "Radios",
+	"NOSEWHEEL": "Controls", "NUM FUEL": "Fuel", "NUM SLING": "Controls", "NUMBER OF CATAPULTS": "Controls",
+	"NUMBER OF ENGINES": "Engines", "OIL AMOUNT": "Engines", "OLD ENG": "Engines", "ON ANY RUNWAY": "ATC",
+	"OUTER MARKER": "Radios", "OVERSPEED": "Instruments", "PANEL ANTI ICE": "Systems",
+	"PANEL AUTO FEATHER": "Engines", "PARTIAL PANEL": "Instruments", "PILOT TRANSMIT": "Radios",
+	"PITOT": "Instruments", "PLANE": "Flight", "PRESSURE ALTITUDE": "Flight", "PRESSURIZATION": "Systems",
+	"PROP": "Engines", "PROP DEICE": "Systems", "PROPELLER": "Engines", "PUSHBACK": "Controls",
+	"RAD INS": "Instruments", "RADIO HEIGHT": "Instruments", "RADIOS AVAILABLE": "Radios",
+	"REALISM": "Simulation", "RECIP": "Engines", "REJECTED TAKEOFF": "Controls", "RELATIVE WIND": "Flight",
+	"RETRACT": "Controls", "RIGHT WHEEL": "Controls", "ROTATION VELOCITY": "Flight", "ROTOR": "Controls",
+	"RUDDER": "Controls", "SEA LEVEL": "Environment", "SELECTED DME": "Radios", "SEMIBODY": "Flight",
+	"SHUTOFF VALVE": "Fuel", "SIGMA": "Flight", "SIM": "Simulation", "SIM ON GROUND": "Flight",
+	"SIM SHOULD SET ON GROUND": "Flight", "SIMULATED RADIUS": "Aircraft", "SLING": "Controls",
+	"SLOPE TO ATC": "ATC", "SMART CAMERA": "Simulation", "SMOKE": "Systems", "SPEAKER": "Radios",
+	"SPOILER": "Controls", "STALL HORN": "Instruments", "STALL WARNING": "Instruments",
+	"STANDARD ATM": "Environment", "STATIC": "Flight", "STEER": "Controls", "STROBE": "Lights",
+	"STRUC": "Autopilot", "STRUCT": "Flight", "STRUCT AMBIENT WIND": "Environment",
+	"STRUCT ENGINE POSITION": "Engines", "STRUCT EYEPOINT": "Simulation", "STRUCT REALISM": "Simulation",
+	"STRUCTURAL": "Systems", "SUCTION": "Instruments", "SURFACE": "Environment",
+	"SURFACE RELATIVE": "Flight", "SYSTEMS AVAILABLE": "Systems", "TAILHOOK": "Controls",
+	"TAILWHEEL": "Controls", "TOE BRAKES": "Controls", "TOTAL AIR": "Environment", "TOTAL VELOCITY": "Flight",
+	"TOTAL WORLD VELOCITY": "Flight", "TOW": "Controls", "TRAILING EDGE": "Controls", "TRANSPONDER": "Radios",
+	"TRUE AIRSPEED": "Instruments", "TURB ENG": "Engines", "TURN": "Instruments", "UNLIMITED FUEL": "Simulation",
+	"USER INPUT": "Simulation", "VARIOMETER": "Instruments", "VELOCITY": "Flight", "VERTICAL SPEED": "Flight",
+	"WARNING": "Instruments", "WATER": "Controls", "WHEEL": "Controls", "WISKEY": "Instruments",
+	"YAW STRING": "Instruments", "YOKE": "Controls",
+}
+
+// unitRules map name patterns to units; the first matching rule wins.
+var unitRules = []struct {
+	match func(name string) bool
+	unit  string
+}{
+	{suffix(" ON", " AVAILABLE", " ACTIVE", " ARMED", " SWITCH", " FAILED", " WARNING", " ENABLED", " DETECTED",
+		" EXCEEDED", " PULLED", " FIRE", " LOCK", " HOLD", " VALID", " INSTALLED", " EXTENDED", " HELD EXTENDED",
+		" ATTACHED", " BROKEN", " DISABLED", " DISCHARGED", " SELECTED", " OPEN", " IGNITING", " MODE", " TRANSMIT",
+		" FEATHERED", " INHIBIT", " COMBUSTION", " STARTER", " ANTI ICE", " MASTER", " LEVELER", " DAMPER", " ENABLE"),
+		"Bool"},
+	{prefix("IS ", "NAV HAS ", "HSI HAS ", "GPS IS ", "GPS APPROACH IS ", "ATC CLEARED ", "CIRCUIT ", "BREAKER ", "FAKE ",
+		"LIGHT ", "WARNING "), "Bool"},
+	{prefix("PARTIAL PANEL "), "Enum"},
+	{contains(" PCT", "PERCENT"), "percent"},
+	{suffix(" LEVEL"), "percent over 100"},
+	{contains("FREQUENCY"), "MHz"},
+	{contains("RPM"), "rpm"},
+	{contains("TEMPERATURE"), "celsius"},
+	{contains("FUEL FLOW GPH"), "gallons per hour"},
+	{contains("FUEL FLOW", "CORRECTED FF"), "pounds per hour"},
+	{contains("VERTICAL SPEED", "DESCENT RATE", "RATE"), "feet per minute"},
+	{contains("AIRSPEED", "SPEED", "DESIGN SPEED", "GROUND VELOCITY", "TOTAL VELOCITY"), "knots"},
+	{contains("ROTATION VELOCITY"), "degrees per second"},
+	{contains("ACCELERATION"), "feet per second squared"},
+	{contains("VELOCITY", "AMBIENT WIND X", "AMBIENT WIND Y", "AMBIENT WIND Z", "AIRCRAFT WIND"), "feet per second"},
+	{contains("LATITUDE", "LONGITUDE", "DEGREES", "ANGLE", "HEADING", "BEARING", "MAGVAR", "TRACK", "RADIAL",
+		"DIRECTION", "ALPHA", "BETA", "WP NEXT LAT", "WP NEXT LON", "WP PREV LAT", "WP PREV LON", "POSITION LAT",
+		"POSITION LON", "COURSE", "NAV OBS", "HDG"), "degrees"},
+	{contains("ALTITUDE", "ALT ABOVE GROUND", "HEIGHT", "CRUISE ALT", "ELEVATION", "FLIGHTPLAN DIFF ALT"), "feet"},
+	{contains("PRESSURE"), "psi"},
+	{contains("MOI"), "slug feet squared"},
+	{contains("WEIGHT"), "pounds"},
+	{contains("QUANTITY", "CAPACITY", "OIL AMOUNT"), "gallons"},
+	{contains("VOLTAGE", "VOLTS"), "volts"},
+	{contains("AMPS", "LOAD"), "amperes"},
+	{contains("DISTANCE", "LENGTH", "WIDTH", "SPAN", "RADIUS", "CROSS TRK"), "feet"},
+	{contains("ETE", "ETA", "ELAPSED TIME"), "seconds"},
+	{contains("G FORCE", "LOADFACTOR"), "GForce"},
+	{contains("TORQUE"), "foot pounds"},
+	{contains("RELATIVE POSITION"), "meters"},
+	{contains("CONTACT", "EXIT POS"), "feet"},
+	{contains("POSITION", "POS"), "percent over 100"},
+}
+
+type simVar struct {
+	name, unit, dataType, category string
+	settable, indexed              bool
+}
+
+func main() {
+	file, err := os.Open(source)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer file.Close()
+
+	var simVars []simVar
+	seen := make(map[string]bool)
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		name := strings.TrimSpace(scanner.Text())
+		if name == "" || strings.HasPrefix(name, "#") || name == "NONE" || seen[name] {
+			continue
+		}
+		seen[name] = true
+		simVars = append(simVars, describe(name))
+	}
+	if err := scanner.Err(); err != nil {
+		log.Fatal(err)
+	}
+	// An override which names no simvar of the reference is a typo.
+	for _, table := range []map[string]bool{stringVars, positions, vectors, settable, notIndexed} {
+		for name := range table {
+			if !seen[name] {
+				log.Fatalf("%s is not in %s", name, source)
+			}
+		}
+	}
+	for name := range units {
+		if !seen[name] {
+			log.Fatalf("%s is not in %s", name, source)
+		}
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by gen.go from references/simvars.txt; DO NOT EDIT.\n\n")
+	fmt.Fprintf(&buf, "package catalog\n\nimport \"github.com/grumpypixel/msfs2020-simconnect-go/simconnect\"\n\n")
+	fmt.Fprintf(&buf, "var simVars = []SimVar{\n")
+	for _, v := range simVars {
+		fmt.Fprintf(&buf, "\t{Name: %q, Unit: %q, DataType: simconnect.%s, Settable: %t, Indexed: %t, Category: %s},\n",
+			v.name, v.unit, v.dataType, v.settable, v.indexed, v.category)
+	}
+	fmt.Fprintf(&buf, "}\n")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(target, src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func describe(name string) simVar {
+	v := simVar{name: name, unit: "number", dataType: "DataTypeFloat64", category: "Aircraft", settable: settable[name]}
+
+	switch {
+	case stringVars[name]:
+		v.unit, v.dataType = "", "DataTypeString256"
+	case positions[name]:
+		v.unit, v.dataType = "SIMCONNECT_DATA_LATLONALT", "DataTypeLatLonAlt"
+	case vectors[name]:
+		v.unit, v.dataType = "SIMCONNECT_DATA_XYZ", "DataTypeXYZ"
+	case strings.HasPrefix(name, "STRUC"):
+		// The remaining structs are undocumented and have neither a unit nor a data type.
+		v.unit, v.dataType = "", "DataTypeInvalid"
+	default:
+		if unit, ok := units[name]; ok {
+			v.unit = unit
+		} else {
+			for _, rule := range unitRules {
+				if rule.match(name) {
+					v.unit = rule.unit
+					break
+				}
+			}
+		}
+		if v.unit == "Bool" || v.unit == "Enum" || v.unit == "mask" || v.unit == "Bco16" {
+			v.dataType = "DataTypeInt32"
+		}
+	}
+
+	if !notIndexed[name] {
+		for _, p := range indexed {
+			if strings.HasPrefix(name, p) {
+				v.indexed = true
+				break
+			}
+		}
+	}
+
+	longest := 0
+	for p, category := range categories {
+		if len(p) > longest && strings.HasPrefix(name, p) {
+			v.category, longest = category, len(p)
+		}
+	}
+	return v
+}
+
+func set(names ...string) map[string]bool {
+	m := make(map[string]bool, len(names))
+	for _, name := range names {
+		m[name] = true
+	}
+	return m
+}
+
+func prefix(prefixes ...string) func(string) bool {
+	return func(name string) bool {
+		for _, p := range prefixes {
+			if strings.HasPrefix(name, p) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+func suffix(suffixes ...string) func(string) bool {
+	return func(name string) bool {
+		for _, s := range suffixes {
+			if strings.HasSuffix(name, s) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+func contains(parts ...string) func(string) bool {
+	return func(name string) bool {
+		for _, p := range parts {
+			if strings.Contains(name, p) {
+				return true
+			}
+		}
+		return false
+	}
+}
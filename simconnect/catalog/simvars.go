@@ -0,0 +1,1047 @@
+// Code generated by gen.go from references/simvars.txt; DO NOT EDIT.
+
+package catalog
+
+import "github.com/grumpypixel/msfs2020-simconnect-go/simconnect"
+
+var simVars = []SimVar{
+	{Name: "ANGLE OF ATTACK INDICATOR", Unit: "degrees", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Instruments},
+	{Name: "GUN AMMO", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Aircraft},
+	{Name: "CANNON AMMO", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Aircraft},
+	{Name: "ROCKET AMMO", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Aircraft},
+	{Name: "BOMB AMMO", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Aircraft},
+	{Name: "LIGHT ON STATES", Unit: "mask", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Lights},
+	{Name: "LIGHT STATES", Unit: "mask", DataType: simconnect.DataTypeInt32, Settable: true, Indexed: false, Category: Lights},
+	{Name: "LIGHT PANEL", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: true, Indexed: false, Category: Lights},
+	{Name: "LIGHT STROBE", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: true, Indexed: false, Category: Lights},
+	{Name: "LIGHT LANDING", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: true, Indexed: false, Category: Lights},
+	{Name: "STROBE FLASH", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Lights},
+	{Name: "LIGHT TAXI", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: true, Indexed: false, Category: Lights},
+	{Name: "LIGHT BEACON", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: true, Indexed: false, Category: Lights},
+	{Name: "LIGHT NAV", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: true, Indexed: false, Category: Lights},
+	{Name: "LIGHT LOGO", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: true, Indexed: false, Category: Lights},
+	{Name: "LIGHT WING", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: true, Indexed: false, Category: Lights},
+	{Name: "LIGHT RECOGNITION", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: true, Indexed: false, Category: Lights},
+	{Name: "LIGHT CABIN", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: true, Indexed: false, Category: Lights},
+	{Name: "LANDING LIGHT PBH", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Lights},
+	{Name: "LIGHT NAV ON", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Lights},
+	{Name: "LIGHT BEACON ON", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Lights},
+	{Name: "LIGHT LANDING ON", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Lights},
+	{Name: "LIGHT TAXI ON", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Lights},
+	{Name: "LIGHT STROBE ON", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Lights},
+	{Name: "LIGHT PANEL ON", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Lights},
+	{Name: "LIGHT RECOGNITION ON", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Lights},
+	{Name: "LIGHT WING ON", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Lights},
+	{Name: "LIGHT LOGO ON", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Lights},
+	{Name: "LIGHT CABIN ON", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Lights},
+	{Name: "LIGHT HEAD ON", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Lights},
+	{Name: "LIGHT BRAKE ON", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Lights},
+	{Name: "WHEEL RPM", Unit: "rpm", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Controls},
+	{Name: "CENTER WHEEL RPM", Unit: "rpm", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Controls},
+	{Name: "LEFT WHEEL RPM", Unit: "rpm", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Controls},
+	{Name: "RIGHT WHEEL RPM", Unit: "rpm", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Controls},
+	{Name: "AUX WHEEL RPM", Unit: "rpm", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Controls},
+	{Name: "WHEEL ROTATION ANGLE", Unit: "degrees", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Controls},
+	{Name: "CENTER WHEEL ROTATION ANGLE", Unit: "degrees", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Controls},
+	{Name: "LEFT WHEEL ROTATION ANGLE", Unit: "degrees", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Controls},
+	{Name: "RIGHT WHEEL ROTATION ANGLE", Unit: "degrees", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Controls},
+	{Name: "AUX WHEEL ROTATION ANGLE", Unit: "degrees", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Controls},
+	{Name: "SIGMA SQRT", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Flight},
+	{Name: "DYNAMIC PRESSURE", Unit: "psi", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Flight},
+	{Name: "TOTAL VELOCITY", Unit: "knots", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Flight},
+	{Name: "TOTAL WORLD VELOCITY", Unit: "feet per second", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Flight},
+	{Name: "GROUND VELOCITY", Unit: "knots", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Flight},
+	{Name: "SURFACE RELATIVE GROUND SPEED", Unit: "knots", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Flight},
+	{Name: "AIRSPEED TRUE", Unit: "knots", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Flight},
+	{Name: "AIRSPEED INDICATED", Unit: "knots", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Flight},
+	{Name: "AIRSPEED SELECT INDICATED OR TRUE", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Flight},
+	{Name: "AIRSPEED TRUE CALIBRATE", Unit: "feet per minute", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Flight},
+	{Name: "AIRSPEED BARBER POLE", Unit: "knots", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Flight},
+	{Name: "AIRSPEED MACH", Unit: "mach", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Flight},
+	{Name: "VERTICAL SPEED", Unit: "feet per minute", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Flight},
+	{Name: "VARIOMETER RATE", Unit: "feet per minute", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Instruments},
+	{Name: "VARIOMETER SWITCH", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Instruments},
+	{Name: "MACH MAX OPERATE", Unit: "mach", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Flight},
+	{Name: "STALL WARNING", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Instruments},
+	{Name: "OVERSPEED WARNING", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Instruments},
+	{Name: "BARBER POLE MACH", Unit: "mach", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Flight},
+	{Name: "VELOCITY BODY X", Unit: "feet per second", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: false, Category: Flight},
+	{Name: "VELOCITY BODY Y", Unit: "feet per second", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: false, Category: Flight},
+	{Name: "VELOCITY BODY Z", Unit: "feet per second", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: false, Category: Flight},
+	{Name: "VELOCITY WORLD X", Unit: "feet per second", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: false, Category: Flight},
+	{Name: "VELOCITY WORLD Y", Unit: "feet per second", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: false, Category: Flight},
+	{Name: "VELOCITY WORLD Z", Unit: "feet per second", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: false, Category: Flight},
+	{Name: "RELATIVE WIND VELOCITY BODY X", Unit: "feet per second", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Flight},
+	{Name: "RELATIVE WIND VELOCITY BODY Y", Unit: "feet per second", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Flight},
+	{Name: "RELATIVE WIND VELOCITY BODY Z", Unit: "feet per second", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Flight},
+	{Name: "ACCELERATION WORLD X", Unit: "feet per second squared", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: false, Category: Flight},
+	{Name: "ACCELERATION WORLD Y", Unit: "feet per second squared", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: false, Category: Flight},
+	{Name: "ACCELERATION WORLD Z", Unit: "feet per second squared", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: false, Category: Flight},
+	{Name: "ACCELERATION BODY X", Unit: "feet per second squared", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: false, Category: Flight},
+	{Name: "ACCELERATION BODY Y", Unit: "feet per second squared", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: false, Category: Flight},
+	{Name: "ACCELERATION BODY Z", Unit: "feet per second squared", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: false, Category: Flight},
+	{Name: "ROTATION VELOCITY BODY X", Unit: "degrees per second", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: false, Category: Flight},
+	{Name: "ROTATION VELOCITY BODY Y", Unit: "degrees per second", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: false, Category: Flight},
+	{Name: "ROTATION VELOCITY BODY Z", Unit: "degrees per second", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: false, Category: Flight},
+	{Name: "DESIGN SPEED VS0", Unit: "knots", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Aircraft},
+	{Name: "DESIGN SPEED VS1", Unit: "knots", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Aircraft},
+	{Name: "DESIGN SPEED VC", Unit: "knots", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Aircraft},
+	{Name: "DESIGN SPEED MIN ROTATION", Unit: "knots", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Aircraft},
+	{Name: "DESIGN SPEED CLIMB", Unit: "knots", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Aircraft},
+	{Name: "DESIGN CRUISE ALT", Unit: "feet", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Aircraft},
+	{Name: "DESIGN TAKEOFF SPEED", Unit: "knots", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Aircraft},
+	{Name: "AI CONTROLS", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Simulation},
+	{Name: "DELEGATE CONTROLS TO AI", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: false, Category: Simulation},
+	{Name: "MIN DRAG VELOCITY", Unit: "feet per second", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Flight},
+	{Name: "PLANE LATITUDE", Unit: "degrees", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: false, Category: Flight},
+	{Name: "PLANE LONGITUDE", Unit: "degrees", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: false, Category: Flight},
+	{Name: "PLANE ALTITUDE", Unit: "feet", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: false, Category: Flight},
+	{Name: "PLANE ALT ABOVE GROUND", Unit: "feet", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Flight},
+	{Name: "PLANE PITCH DEGREES", Unit: "degrees", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: false, Category: Flight},
+	{Name: "PLANE BANK DEGREES", Unit: "degrees", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: false, Category: Flight},
+	{Name: "PLANE HEADING DEGREES MAGNETIC", Unit: "degrees", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: false, Category: Flight},
+	{Name: "PLANE HEADING DEGREES TRUE", Unit: "degrees", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: false, Category: Flight},
+	{Name: "INDICATED ALTITUDE", Unit: "feet", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Instruments},
+	{Name: "PRESSURE ALTITUDE", Unit: "feet", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Flight},
+	{Name: "KOHLSMAN SETTING MB", Unit: "millibars", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: false, Category: Instruments},
+	{Name: "KOHLSMAN SETTING HG", Unit: "inHg", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: false, Category: Instruments},
+	{Name: "ATTITUDE INDICATOR PITCH DEGREES", Unit: "degrees", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Instruments},
+	{Name: "ATTITUDE INDICATOR BANK DEGREES", Unit: "degrees", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Instruments},
+	{Name: "ATTITUDE BARS POSITION", Unit: "percent over 100", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Instruments},
+	{Name: "ATTITUDE CAGE", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Instruments},
+	{Name: "MAGVAR", Unit: "degrees", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Navigation},
+	{Name: "WISKEY COMPASS INDICATION DEGREES", Unit: "degrees", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Instruments},
+	{Name: "MAGNETIC COMPASS", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Instruments},
+	{Name: "PLANE HEADING DEGREES GYRO", Unit: "degrees", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: false, Category: Flight},
+	{Name: "GYRO DRIFT ERROR", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: false, Category: Instruments},
+	{Name: "DELTA HEADING RATE", Unit: "degrees per second", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Flight},
+	{Name: "TURN INDICATOR RATE", Unit: "degrees per second", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Instruments},
+	{Name: "TURN INDICATOR SWITCH", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Instruments},
+	{Name: "GROUND ALTITUDE", Unit: "feet", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Environment},
+	{Name: "SIM ON GROUND", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Flight},
+	{Name: "SIM SHOULD SET ON GROUND", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Flight},
+	{Name: "TURN COORDINATOR BALL", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Instruments},
+	{Name: "YOKE Y POSITION", Unit: "position", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: false, Category: Controls},
+	{Name: "YOKE Y INDICATOR", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Controls},
+	{Name: "YOKE X POSITION", Unit: "position", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: false, Category: Controls},
+	{Name: "YOKE X INIDICATOR", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Controls},
+	{Name: "YOKE X INDICATOR", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Controls},
+	{Name: "AILERON POSITION", Unit: "position", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: false, Category: Controls},
+	{Name: "RUDDER PEDAL POSITION", Unit: "position", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: false, Category: Controls},
+	{Name: "RUDDER PEDAL INDICATOR", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Controls},
+	{Name: "RUDDER POSITION", Unit: "position", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: false, Category: Controls},
+	{Name: "ELEVATOR POSITION", Unit: "position", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: false, Category: Controls},
+	{Name: "ELEVATOR TRIM POSITION", Unit: "radians", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: false, Category: Controls},
+	{Name: "ELEVATOR TRIM INDICATOR", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Controls},
+	{Name: "ELEVATOR TRIM PCT", Unit: "percent", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: false, Category: Controls},
+	{Name: "BRAKE LEFT POSITION", Unit: "position", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: false, Category: Controls},
+	{Name: "BRAKE RIGHT POSITION", Unit: "position", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: false, Category: Controls},
+	{Name: "BRAKE INDICATOR", Unit: "position", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Controls},
+	{Name: "BRAKE PARKING POSITION", Unit: "position", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: false, Category: Controls},
+	{Name: "BRAKE PARKING INDICATOR", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Controls},
+	{Name: "BRAKE DEPENDENT HYDRAULIC PRESSURE", Unit: "psi", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Controls},
+	{Name: "SPOILERS ARMED", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: true, Indexed: false, Category: Controls},
+	{Name: "SPOILERS HANDLE POSITION", Unit: "percent over 100", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: false, Category: Controls},
+	{Name: "SPOILERS LEFT POSITION", Unit: "percent over 100", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: false, Category: Controls},
+	{Name: "SPOILERS RIGHT POSITION", Unit: "percent over 100", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: false, Category: Controls},
+	{Name: "FLY BY WIRE ELAC SWITCH", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Autopilot},
+	{Name: "FLY BY WIRE FAC SWITCH", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Autopilot},
+	{Name: "FLY BY WIRE SEC SWITCH", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Autopilot},
+	{Name: "FLY BY WIRE ELAC FAILED", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Autopilot},
+	{Name: "FLY BY WIRE FAC FAILED", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Autopilot},
+	{Name: "FLY BY WIRE SEC FAILED", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Autopilot},
+	{Name: "FLY BY WIRE ALPHA PROTECTION", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Autopilot},
+	{Name: "FLAPS NUM HANDLE POSITIONS", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Controls},
+	{Name: "FLAPS HANDLE PERCENT", Unit: "percent", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: false, Category: Controls},
+	{Name: "FLAPS HANDLE INDEX", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: false, Category: Controls},
+	{Name: "TRAILING EDGE FLAPS LEFT PERCENT", Unit: "percent", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: false, Category: Controls},
+	{Name: "TRAILING EDGE FLAPS RIGHT PERCENT", Unit: "percent", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: false, Category: Controls},
+	{Name: "LEADING EDGE FLAPS LEFT PERCENT", Unit: "percent", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: false, Category: Controls},
+	{Name: "LEADING EDGE FLAPS RIGHT PERCENT", Unit: "percent", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: false, Category: Controls},
+	{Name: "TRAILING EDGE FLAPS LEFT ANGLE", Unit: "degrees", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Controls},
+	{Name: "TRAILING EDGE FLAPS RIGHT ANGLE", Unit: "degrees", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Controls},
+	{Name: "LEADING EDGE FLAPS LEFT ANGLE", Unit: "degrees", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Controls},
+	{Name: "LEADING EDGE FLAPS RIGHT ANGLE", Unit: "degrees", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Controls},
+	{Name: "FLAP POSITION SET", Unit: "percent over 100", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Controls},
+	{Name: "IS GEAR RETRACTABLE", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Controls},
+	{Name: "IS GEAR WHEELS", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Controls},
+	{Name: "IS GEAR SKIS", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Controls},
+	{Name: "IS GEAR FLOATS", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Controls},
+	{Name: "IS GEAR SKIDS", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Controls},
+	{Name: "GEAR HANDLE POSITION", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: true, Indexed: false, Category: Controls},
+	{Name: "GEAR EMERGENCY HANDLE POSITION", Unit: "percent over 100", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Controls},
+	{Name: "GEAR CENTER POSITION", Unit: "percent over 100", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: false, Category: Controls},
+	{Name: "GEAR LEFT POSITION", Unit: "percent over 100", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: false, Category: Controls},
+	{Name: "GEAR RIGHT POSITION", Unit: "percent over 100", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: false, Category: Controls},
+	{Name: "GEAR TAIL POSITION", Unit: "percent over 100", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: false, Category: Controls},
+	{Name: "GEAR AUX POSITION", Unit: "percent over 100", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: false, Category: Controls},
+	{Name: "GEAR POSITION", Unit: "percent over 100", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Controls},
+	{Name: "GEAR ANIMATION POSITION", Unit: "percent over 100", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Controls},
+	{Name: "GEAR TOTAL PCT EXTENDED", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Controls},
+	{Name: "GEAR WARNING", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Controls},
+	{Name: "TAILWHEEL LOCK ON", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Controls},
+	{Name: "NOSEWHEEL LOCK ON", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Controls},
+	{Name: "COWL FLAPS", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Engines},
+	{Name: "AVIONICS MASTER SWITCH", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: true, Indexed: false, Category: Electrical},
+	{Name: "PANEL AUTO FEATHER SWITCH", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Engines},
+	{Name: "PANEL ANTI ICE SWITCH", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: true, Indexed: false, Category: Systems},
+	{Name: "AUTO BRAKE SWITCH CB", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Controls},
+	{Name: "ANTISKID BRAKES ACTIVE", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Controls},
+	{Name: "WATER RUDDER HANDLE POSITION", Unit: "percent over 100", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: false, Category: Controls},
+	{Name: "WATER LEFT RUDDER EXTENDED", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Controls},
+	{Name: "WATER RIGHT RUDDER EXTENDED", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Controls},
+	{Name: "RETRACT FLOAT SWITCH", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Controls},
+	{Name: "RETRACT LEFT FLOAT EXTENDED", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Controls},
+	{Name: "RETRACT RIGHT FLOAT EXTENDED", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Controls},
+	{Name: "GEAR CENTER STEER ANGLE", Unit: "degrees", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Controls},
+	{Name: "GEAR LEFT STEER ANGLE", Unit: "degrees", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Controls},
+	{Name: "GEAR RIGHT STEER ANGLE", Unit: "degrees", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Controls},
+	{Name: "GEAR AUX STEER ANGLE", Unit: "degrees", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Controls},
+	{Name: "GEAR STEER ANGLE", Unit: "degrees", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Controls},
+	{Name: "WATER LEFT RUDDER STEER ANGLE", Unit: "degrees", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Controls},
+	{Name: "WATER RIGHT RUDDER STEER ANGLE", Unit: "degrees", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Controls},
+	{Name: "GEAR CENTER STEER ANGLE PCT", Unit: "percent", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Controls},
+	{Name: "GEAR LEFT STEER ANGLE PCT", Unit: "percent", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Controls},
+	{Name: "GEAR RIGHT STEER ANGLE PCT", Unit: "percent", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Controls},
+	{Name: "GEAR AUX STEER ANGLE PCT", Unit: "percent", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Controls},
+	{Name: "GEAR STEER ANGLE PCT", Unit: "percent", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Controls},
+	{Name: "WATER LEFT RUDDER STEER ANGLE PCT", Unit: "percent", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Controls},
+	{Name: "WATER RIGHT RUDDER STEER ANGLE PCT", Unit: "percent", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Controls},
+	{Name: "STEER INPUT CONTROL", Unit: "percent over 100", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: false, Category: Controls},
+	{Name: "ELEVATOR DEFLECTION", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Controls},
+	{Name: "ELEVATOR DEFLECTION PCT", Unit: "percent", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Controls},
+	{Name: "AILERON LEFT DEFLECTION", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Controls},
+	{Name: "AILERON LEFT DEFLECTION PCT", Unit: "percent", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Controls},
+	{Name: "AILERON RIGHT DEFLECTION", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Controls},
+	{Name: "AILERON RIGHT DEFLECTION PCT", Unit: "percent", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Controls},
+	{Name: "AILERON AVERAGE DEFLECTION", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Controls},
+	{Name: "AILERON TRIM", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: false, Category: Controls},
+	{Name: "AILERON TRIM PCT", Unit: "percent", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: false, Category: Controls},
+	{Name: "RUDDER DEFLECTION", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Controls},
+	{Name: "RUDDER DEFLECTION PCT", Unit: "percent", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Controls},
+	{Name: "RUDDER TRIM", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: false, Category: Controls},
+	{Name: "RUDDER TRIM PCT", Unit: "percent", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: false, Category: Controls},
+	{Name: "WING FLEX PCT", Unit: "percent", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: false, Category: Aircraft},
+	{Name: "WING AREA", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Aircraft},
+	{Name: "WING SPAN", Unit: "feet", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Aircraft},
+	{Name: "PROP SYNC ACTIVE", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: true, Category: Engines},
+	{Name: "INCIDENCE ALPHA", Unit: "degrees", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Flight},
+	{Name: "INCIDENCE BETA", Unit: "degrees", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Flight},
+	{Name: "BETA DOT", Unit: "degrees", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Flight},
+	{Name: "LINEAR CL ALPHA", Unit: "degrees", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Aircraft},
+	{Name: "STALL ALPHA", Unit: "degrees", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Aircraft},
+	{Name: "ZERO LIFT ALPHA", Unit: "degrees", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Aircraft},
+	{Name: "CG PERCENT", Unit: "percent", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Aircraft},
+	{Name: "CG PERCENT LATERAL", Unit: "percent", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Aircraft},
+	{Name: "CG AFT LIMIT", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Aircraft},
+	{Name: "CG FWD LIMIT", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Aircraft},
+	{Name: "CG MAX MACH", Unit: "mach", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Aircraft},
+	{Name: "CG MIN MACH", Unit: "mach", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Aircraft},
+	{Name: "PAYLOAD STATION WEIGHT", Unit: "pounds", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: true, Category: Aircraft},
+	{Name: "PAYLOAD STATION NAME", Unit: "", DataType: simconnect.DataTypeString256, Settable: false, Indexed: true, Category: Aircraft},
+	{Name: "PAYLOAD STATION COUNT", Unit: "amperes", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Aircraft},
+	{Name: "PAYLOAD STATION OBJECT", Unit: "amperes", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Aircraft},
+	{Name: "PAYLOAD STATION NUM SIMOBJECTS", Unit: "amperes", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Aircraft},
+	{Name: "ELEVON DEFLECTION", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Controls},
+	{Name: "FOLDING WING LEFT PERCENT", Unit: "percent", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: false, Category: Controls},
+	{Name: "FOLDING WING RIGHT PERCENT", Unit: "percent", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: false, Category: Controls},
+	{Name: "FOLDING WING HANDLE POSITION", Unit: "percent over 100", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: false, Category: Controls},
+	{Name: "CANOPY OPEN", Unit: "percent", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: false, Category: Controls},
+	{Name: "TAILHOOK POSITION", Unit: "percent over 100", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: false, Category: Controls},
+	{Name: "TAILHOOK HANDLE", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Controls},
+	{Name: "LAUNCHBAR POSITION", Unit: "percent over 100", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Controls},
+	{Name: "LAUNCHBAR SWITCH", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: true, Indexed: false, Category: Controls},
+	{Name: "LAUNCHBAR HELD EXTENDED", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Controls},
+	{Name: "EXIT OPEN", Unit: "percent", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: true, Category: Controls},
+	{Name: "EXIT TYPE", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Controls},
+	{Name: "EXIT POSX", Unit: "feet", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Controls},
+	{Name: "EXIT POSY", Unit: "feet", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Controls},
+	{Name: "EXIT POSZ", Unit: "feet", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Controls},
+	{Name: "RADIO HEIGHT", Unit: "feet", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Instruments},
+	{Name: "DECISION HEIGHT", Unit: "feet", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: false, Category: Instruments},
+	{Name: "DECISION ALTITUDE MSL", Unit: "feet", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: false, Category: Instruments},
+	{Name: "TOTAL WEIGHT", Unit: "pounds", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Aircraft},
+	{Name: "MAX GROSS WEIGHT", Unit: "pounds", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Aircraft},
+	{Name: "EMPTY WEIGHT", Unit: "pounds", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Aircraft},
+	{Name: "EMPTY WEIGHT PITCH MOI", Unit: "slug feet squared", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Aircraft},
+	{Name: "EMPTY WEIGHT ROLL MOI", Unit: "slug feet squared", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Aircraft},
+	{Name: "EMPTY WEIGHT YAW MOI", Unit: "slug feet squared", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Aircraft},
+	{Name: "EMPTY WEIGHT CROSS COUPLED MOI", Unit: "slug feet squared", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Aircraft},
+	{Name: "TOTAL WEIGHT PITCH MOI", Unit: "slug feet squared", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Aircraft},
+	{Name: "TOTAL WEIGHT ROLL MOI", Unit: "slug feet squared", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Aircraft},
+	{Name: "TOTAL WEIGHT YAW MOI", Unit: "slug feet squared", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Aircraft},
+	{Name: "TOTAL WEIGHT CROSS COUPLED MOI", Unit: "slug feet squared", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Aircraft},
+	{Name: "WATER BALLAST VALVE", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: false, Category: Controls},
+	{Name: "AUTOPILOT MASTER", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Autopilot},
+	{Name: "AUTOPILOT WING LEVELER", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Autopilot},
+	{Name: "AUTOPILOT NAV1 LOCK", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Autopilot},
+	{Name: "AUTOPILOT HEADING LOCK", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Autopilot},
+	{Name: "AUTOPILOT HEADING LOCK DIR", Unit: "degrees", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: false, Category: Autopilot},
+	{Name: "AUTOPILOT ALTITUDE LOCK", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Autopilot},
+	{Name: "AUTOPILOT ALTITUDE LOCK VAR", Unit: "feet", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: false, Category: Autopilot},
+	{Name: "AUTOPILOT ATTITUDE HOLD", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Autopilot},
+	{Name: "AUTOPILOT GLIDESLOPE HOLD", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Autopilot},
+	{Name: "AUTOPILOT APPROACH HOLD", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Autopilot},
+	{Name: "AUTOPILOT BACKCOURSE HOLD", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Autopilot},
+	{Name: "AUTOPILOT YAW DAMPER", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Autopilot},
+	{Name: "AUTOPILOT AIRSPEED HOLD", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Autopilot},
+	{Name: "AUTOPILOT AIRSPEED HOLD VAR", Unit: "knots", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: false, Category: Autopilot},
+	{Name: "AUTOPILOT MACH HOLD", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Autopilot},
+	{Name: "AUTOPILOT MACH HOLD VAR", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: false, Category: Autopilot},
+	{Name: "AUTOPILOT VERTICAL HOLD", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Autopilot},
+	{Name: "AUTOPILOT VERTICAL HOLD VAR", Unit: "feet per minute", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: false, Category: Autopilot},
+	{Name: "AUTOPILOT ALTITUDE MANUALLY TUNABLE", Unit: "feet", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Autopilot},
+	{Name: "AUTOPILOT HEADING MANUALLY TUNABLE", Unit: "degrees", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Autopilot},
+	{Name: "AUTOPILOT THROTTLE ARM", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Autopilot},
+	{Name: "AUTOPILOT TAKEOFF POWER ACTIVE", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Autopilot},
+	{Name: "AUTOPILOT RPM HOLD", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Autopilot},
+	{Name: "AUTOPILOT RPM HOLD VAR", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: false, Category: Autopilot},
+	{Name: "AUTOPILOT SPEED SETTING", Unit: "knots", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Autopilot},
+	{Name: "AUTOPILOT AIRSPEED ACQUISITION", Unit: "knots", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Autopilot},
+	{Name: "AUTOPILOT AIRSPEED HOLD CURRENT", Unit: "knots", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Autopilot},
+	{Name: "AUTOPILOT MAX SPEED HOLD", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Autopilot},
+	{Name: "AUTOPILOT CRUISE SPEED HOLD", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Autopilot},
+	{Name: "AUTOPILOT FLIGHT DIRECTOR ACTIVE", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Autopilot},
+	{Name: "AUTOPILOT FLIGHT DIRECTOR PITCH", Unit: "degrees", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Autopilot},
+	{Name: "AUTOPILOT FLIGHT DIRECTOR BANK", Unit: "degrees", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Autopilot},
+	{Name: "AUTOPILOT PITCH HOLD", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Autopilot},
+	{Name: "AUTOPILOT PITCH HOLD REF", Unit: "degrees", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: false, Category: Autopilot},
+	{Name: "AUTOPILOT NAV SELECTED", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Autopilot},
+	{Name: "GPS DRIVES NAV1", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Autopilot},
+	{Name: "AUTOTHROTTLE ACTIVE", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Autopilot},
+	{Name: "AUTOPILOT MAX BANK", Unit: "degrees", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Autopilot},
+	{Name: "NUMBER OF CATAPULTS", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Controls},
+	{Name: "HOLDBACK BAR INSTALLED", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Controls},
+	{Name: "BLAST SHIELD POSITION", Unit: "percent over 100", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Controls},
+	{Name: "CATAPULT STROKE POSITION", Unit: "percent over 100", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Controls},
+	{Name: "ENGINE CONTROL SELECT", Unit: "mask", DataType: simconnect.DataTypeInt32, Settable: true, Indexed: false, Category: Engines},
+	{Name: "NUMBER OF ENGINES", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Engines},
+	{Name: "MAX RATED ENGINE RPM", Unit: "rpm", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Engines},
+	{Name: "PROPELLER ADVANCED SELECTION", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Engines},
+	{Name: "THROTTLE LOWER LIMIT", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Aircraft},
+	{Name: "OIL AMOUNT", Unit: "gallons", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Engines},
+	{Name: "ENGINE PRIMER", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Engines},
+	{Name: "ENGINE TYPE", Unit: "Enum", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Engines},
+	{Name: "ENG RPM ANIMATION PERCENT", Unit: "percent", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Engines},
+	{Name: "FULL THROTTLE THRUST TO WEIGHT RATIO", Unit: "pounds", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Engines},
+	{Name: "PROP RPM", Unit: "rpm", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: true, Category: Engines},
+	{Name: "PROP MAX RPM PERCENT", Unit: "percent", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Engines},
+	{Name: "PROP THRUST", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Engines},
+	{Name: "PROP BETA", Unit: "degrees", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Engines},
+	{Name: "PROP FEATHERING INHIBIT", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: true, Category: Engines},
+	{Name: "PROP FEATHERED", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: true, Category: Engines},
+	{Name: "PROP SYNC DELTA LEVER", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Engines},
+	{Name: "PROP AUTO FEATHER ARMED", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: true, Category: Engines},
+	{Name: "PROP FEATHER SWITCH", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: true, Category: Engines},
+	{Name: "PROP AUTO CRUISE ACTIVE", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: true, Category: Engines},
+	{Name: "PROP ROTATION ANGLE", Unit: "degrees", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Engines},
+	{Name: "PROP BETA MAX", Unit: "degrees", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Engines},
+	{Name: "PROP BETA MIN", Unit: "degrees", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Engines},
+	{Name: "PROP BETA MIN REVERSE", Unit: "degrees", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Engines},
+	{Name: "MASTER IGNITION SWITCH", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Engines},
+	{Name: "ENG COMBUSTION", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: true, Indexed: true, Category: Engines},
+	{Name: "OLD ENG STARTER", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Engines},
+	{Name: "ENG N1 RPM", Unit: "rpm", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Engines},
+	{Name: "ENG N2 RPM", Unit: "rpm", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Engines},
+	{Name: "ENG FUEL FLOW GPH", Unit: "gallons per hour", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Engines},
+	{Name: "ENG FUEL FLOW PPH", Unit: "pounds per hour", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Engines},
+	{Name: "ENG FUEL FLOW PPH SSL", Unit: "pounds per hour", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Engines},
+	{Name: "ENG TORQUE", Unit: "foot pounds", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Engines},
+	{Name: "ENG ANTI ICE", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: true, Indexed: true, Category: Engines},
+	{Name: "ENG PRESSURE RATIO", Unit: "psi", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Engines},
+	{Name: "ENG PRESSURE RATIO GES", Unit: "psi", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Engines},
+	{Name: "ENG EXHAUST GAS TEMPERATURE", Unit: "celsius", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Engines},
+	{Name: "ENG EXHAUST GAS TEMPERATURE GES", Unit: "celsius", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Engines},
+	{Name: "ENG CYLINDER HEAD TEMPERATURE", Unit: "celsius", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Engines},
+	{Name: "ENG OIL TEMPERATURE", Unit: "celsius", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Engines},
+	{Name: "ENG OIL PRESSURE", Unit: "psi", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Engines},
+	{Name: "ENG OIL QUANTITY", Unit: "gallons", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Engines},
+	{Name: "ENG HYDRAULIC PRESSURE", Unit: "psi", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Engines},
+	{Name: "ENG HYDRAULIC QUANTITY", Unit: "gallons", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Engines},
+	{Name: "ENG MANIFOLD PRESSURE", Unit: "inches of mercury", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Engines},
+	{Name: "ENG VIBRATION", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Engines},
+	{Name: "ENG RPM SCALER", Unit: "rpm", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Engines},
+	{Name: "ENG TURBINE TEMPERATURE", Unit: "celsius", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Engines},
+	{Name: "ENG TORQUE PERCENT", Unit: "percent", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Engines},
+	{Name: "ENG FUEL PRESSURE", Unit: "psi", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Engines},
+	{Name: "ENG ELECTRICAL LOAD", Unit: "amperes", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Engines},
+	{Name: "ENG TRANSMISSION PRESSURE", Unit: "psi", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Engines},
+	{Name: "ENG TRANSMISSION TEMPERATURE", Unit: "celsius", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Engines},
+	{Name: "ENG ROTOR RPM", Unit: "rpm", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Engines},
+	{Name: "ENG FUEL FLOW BUG POSITION", Unit: "pounds per hour", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Engines},
+	{Name: "ENG MAX RPM", Unit: "rpm", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Engines},
+	{Name: "ENG ON FIRE", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: true, Indexed: true, Category: Engines},
+	{Name: "GENERAL ENG COMBUSTION", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: true, Indexed: true, Category: Engines},
+	{Name: "GENERAL ENG MASTER ALTERNATOR", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: true, Category: Engines},
+	{Name: "GENERAL ENG FUEL PUMP SWITCH", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: true, Indexed: true, Category: Engines},
+	{Name: "GENERAL ENG FUEL PUMP ON", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: true, Category: Engines},
+	{Name: "GENERAL ENG RPM", Unit: "rpm", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Engines},
+	{Name: "GENERAL ENG PCT MAX RPM", Unit: "percent", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Engines},
+	{Name: "GENERAL ENG MAX REACHED RPM", Unit: "rpm", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Engines},
+	{Name: "GENERAL ENG THROTTLE LEVER POSITION", Unit: "percent over 100", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: true, Category: Engines},
+	{Name: "GENERAL ENG MIXTURE LEVER POSITION", Unit: "percent over 100", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: true, Category: Engines},
+	{Name: "GENERAL ENG PROPELLER LEVER POSITION", Unit: "percent over 100", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: true, Category: Engines},
+	{Name: "GENERAL ENG STARTER", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: true, Indexed: true, Category: Engines},
+	{Name: "GENERAL ENG STARTER ACTIVE", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: true, Category: Engines},
+	{Name: "GENERAL ENG EXHAUST GAS TEMPERATURE", Unit: "celsius", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Engines},
+	{Name: "GENERAL ENG OIL PRESSURE", Unit: "psi", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Engines},
+	{Name: "GENERAL ENG OIL LEAKED PERCENT", Unit: "percent", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Engines},
+	{Name: "GENERAL ENG COMBUSTION SOUND PERCENT", Unit: "percent", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Engines},
+	{Name: "GENERAL ENG DAMAGE PERCENT", Unit: "percent", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: true, Category: Engines},
+	{Name: "GENERAL ENG OIL TEMPERATURE", Unit: "celsius", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Engines},
+	{Name: "GENERAL ENG FAILED", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: true, Category: Engines},
+	{Name: "GENERAL ENG GENERATOR SWITCH", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: true, Indexed: true, Category: Engines},
+	{Name: "GENERAL ENG GENERATOR ACTIVE", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: true, Category: Engines},
+	{Name: "GENERAL ENG ANTI ICE POSITION", Unit: "percent over 100", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: true, Category: Engines},
+	{Name: "GENERAL ENG FUEL VALVE", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: true, Category: Engines},
+	{Name: "GENERAL ENG FUEL PRESSURE", Unit: "psi", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Engines},
+	{Name: "GENERAL ENG ELAPSED TIME", Unit: "hours", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Engines},
+	{Name: "GENERAL ENG FIRE DETECTED", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: true, Category: Engines},
+	{Name: "GENERAL ENG FUEL USED SINCE START", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Engines},
+	{Name: "RECIP ENG COWL FLAP POSITION", Unit: "percent over 100", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: true, Category: Engines},
+	{Name: "RECIP ENG PRIMER", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: true, Category: Engines},
+	{Name: "RECIP ENG MANIFOLD PRESSURE", Unit: "psi", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Engines},
+	{Name: "RECIP ENG ALTERNATE AIR POSITION", Unit: "percent over 100", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: true, Category: Engines},
+	{Name: "RECIP ENG COOLANT RESERVOIR PERCENT", Unit: "percent", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Engines},
+	{Name: "RECIP ENG LEFT MAGNETO", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: true, Category: Engines},
+	{Name: "RECIP ENG RIGHT MAGNETO", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: true, Category: Engines},
+	{Name: "RECIP ENG BRAKE POWER", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Engines},
+	{Name: "RECIP ENG STARTER TORQUE", Unit: "foot pounds", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Engines},
+	{Name: "RECIP ENG TURBOCHARGER FAILED", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: true, Category: Engines},
+	{Name: "RECIP ENG EMERGENCY BOOST ACTIVE", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: true, Category: Engines},
+	{Name: "RECIP ENG EMERGENCY BOOST ELAPSED TIME", Unit: "seconds", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Engines},
+	{Name: "RECIP ENG WASTEGATE POSITION", Unit: "percent over 100", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Engines},
+	{Name: "RECIP ENG TURBINE INLET TEMPERATURE", Unit: "celsius", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Engines},
+	{Name: "RECIP ENG CYLINDER HEAD TEMPERATURE", Unit: "celsius", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Engines},
+	{Name: "RECIP ENG RADIATOR TEMPERATURE", Unit: "celsius", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Engines},
+	{Name: "RECIP ENG FUEL AVAILABLE", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: true, Category: Engines},
+	{Name: "RECIP ENG FUEL FLOW", Unit: "pounds per hour", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Engines},
+	{Name: "RECIP ENG FUEL TANK SELECTOR", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Engines},
+	{Name: "RECIP ENG FUEL TANKS USED", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Engines},
+	{Name: "RECIP ENG FUEL NUMBER TANKS USED", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Engines},
+	{Name: "RECIP ENG DETONATING", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Engines},
+	{Name: "RECIP ENG CYLINDER HEALTH", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Engines},
+	{Name: "RECIP ENG NUM CYLINDERS", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Engines},
+	{Name: "RECIP ENG NUM CYLINDERS FAILED", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: true, Category: Engines},
+	{Name: "RECIP CARBURETOR TEMPERATURE", Unit: "celsius", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Engines},
+	{Name: "RECIP MIXTURE RATIO", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: true, Category: Engines},
+	{Name: "RECIP ENG ANTIDETONATION TANK VALVE", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Engines},
+	{Name: "RECIP ENG ANTIDETONATION TANK QUANTITY", Unit: "gallons", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Engines},
+	{Name: "RECIP ENG ANTIDETONATION TANK MAX QUANTITY", Unit: "gallons", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Engines},
+	{Name: "RECIP ENG NITROUS TANK VALVE", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Engines},
+	{Name: "RECIP ENG NITROUS TANK QUANTITY", Unit: "gallons", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Engines},
+	{Name: "RECIP ENG NITROUS TANK MAX QUANTITY", Unit: "gallons", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Engines},
+	{Name: "TURB ENG N1", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: true, Category: Engines},
+	{Name: "TURB ENG N2", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: true, Category: Engines},
+	{Name: "TURB ENG CORRECTED N1", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Engines},
+	{Name: "TURB ENG CORRECTED N2", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Engines},
+	{Name: "TURB ENG CORRECTED FF", Unit: "pounds per hour", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Engines},
+	{Name: "TURB ENG MAX TORQUE PERCENT", Unit: "percent", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Engines},
+	{Name: "TURB ENG PRESSURE RATIO", Unit: "psi", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Engines},
+	{Name: "TURB ENG ITT", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Engines},
+	{Name: "TURB ENG AFTERBURNER", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: true, Category: Engines},
+	{Name: "TURB ENG AFTERBURNER STAGE ACTIVE", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: true, Category: Engines},
+	{Name: "TURB ENG AFTERBURNER PCT ACTIVE", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: true, Category: Engines},
+	{Name: "TURB ENG JET THRUST", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Engines},
+	{Name: "TURB ENG BLEED AIR", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Engines},
+	{Name: "TURB ENG TANK SELECTOR", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Engines},
+	{Name: "TURB ENG TANKS USED", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Engines},
+	{Name: "TURB ENG NUM TANKS USED", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Engines},
+	{Name: "TURB ENG FUEL FLOW PPH", Unit: "pounds per hour", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Engines},
+	{Name: "TURB ENG FUEL AVAILABLE", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: true, Category: Engines},
+	{Name: "TURB ENG PRIMARY NOZZLE PERCENT", Unit: "percent", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: true, Category: Engines},
+	{Name: "TURB ENG REVERSE NOZZLE PERCENT", Unit: "percent", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Engines},
+	{Name: "TURB ENG VIBRATION", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Engines},
+	{Name: "TURB ENG IGNITION SWITCH", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: true, Indexed: true, Category: Engines},
+	{Name: "TURB ENG MASTER STARTER SWITCH", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: true, Indexed: true, Category: Engines},
+	{Name: "ENG FAILED", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: true, Category: Engines},
+	{Name: "PARTIAL PANEL ADF", Unit: "Enum", DataType: simconnect.DataTypeInt32, Settable: true, Indexed: false, Category: Instruments},
+	{Name: "PARTIAL PANEL AIRSPEED", Unit: "Enum", DataType: simconnect.DataTypeInt32, Settable: true, Indexed: false, Category: Instruments},
+	{Name: "PARTIAL PANEL ALTIMETER", Unit: "Enum", DataType: simconnect.DataTypeInt32, Settable: true, Indexed: false, Category: Instruments},
+	{Name: "PARTIAL PANEL ATTITUDE", Unit: "Enum", DataType: simconnect.DataTypeInt32, Settable: true, Indexed: false, Category: Instruments},
+	{Name: "PARTIAL PANEL COMM", Unit: "Enum", DataType: simconnect.DataTypeInt32, Settable: true, Indexed: false, Category: Instruments},
+	{Name: "PARTIAL PANEL COMPASS", Unit: "Enum", DataType: simconnect.DataTypeInt32, Settable: true, Indexed: false, Category: Instruments},
+	{Name: "PARTIAL PANEL ELECTRICAL", Unit: "Enum", DataType: simconnect.DataTypeInt32, Settable: true, Indexed: false, Category: Instruments},
+	{Name: "PARTIAL PANEL AVIONICS", Unit: "Enum", DataType: simconnect.DataTypeInt32, Settable: true, Indexed: false, Category: Instruments},
+	{Name: "PARTIAL PANEL ENGINE", Unit: "Enum", DataType: simconnect.DataTypeInt32, Settable: true, Indexed: false, Category: Instruments},
+	{Name: "PARTIAL PANEL FUEL INDICATOR", Unit: "Enum", DataType: simconnect.DataTypeInt32, Settable: true, Indexed: false, Category: Instruments},
+	{Name: "PARTIAL PANEL HEADING", Unit: "Enum", DataType: simconnect.DataTypeInt32, Settable: true, Indexed: false, Category: Instruments},
+	{Name: "PARTIAL PANEL VERTICAL VELOCITY", Unit: "Enum", DataType: simconnect.DataTypeInt32, Settable: true, Indexed: false, Category: Instruments},
+	{Name: "PARTIAL PANEL TRANSPONDER", Unit: "Enum", DataType: simconnect.DataTypeInt32, Settable: true, Indexed: false, Category: Instruments},
+	{Name: "PARTIAL PANEL NAV", Unit: "Enum", DataType: simconnect.DataTypeInt32, Settable: true, Indexed: false, Category: Instruments},
+	{Name: "PARTIAL PANEL PITOT", Unit: "Enum", DataType: simconnect.DataTypeInt32, Settable: true, Indexed: false, Category: Instruments},
+	{Name: "PARTIAL PANEL TURN COORDINATOR", Unit: "Enum", DataType: simconnect.DataTypeInt32, Settable: true, Indexed: false, Category: Instruments},
+	{Name: "PARTIAL PANEL VACUUM", Unit: "Enum", DataType: simconnect.DataTypeInt32, Settable: true, Indexed: false, Category: Instruments},
+	{Name: "FUEL TANK CENTER LEVEL", Unit: "percent over 100", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: false, Category: Fuel},
+	{Name: "FUEL TANK CENTER CAPACITY", Unit: "gallons", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Fuel},
+	{Name: "FUEL TANK CENTER QUANTITY", Unit: "gallons", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: false, Category: Fuel},
+	{Name: "FUEL TANK CENTER2 LEVEL", Unit: "percent over 100", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: false, Category: Fuel},
+	{Name: "FUEL TANK CENTER2 CAPACITY", Unit: "gallons", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Fuel},
+	{Name: "FUEL TANK CENTER2 QUANTITY", Unit: "gallons", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: false, Category: Fuel},
+	{Name: "FUEL TANK CENTER3 LEVEL", Unit: "percent over 100", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: false, Category: Fuel},
+	{Name: "FUEL TANK CENTER3 CAPACITY", Unit: "gallons", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Fuel},
+	{Name: "FUEL TANK CENTER3 QUANTITY", Unit: "gallons", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: false, Category: Fuel},
+	{Name: "FUEL TANK LEFT MAIN LEVEL", Unit: "percent over 100", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: false, Category: Fuel},
+	{Name: "FUEL TANK LEFT MAIN CAPACITY", Unit: "gallons", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Fuel},
+	{Name: "FUEL TANK LEFT MAIN QUANTITY", Unit: "gallons", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: false, Category: Fuel},
+	{Name: "FUEL TANK LEFT AUX LEVEL", Unit: "percent over 100", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: false, Category: Fuel},
+	{Name: "FUEL TANK LEFT AUX CAPACITY", Unit: "gallons", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Fuel},
+	{Name: "FUEL TANK LEFT AUX QUANTITY", Unit: "gallons", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: false, Category: Fuel},
+	{Name: "FUEL TANK LEFT TIP LEVEL", Unit: "percent over 100", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: false, Category: Fuel},
+	{Name: "FUEL TANK LEFT TIP CAPACITY", Unit: "gallons", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Fuel},
+	{Name: "FUEL TANK LEFT TIP QUANTITY", Unit: "gallons", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: false, Category: Fuel},
+	{Name: "FUEL LEFT QUANTITY", Unit: "gallons", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Fuel},
+	{Name: "FUEL TANK RIGHT MAIN LEVEL", Unit: "percent over 100", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: false, Category: Fuel},
+	{Name: "FUEL TANK RIGHT MAIN CAPACITY", Unit: "gallons", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Fuel},
+	{Name: "FUEL TANK RIGHT MAIN QUANTITY", Unit: "gallons", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: false, Category: Fuel},
+	{Name: "FUEL TANK RIGHT AUX LEVEL", Unit: "percent over 100", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: false, Category: Fuel},
+	{Name: "FUEL TANK RIGHT AUX CAPACITY", Unit: "gallons", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Fuel},
+	{Name: "FUEL TANK RIGHT AUX QUANTITY", Unit: "gallons", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: false, Category: Fuel},
+	{Name: "FUEL TANK RIGHT TIP LEVEL", Unit: "percent over 100", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: false, Category: Fuel},
+	{Name: "FUEL TANK RIGHT TIP CAPACITY", Unit: "gallons", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Fuel},
+	{Name: "FUEL TANK RIGHT TIP QUANTITY", Unit: "gallons", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: false, Category: Fuel},
+	{Name: "FUEL RIGHT QUANTITY", Unit: "gallons", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Fuel},
+	{Name: "FUEL TANK EXTERNAL1 LEVEL", Unit: "percent over 100", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: false, Category: Fuel},
+	{Name: "FUEL TANK EXTERNAL1 CAPACITY", Unit: "gallons", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Fuel},
+	{Name: "FUEL TANK EXTERNAL1 QUANTITY", Unit: "gallons", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: false, Category: Fuel},
+	{Name: "FUEL TANK EXTERNAL2 LEVEL", Unit: "percent over 100", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: false, Category: Fuel},
+	{Name: "FUEL TANK EXTERNAL2 CAPACITY", Unit: "gallons", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Fuel},
+	{Name: "FUEL TANK EXTERNAL2 QUANTITY", Unit: "gallons", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: false, Category: Fuel},
+	{Name: "FUEL TOTAL QUANTITY", Unit: "gallons", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Fuel},
+	{Name: "FUEL TOTAL CAPACITY", Unit: "gallons", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Fuel},
+	{Name: "FUEL LEFT CAPACITY", Unit: "gallons", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Fuel},
+	{Name: "FUEL RIGHT CAPACITY", Unit: "gallons", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Fuel},
+	{Name: "FUEL WEIGHT PER GALLON", Unit: "pounds", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Fuel},
+	{Name: "FUEL TANK SELECTOR", Unit: "Enum", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: true, Category: Fuel},
+	{Name: "FUEL CROSS FEED", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Fuel},
+	{Name: "NUM FUEL SELECTORS", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Fuel},
+	{Name: "FUEL SELECTED QUANTITY PERCENT", Unit: "percent", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Fuel},
+	{Name: "FUEL SELECTED QUANTITY", Unit: "gallons", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Fuel},
+	{Name: "FUEL TOTAL QUANTITY WEIGHT", Unit: "pounds", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Fuel},
+	{Name: "FUEL SELECTED TRANSFER MODE", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Fuel},
+	{Name: "FUEL DUMP SWITCH", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Fuel},
+	{Name: "FUEL DUMP ACTIVE", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Fuel},
+	{Name: "DROPPABLE OBJECTS COUNT", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Aircraft},
+	{Name: "DROPPABLE OBJECTS TYPE", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Aircraft},
+	{Name: "DROPPABLE OBJECTS UI NAME", Unit: "", DataType: simconnect.DataTypeString256, Settable: false, Indexed: false, Category: Aircraft},
+	{Name: "WARNING FUEL", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Instruments},
+	{Name: "WARNING FUEL LEFT", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Instruments},
+	{Name: "WARNING FUEL RIGHT", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Instruments},
+	{Name: "WARNING VACUUM", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Instruments},
+	{Name: "WARNING VACUUM LEFT", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Instruments},
+	{Name: "WARNING VACUUM RIGHT", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Instruments},
+	{Name: "WARNING OIL PRESSURE", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Instruments},
+	{Name: "WARNING VOLTAGE", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Instruments},
+	{Name: "WARNING LOW HEIGHT", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Instruments},
+	{Name: "AUTOPILOT AVAILABLE", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Autopilot},
+	{Name: "FLAPS AVAILABLE", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Controls},
+	{Name: "STALL HORN AVAILABLE", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Instruments},
+	{Name: "ENGINE MIXURE AVAILABLE", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Engines},
+	{Name: "CARB HEAT AVAILABLE", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Engines},
+	{Name: "SPOILER AVAILABLE", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Controls},
+	{Name: "STROBES AVAILABLE", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Lights},
+	{Name: "PROP TYPE AVAILABLE", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Engines},
+	{Name: "TOE BRAKES AVAILABLE", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Controls},
+	{Name: "IS TAIL DRAGGER", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Aircraft},
+	{Name: "SYSTEMS AVAILABLE", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Systems},
+	{Name: "INSTRUMENTS AVAILABLE", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Instruments},
+	{Name: "FUEL PUMP", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Fuel},
+	{Name: "MANUAL FUEL PUMP HANDLE", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: false, Category: Fuel},
+	{Name: "ALTERNATE STATIC SOURCE OPEN", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Instruments},
+	{Name: "BLEED AIR SOURCE CONTROL", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Systems},
+	{Name: "ELECTRICAL MASTER BATTERY", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: true, Indexed: false, Category: Electrical},
+	{Name: "ELECTRICAL OLD CHARGING AMPS", Unit: "amperes", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Electrical},
+	{Name: "ELECTRICAL TOTAL LOAD AMPS", Unit: "amperes", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Electrical},
+	{Name: "ELECTRICAL BATTERY LOAD", Unit: "amperes", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Electrical},
+	{Name: "ELECTRICAL BATTERY VOLTAGE", Unit: "volts", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Electrical},
+	{Name: "ELECTRICAL MAIN BUS VOLTAGE", Unit: "volts", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Electrical},
+	{Name: "ELECTRICAL MAIN BUS AMPS", Unit: "amperes", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Electrical},
+	{Name: "ELECTRICAL AVIONICS BUS VOLTAGE", Unit: "volts", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Electrical},
+	{Name: "ELECTRICAL AVIONICS BUS AMPS", Unit: "amperes", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Electrical},
+	{Name: "ELECTRICAL HOT BATTERY BUS VOLTAGE", Unit: "volts", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Electrical},
+	{Name: "ELECTRICAL HOT BATTERY BUS AMPS", Unit: "amperes", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Electrical},
+	{Name: "ELECTRICAL BATTERY BUS VOLTAGE", Unit: "volts", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Electrical},
+	{Name: "ELECTRICAL BATTERY BUS AMPS", Unit: "amperes", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Electrical},
+	{Name: "ELECTRICAL GENALT BUS VOLTAGE", Unit: "volts", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Electrical},
+	{Name: "ELECTRICAL GENALT BUS AMPS", Unit: "amperes", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Electrical},
+	{Name: "CIRCUIT GENERAL PANEL ON", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Electrical},
+	{Name: "CIRCUIT FLAP MOTOR ON", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Electrical},
+	{Name: "CIRCUIT GEAR MOTOR ON", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Electrical},
+	{Name: "CIRCUIT AUTOPILOT ON", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Electrical},
+	{Name: "CIRCUIT AVIONICS ON", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Electrical},
+	{Name: "CIRCUIT PITOT HEAT ON", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Electrical},
+	{Name: "CIRCUIT PROP SYNC ON", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Electrical},
+	{Name: "CIRCUIT AUTO FEATHER ON", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Electrical},
+	{Name: "CIRCUIT AUTO BRAKES ON", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Electrical},
+	{Name: "CIRCUIT STANDY VACUUM ON", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Electrical},
+	{Name: "CIRCUIT STANDBY VACUUM ON", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Electrical},
+	{Name: "CIRCUIT MARKER BEACON ON", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Electrical},
+	{Name: "CIRCUIT GEAR WARNING ON", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Electrical},
+	{Name: "CIRCUIT HYDRAULIC PUMP ON", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Electrical},
+	{Name: "AMBIENT DENSITY", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Environment},
+	{Name: "AMBIENT TEMPERATURE", Unit: "celsius", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Environment},
+	{Name: "AMBIENT PRESSURE", Unit: "inHg", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Environment},
+	{Name: "AMBIENT WIND VELOCITY", Unit: "feet per second", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Environment},
+	{Name: "AMBIENT WIND DIRECTION", Unit: "degrees", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Environment},
+	{Name: "AMBIENT WIND X", Unit: "feet per second", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Environment},
+	{Name: "AMBIENT WIND Y", Unit: "feet per second", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Environment},
+	{Name: "AMBIENT WIND Z", Unit: "feet per second", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Environment},
+	{Name: "AMBIENT PRECIP STATE", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Environment},
+	{Name: "AMBIENT IN CLOUD", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Environment},
+	{Name: "AMBIENT VISIBILITY", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Environment},
+	{Name: "BAROMETER PRESSURE", Unit: "millibars", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Environment},
+	{Name: "SEA LEVEL PRESSURE", Unit: "millibars", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Environment},
+	{Name: "TOTAL AIR TEMPERATURE", Unit: "celsius", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Environment},
+	{Name: "STANDARD ATM TEMPERATURE", Unit: "celsius", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Environment},
+	{Name: "AIRCRAFT WIND X", Unit: "feet per second", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Environment},
+	{Name: "AIRCRAFT WIND Y", Unit: "feet per second", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Environment},
+	{Name: "AIRCRAFT WIND Z", Unit: "feet per second", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Environment},
+	{Name: "HYDRAULIC PRESSURE", Unit: "psi", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Systems},
+	{Name: "HYDRAULIC RESERVOIR PERCENT", Unit: "percent", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Systems},
+	{Name: "HYDRAULIC SYSTEM INTEGRITY", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Systems},
+	{Name: "HYDRAULIC SWITCH", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Systems},
+	{Name: "GEAR HYDRAULIC PRESSURE", Unit: "psi", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Controls},
+	{Name: "CONCORDE VISOR NOSE HANDLE", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Controls},
+	{Name: "CONCORDE VISOR POSITION PERCENT", Unit: "percent", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Controls},
+	{Name: "CONCORDE NOSE ANGLE", Unit: "degrees", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Controls},
+	{Name: "RADIOS AVAILABLE", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Radios},
+	{Name: "COM TRANSMIT", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: true, Category: Radios},
+	{Name: "COM RECEIVE ALL", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Radios},
+	{Name: "COM RECIEVE ALL", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Radios},
+	{Name: "NAV SOUND", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Radios},
+	{Name: "DME SOUND", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Radios},
+	{Name: "ADF SOUND", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Radios},
+	{Name: "ADF CARD", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: true, Category: Radios},
+	{Name: "MARKER SOUND", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Radios},
+	{Name: "COM AVAILABLE", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: true, Category: Radios},
+	{Name: "COM ACTIVE FREQUENCY", Unit: "MHz", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Radios},
+	{Name: "COM STANDBY FREQUENCY", Unit: "MHz", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Radios},
+	{Name: "COM STATUS", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Radios},
+	{Name: "COM TEST", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Radios},
+	{Name: "TRANSPONDER AVAILABLE", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: true, Category: Radios},
+	{Name: "TRANSPONDER CODE", Unit: "Bco16", DataType: simconnect.DataTypeInt32, Settable: true, Indexed: true, Category: Radios},
+	{Name: "ADF AVAILABLE", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: true, Category: Radios},
+	{Name: "ADF FREQUENCY", Unit: "KHz", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Radios},
+	{Name: "ADF EXT FREQUENCY", Unit: "KHz", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Radios},
+	{Name: "ADF ACTIVE FREQUENCY", Unit: "KHz", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Radios},
+	{Name: "ADF STANDBY FREQUENCY", Unit: "KHz", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Radios},
+	{Name: "ADF LATLONALT", Unit: "SIMCONNECT_DATA_LATLONALT", DataType: simconnect.DataTypeLatLonAlt, Settable: false, Indexed: true, Category: Radios},
+	{Name: "ADF SIGNAL", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Radios},
+	{Name: "ADF RADIAL", Unit: "degrees", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Radios},
+	{Name: "ADF IDENT", Unit: "", DataType: simconnect.DataTypeString256, Settable: false, Indexed: true, Category: Radios},
+	{Name: "ADF NAME", Unit: "", DataType: simconnect.DataTypeString256, Settable: false, Indexed: true, Category: Radios},
+	{Name: "NAV AVAILABLE", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: true, Category: Radios},
+	{Name: "NAV ACTIVE FREQUENCY", Unit: "MHz", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Radios},
+	{Name: "NAV STANDBY FREQUENCY", Unit: "MHz", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Radios},
+	{Name: "NAV SIGNAL", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Radios},
+	{Name: "NAV IDENT", Unit: "", DataType: simconnect.DataTypeString256, Settable: false, Indexed: true, Category: Radios},
+	{Name: "NAV NAME", Unit: "", DataType: simconnect.DataTypeString256, Settable: false, Indexed: true, Category: Radios},
+	{Name: "NAV CODES", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Radios},
+	{Name: "NAV HAS NAV", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: true, Category: Radios},
+	{Name: "NAV HAS LOCALIZER", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: true, Category: Radios},
+	{Name: "NAV HAS DME", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: true, Category: Radios},
+	{Name: "NAV HAS GLIDE SLOPE", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: true, Category: Radios},
+	{Name: "NAV BACK COURSE FLAGS", Unit: "degrees", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Radios},
+	{Name: "NAV MAGVAR", Unit: "degrees", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Radios},
+	{Name: "NAV RADIAL", Unit: "degrees", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Radios},
+	{Name: "NAV RADIAL ERROR", Unit: "degrees", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Radios},
+	{Name: "NAV LOCALIZER", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Radios},
+	{Name: "NAV GLIDE SLOPE", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Radios},
+	{Name: "NAV GLIDE SLOPE ERROR", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Radios},
+	{Name: "NAV CDI", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Radios},
+	{Name: "NAV GSI", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Radios},
+	{Name: "NAV TOFROM", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Radios},
+	{Name: "NAV GS FLAG", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Radios},
+	{Name: "NAV OBS", Unit: "degrees", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: true, Category: Radios},
+	{Name: "NAV DME", Unit: "nautical miles", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Radios},
+	{Name: "NAV DMESPEED", Unit: "knots", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Radios},
+	{Name: "NAV VOR LATLONALT", Unit: "SIMCONNECT_DATA_LATLONALT", DataType: simconnect.DataTypeLatLonAlt, Settable: false, Indexed: true, Category: Radios},
+	{Name: "NAV GS LATLONALT", Unit: "SIMCONNECT_DATA_LATLONALT", DataType: simconnect.DataTypeLatLonAlt, Settable: false, Indexed: true, Category: Radios},
+	{Name: "NAV DME LATLONALT", Unit: "SIMCONNECT_DATA_LATLONALT", DataType: simconnect.DataTypeLatLonAlt, Settable: false, Indexed: true, Category: Radios},
+	{Name: "NAV RELATIVE BEARING TO STATION", Unit: "degrees", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Radios},
+	{Name: "MARKER BEACON STATE", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Radios},
+	{Name: "INNER MARKER", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Radios},
+	{Name: "MIDDLE MARKER", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Radios},
+	{Name: "OUTER MARKER", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Radios},
+	{Name: "INNER MARKER LATLONALT", Unit: "SIMCONNECT_DATA_LATLONALT", DataType: simconnect.DataTypeLatLonAlt, Settable: false, Indexed: false, Category: Radios},
+	{Name: "MIDDLE MARKER LATLONALT", Unit: "SIMCONNECT_DATA_LATLONALT", DataType: simconnect.DataTypeLatLonAlt, Settable: false, Indexed: false, Category: Radios},
+	{Name: "OUTER MARKER LATLONALT", Unit: "SIMCONNECT_DATA_LATLONALT", DataType: simconnect.DataTypeLatLonAlt, Settable: false, Indexed: false, Category: Radios},
+	{Name: "SELECTED DME", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Radios},
+	{Name: "REALISM", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: false, Category: Simulation},
+	{Name: "AUTO COORDINATION", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Simulation},
+	{Name: "UNLIMITED FUEL", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Simulation},
+	{Name: "REALISM CRASH WITH OTHERS", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Simulation},
+	{Name: "REALISM CRASH DETECTION", Unit: "seconds", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Simulation},
+	{Name: "MANUAL INSTRUMENT LIGHTS", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Lights},
+	{Name: "TRUE AIRSPEED SELECTED", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Instruments},
+	{Name: "ATC TYPE", Unit: "", DataType: simconnect.DataTypeString256, Settable: false, Indexed: false, Category: ATC},
+	{Name: "ATC MODEL", Unit: "", DataType: simconnect.DataTypeString256, Settable: false, Indexed: false, Category: ATC},
+	{Name: "ATC HEAVY", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: ATC},
+	{Name: "ATC ID", Unit: "", DataType: simconnect.DataTypeString256, Settable: false, Indexed: false, Category: ATC},
+	{Name: "ATC AIRLINE", Unit: "", DataType: simconnect.DataTypeString256, Settable: false, Indexed: false, Category: ATC},
+	{Name: "ATC FLIGHT NUMBER", Unit: "", DataType: simconnect.DataTypeString256, Settable: false, Indexed: false, Category: ATC},
+	{Name: "STRUCT LATLONALT", Unit: "SIMCONNECT_DATA_LATLONALT", DataType: simconnect.DataTypeLatLonAlt, Settable: true, Indexed: false, Category: Flight},
+	{Name: "STRUCT LATLONALTPBH", Unit: "", DataType: simconnect.DataTypeInvalid, Settable: false, Indexed: false, Category: Flight},
+	{Name: "STRUCT PBH32", Unit: "", DataType: simconnect.DataTypeInvalid, Settable: false, Indexed: false, Category: Flight},
+	{Name: "STRUCT DAMAGEVISIBLE", Unit: "", DataType: simconnect.DataTypeInvalid, Settable: false, Indexed: false, Category: Flight},
+	{Name: "STRUCT SURFACE RELATIVE VELOCITY", Unit: "SIMCONNECT_DATA_XYZ", DataType: simconnect.DataTypeXYZ, Settable: false, Indexed: false, Category: Flight},
+	{Name: "STRUCT WORLDVELOCITY", Unit: "SIMCONNECT_DATA_XYZ", DataType: simconnect.DataTypeXYZ, Settable: false, Indexed: false, Category: Flight},
+	{Name: "STRUCT WORLD ROTATION VELOCITY", Unit: "SIMCONNECT_DATA_XYZ", DataType: simconnect.DataTypeXYZ, Settable: false, Indexed: false, Category: Flight},
+	{Name: "STRUCT BODY VELOCITY", Unit: "SIMCONNECT_DATA_XYZ", DataType: simconnect.DataTypeXYZ, Settable: false, Indexed: false, Category: Flight},
+	{Name: "STRUCT BODY ROTATION VELOCITY", Unit: "SIMCONNECT_DATA_XYZ", DataType: simconnect.DataTypeXYZ, Settable: false, Indexed: false, Category: Flight},
+	{Name: "STRUCT BODY ROTATION ACCELERATION", Unit: "SIMCONNECT_DATA_XYZ", DataType: simconnect.DataTypeXYZ, Settable: false, Indexed: false, Category: Flight},
+	{Name: "STRUCT WORLD ACCELERATION", Unit: "SIMCONNECT_DATA_XYZ", DataType: simconnect.DataTypeXYZ, Settable: false, Indexed: false, Category: Flight},
+	{Name: "STRUCT ENGINE POSITION", Unit: "SIMCONNECT_DATA_XYZ", DataType: simconnect.DataTypeXYZ, Settable: false, Indexed: false, Category: Engines},
+	{Name: "STRUCT AMBIENT WIND", Unit: "SIMCONNECT_DATA_XYZ", DataType: simconnect.DataTypeXYZ, Settable: false, Indexed: false, Category: Environment},
+	{Name: "STRUCT REALISM VARS", Unit: "", DataType: simconnect.DataTypeInvalid, Settable: false, Indexed: false, Category: Simulation},
+	{Name: "STRUC HEADING HOLD PID CONSTS", Unit: "", DataType: simconnect.DataTypeInvalid, Settable: false, Indexed: false, Category: Autopilot},
+	{Name: "STRUC AIRSPEED HOLD PID CONSTS", Unit: "", DataType: simconnect.DataTypeInvalid, Settable: false, Indexed: false, Category: Autopilot},
+	{Name: "STRUCT EYEPOINT DYNAMIC ANGLE", Unit: "SIMCONNECT_DATA_XYZ", DataType: simconnect.DataTypeXYZ, Settable: false, Indexed: false, Category: Simulation},
+	{Name: "STRUCT EYEPOINT DYNAMIC OFFSET", Unit: "SIMCONNECT_DATA_XYZ", DataType: simconnect.DataTypeXYZ, Settable: false, Indexed: false, Category: Simulation},
+	{Name: "PITOT HEAT", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: false, Category: Instruments},
+	{Name: "PITOT ICE PCT", Unit: "percent", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Instruments},
+	{Name: "SMOKE ENABLE", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: true, Indexed: false, Category: Systems},
+	{Name: "SMOKESYSTEM AVAILABLE", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Systems},
+	{Name: "G FORCE", Unit: "GForce", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Flight},
+	{Name: "SEMIBODY LOADFACTOR X", Unit: "amperes", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Flight},
+	{Name: "SEMIBODY LOADFACTOR Y", Unit: "amperes", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Flight},
+	{Name: "SEMIBODY LOADFACTOR Z", Unit: "amperes", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Flight},
+	{Name: "SEMIBODY LOADFACTOR YDOT", Unit: "amperes", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Flight},
+	{Name: "MAX G FORCE", Unit: "GForce", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Flight},
+	{Name: "MIN G FORCE", Unit: "GForce", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Flight},
+	{Name: "SUCTION PRESSURE", Unit: "psi", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Instruments},
+	{Name: "RAD INS SWITCH", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Instruments},
+	{Name: "TYPICAL DESCENT RATE", Unit: "feet per minute", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Aircraft},
+	{Name: "VISUAL MODEL RADIUS", Unit: "feet", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Aircraft},
+	{Name: "SIMULATED RADIUS", Unit: "feet", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Aircraft},
+	{Name: "IS USER SIM", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Simulation},
+	{Name: "CONTROLLABLE", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Simulation},
+	{Name: "HEADING INDICATOR", Unit: "degrees", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Instruments},
+	{Name: "TITLE", Unit: "", DataType: simconnect.DataTypeString256, Settable: false, Indexed: false, Category: Aircraft},
+	{Name: "CATEGORY", Unit: "", DataType: simconnect.DataTypeString256, Settable: false, Indexed: false, Category: Aircraft},
+	{Name: "SIM DISABLED", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: true, Indexed: false, Category: Simulation},
+	{Name: "PROP DEICE SWITCH", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: true, Indexed: true, Category: Systems},
+	{Name: "STRUCTURAL DEICE SWITCH", Unit: "", DataType: simconnect.DataTypeInvalid, Settable: true, Indexed: false, Category: Systems},
+	{Name: "STRUCTURAL ICE PCT", Unit: "", DataType: simconnect.DataTypeInvalid, Settable: false, Indexed: false, Category: Systems},
+	{Name: "ARTIFICIAL GROUND ELEVATION", Unit: "feet", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Environment},
+	{Name: "SURFACE INFO VALID", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Environment},
+	{Name: "SURFACE TYPE", Unit: "Enum", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Environment},
+	{Name: "SURFACE CONDITION", Unit: "Enum", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Environment},
+	{Name: "PUSHBACK STATE", Unit: "Enum", DataType: simconnect.DataTypeInt32, Settable: true, Indexed: false, Category: Controls},
+	{Name: "PUSHBACK ANGLE", Unit: "degrees", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: false, Category: Controls},
+	{Name: "PUSHBACK CONTACTX", Unit: "feet", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Controls},
+	{Name: "PUSHBACK CONTACTY", Unit: "feet", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Controls},
+	{Name: "PUSHBACK CONTACTZ", Unit: "feet", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Controls},
+	{Name: "PUSHBACK WAIT", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: false, Category: Controls},
+	{Name: "HSI CDI NEEDLE", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Navigation},
+	{Name: "HSI GSI NEEDLE", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Navigation},
+	{Name: "HSI CDI NEEDLE VALID", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Navigation},
+	{Name: "HSI GSI NEEDLE VALID", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Navigation},
+	{Name: "HSI TF FLAGS", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Navigation},
+	{Name: "HSI BEARING", Unit: "degrees", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Navigation},
+	{Name: "HSI BEARING VALID", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Navigation},
+	{Name: "HSI HAS LOCALIZER", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Navigation},
+	{Name: "HSI SPEED", Unit: "knots", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Navigation},
+	{Name: "HSI DISTANCE", Unit: "nautical miles", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Navigation},
+	{Name: "HSI STATION IDENT", Unit: "", DataType: simconnect.DataTypeString256, Settable: false, Indexed: false, Category: Navigation},
+	{Name: "IS SLEW ACTIVE", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: true, Indexed: false, Category: Simulation},
+	{Name: "IS SLEW ALLOWED", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Simulation},
+	{Name: "ATC SUGGESTED MIN RWY TAKEOFF", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: ATC},
+	{Name: "ATC SUGGESTED MIN RWY LANDING", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: ATC},
+	{Name: "YAW STRING ANGLE", Unit: "degrees", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Instruments},
+	{Name: "YAW STRING PCT EXTENDED", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Instruments},
+	{Name: "INDUCTOR COMPASS PERCENT DEVIATION", Unit: "percent", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Instruments},
+	{Name: "INDUCTOR COMPASS HEADING REF", Unit: "degrees", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Instruments},
+	{Name: "ANEMOMETER PCT RPM", Unit: "percent", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Instruments},
+	{Name: "GPS POSITION LAT", Unit: "degrees", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Navigation},
+	{Name: "GPS POSITION LON", Unit: "degrees", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Navigation},
+	{Name: "GPS POSITION ALT", Unit: "meters", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Navigation},
+	{Name: "GPS MAGVAR", Unit: "degrees", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Navigation},
+	{Name: "GPS IS ACTIVE FLIGHT PLAN", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Navigation},
+	{Name: "GPS IS ACTIVE WAY POINT", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Navigation},
+	{Name: "GPS IS ARRIVED", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Navigation},
+	{Name: "GPS IS DIRECTTO FLIGHTPLAN", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Navigation},
+	{Name: "GPS GROUND SPEED", Unit: "knots", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Navigation},
+	{Name: "GPS GROUND TRUE HEADING", Unit: "degrees", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Navigation},
+	{Name: "GPS GROUND MAGNETIC TRACK", Unit: "degrees", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Navigation},
+	{Name: "GPS GROUND TRUE TRACK", Unit: "degrees", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Navigation},
+	{Name: "GPS ETE", Unit: "seconds", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Navigation},
+	{Name: "GPS ETA", Unit: "seconds", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Navigation},
+	{Name: "GPS WP DISTANCE", Unit: "feet", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Navigation},
+	{Name: "GPS WP BEARING", Unit: "degrees", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Navigation},
+	{Name: "GPS WP TRUE BEARING", Unit: "degrees", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Navigation},
+	{Name: "GPS WP CROSS TRK", Unit: "feet", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Navigation},
+	{Name: "GPS WP DESIRED TRACK", Unit: "degrees", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Navigation},
+	{Name: "GPS WP TRUE REQ HDG", Unit: "degrees", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Navigation},
+	{Name: "GPS WP VERTICAL SPEED", Unit: "feet per minute", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Navigation},
+	{Name: "GPS WP TRACK ANGLE ERROR", Unit: "degrees", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Navigation},
+	{Name: "GPS WP NEXT ID", Unit: "", DataType: simconnect.DataTypeString256, Settable: false, Indexed: false, Category: Navigation},
+	{Name: "GPS WP NEXT LAT", Unit: "degrees", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Navigation},
+	{Name: "GPS WP NEXT LON", Unit: "degrees", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Navigation},
+	{Name: "GPS WP NEXT ALT", Unit: "meters", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Navigation},
+	{Name: "GPS WP PREV VALID", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Navigation},
+	{Name: "GPS WP PREV ID", Unit: "", DataType: simconnect.DataTypeString256, Settable: false, Indexed: false, Category: Navigation},
+	{Name: "GPS WP PREV LAT", Unit: "degrees", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Navigation},
+	{Name: "GPS WP PREV LON", Unit: "degrees", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Navigation},
+	{Name: "GPS WP PREV ALT", Unit: "meters", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Navigation},
+	{Name: "GPS WP ETE", Unit: "seconds", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Navigation},
+	{Name: "GPS WP ETA", Unit: "seconds", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Navigation},
+	{Name: "GPS COURSE TO STEER", Unit: "degrees", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Navigation},
+	{Name: "GPS FLIGHT PLAN WP INDEX", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Navigation},
+	{Name: "GPS FLIGHT PLAN WP COUNT", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Navigation},
+	{Name: "GPS IS ACTIVE WP LOCKED", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Navigation},
+	{Name: "GPS IS APPROACH LOADED", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Navigation},
+	{Name: "GPS IS APPROACH ACTIVE", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Navigation},
+	{Name: "GPS APPROACH MODE", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Navigation},
+	{Name: "GPS APPROACH WP TYPE", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Navigation},
+	{Name: "GPS APPROACH IS WP RUNWAY", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Navigation},
+	{Name: "GPS APPROACH SEGMENT TYPE", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Navigation},
+	{Name: "GPS APPROACH AIRPORT ID", Unit: "", DataType: simconnect.DataTypeString256, Settable: false, Indexed: false, Category: Navigation},
+	{Name: "GPS APPROACH APPROACH INDEX", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Navigation},
+	{Name: "GPS APPROACH APPROACH ID", Unit: "", DataType: simconnect.DataTypeString256, Settable: false, Indexed: false, Category: Navigation},
+	{Name: "GPS APPROACH APPROACH TYPE", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Navigation},
+	{Name: "GPS APPROACH TRANSITION INDEX", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Navigation},
+	{Name: "GPS APPROACH TRANSITION ID", Unit: "", DataType: simconnect.DataTypeString256, Settable: false, Indexed: false, Category: Navigation},
+	{Name: "GPS APPROACH IS FINAL", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Navigation},
+	{Name: "GPS APPROACH IS MISSED", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Navigation},
+	{Name: "GPS APPROACH TIMEZONE DEVIATION", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Navigation},
+	{Name: "GPS APPROACH WP INDEX", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Navigation},
+	{Name: "GPS APPROACH WP COUNT", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Navigation},
+	{Name: "GPS TARGET DISTANCE", Unit: "feet", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Navigation},
+	{Name: "GPS TARGET ALTITUDE", Unit: "meters", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Navigation},
+	{Name: "USER INPUT ENABLED", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Simulation},
+	{Name: "ROTOR BRAKE HANDLE POS", Unit: "percent over 100", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: false, Category: Controls},
+	{Name: "ROTOR BRAKE ACTIVE", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Controls},
+	{Name: "ROTOR CLUTCH SWITCH POS", Unit: "percent over 100", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: false, Category: Controls},
+	{Name: "ROTOR CLUTCH ACTIVE", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Controls},
+	{Name: "ROTOR TEMPERATURE", Unit: "celsius", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Controls},
+	{Name: "ROTOR CHIP DETECTED", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Controls},
+	{Name: "ROTOR GOV SWITCH POS", Unit: "percent over 100", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: false, Category: Controls},
+	{Name: "ROTOR GOV ACTIVE", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Controls},
+	{Name: "ROTOR LATERAL TRIM PCT", Unit: "percent", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: false, Category: Controls},
+	{Name: "ROTOR RPM PCT", Unit: "percent", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Controls},
+	{Name: "ROTOR ROTATION ANGLE", Unit: "degrees", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Controls},
+	{Name: "COLLECTIVE POSITION", Unit: "percent over 100", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: false, Category: Controls},
+	{Name: "DISK PITCH ANGLE", Unit: "degrees", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Controls},
+	{Name: "DISK BANK ANGLE", Unit: "degrees", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Controls},
+	{Name: "DISK PITCH PCT", Unit: "percent", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Controls},
+	{Name: "DISK BANK PCT", Unit: "percent", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Controls},
+	{Name: "DISK CONING PCT", Unit: "percent", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Controls},
+	{Name: "GEAR DAMAGE BY SPEED", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Controls},
+	{Name: "GEAR SPEED EXCEEDED", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Controls},
+	{Name: "FLAP DAMAGE BY SPEED", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Controls},
+	{Name: "FLAP SPEED EXCEEDED", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Controls},
+	{Name: "ESTIMATED CRUISE SPEED", Unit: "knots", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Aircraft},
+	{Name: "ESTIMATED FUEL FLOW", Unit: "pounds per hour", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Fuel},
+	{Name: "EYEPOINT POSITION", Unit: "SIMCONNECT_DATA_XYZ", DataType: simconnect.DataTypeXYZ, Settable: false, Indexed: false, Category: Simulation},
+	{Name: "NAV VOR LLAF64", Unit: "SIMCONNECT_DATA_LATLONALT", DataType: simconnect.DataTypeLatLonAlt, Settable: false, Indexed: true, Category: Radios},
+	{Name: "NAV GS LLAF64", Unit: "SIMCONNECT_DATA_LATLONALT", DataType: simconnect.DataTypeLatLonAlt, Settable: false, Indexed: true, Category: Radios},
+	{Name: "NAV RAW GLIDE SLOPE", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Radios},
+	{Name: "WINDSHIELD RAIN EFFECT AVAILABLE", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Aircraft},
+	{Name: "STATIC CG TO GROUND", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Flight},
+	{Name: "STATIC PITCH", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Flight},
+	{Name: "CRASH SEQUENCE", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Simulation},
+	{Name: "CRASH FLAG", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Simulation},
+	{Name: "APPLY HEAT TO SYSTEMS", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Systems},
+	{Name: "TOW RELEASE HANDLE", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: true, Indexed: false, Category: Controls},
+	{Name: "TOW CONNECTION", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Controls},
+	{Name: "APU PCT RPM", Unit: "percent", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Engines},
+	{Name: "APU PCT STARTER", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Engines},
+	{Name: "APU VOLTS", Unit: "volts", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Engines},
+	{Name: "APU GENERATOR SWITCH", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: true, Indexed: false, Category: Engines},
+	{Name: "APU GENERATOR ACTIVE", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Engines},
+	{Name: "APU ON FIRE DETECTED", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Engines},
+	{Name: "PRESSURIZATION CABIN ALTITUDE", Unit: "feet", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Systems},
+	{Name: "PRESSURIZATION CABIN ALTITUDE GOAL", Unit: "feet", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Systems},
+	{Name: "PRESSURIZATION CABIN ALTITUDE RATE", Unit: "feet per minute", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Systems},
+	{Name: "PRESSURIZATION PRESSURE DIFFERENTIAL", Unit: "psi", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Systems},
+	{Name: "PRESSURIZATION DUMP SWITCH", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Systems},
+	{Name: "FIRE BOTTLE SWITCH", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Systems},
+	{Name: "FIRE BOTTLE DISCHARGED", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Systems},
+	{Name: "CABIN NO SMOKING ALERT SWITCH", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: true, Indexed: false, Category: Systems},
+	{Name: "CABIN SEATBELTS ALERT SWITCH", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: true, Indexed: false, Category: Systems},
+	{Name: "GPWS WARNING", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Instruments},
+	{Name: "GPWS SYSTEM ACTIVE", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Instruments},
+	{Name: "IS LATITUDE LONGITUDE FREEZE ON", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Simulation},
+	{Name: "IS ALTITUDE FREEZE ON", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Simulation},
+	{Name: "IS ATTITUDE FREEZE ON", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Simulation},
+	{Name: "NUM SLING CABLES", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Controls},
+	{Name: "SLING OBJECT ATTACHED", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Controls},
+	{Name: "SLING CABLE BROKEN", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: true, Category: Controls},
+	{Name: "SLING CABLE EXTENDED LENGTH", Unit: "feet", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Controls},
+	{Name: "SLING ACTIVE PAYLOAD STATION", Unit: "amperes", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Controls},
+	{Name: "SLING HOIST PERCENT DEPLOYED", Unit: "percent", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Controls},
+	{Name: "SLING HOIST SWITCH", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: true, Category: Controls},
+	{Name: "SLING HOOK IN PICKUP MODE", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: true, Category: Controls},
+	{Name: "IS ATTACHED TO SLING", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Controls},
+	{Name: "CABLE CAUGHT BY TAILHOOK", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Controls},
+	{Name: "EXTERNAL SYSTEM VALUE", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Aircraft},
+	{Name: "ANNUNCIATOR SWITCH", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Instruments},
+	{Name: "AUTOBRAKES ACTIVE", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Controls},
+	{Name: "REJECTED TAKEOFF BRAKES ACTIVE", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Controls},
+	{Name: "SHUTOFF VALVE PULLED", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Fuel},
+	{Name: "LIGHT POTENTIOMETER", Unit: "percent", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Lights},
+	{Name: "FAKE AC LWR", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Aircraft},
+	{Name: "FAKE AC UPR", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Aircraft},
+	{Name: "FAKE AC TRIM L", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Aircraft},
+	{Name: "FAKE AC TRIM R", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Aircraft},
+	{Name: "FAKE WINDOW HEAT L", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Aircraft},
+	{Name: "FAKE WINDOW HEAT R", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Aircraft},
+	{Name: "FAKE BUS TIE", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Aircraft},
+	{Name: "FAKE EXT PWR", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Aircraft},
+	{Name: "FAKE GEN CONT", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Aircraft},
+	{Name: "FAKE UTIL PWR L", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Aircraft},
+	{Name: "FAKE UTIL PWR R", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Aircraft},
+	{Name: "FAKE CRT TANK PUMP L", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Aircraft},
+	{Name: "FAKE CRT TANK PUMP R", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Aircraft},
+	{Name: "FAKE FUEL MAIN AFT", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Aircraft},
+	{Name: "FAKE FUEL MAIN FWD", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Aircraft},
+	{Name: "FAKE FUEL OVRD AFT", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Aircraft},
+	{Name: "FAKE FUEL OVRD FWD", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Aircraft},
+	{Name: "FAKE STAB TANK PUMP L", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Aircraft},
+	{Name: "FAKE STAB TANK PUMP R", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Aircraft},
+	{Name: "FAKE HYD PUMP SWITCH", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Aircraft},
+	{Name: "FAKE O2 YD LOWER", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Aircraft},
+	{Name: "FAKE O2 YD UPPER", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Aircraft},
+	{Name: "FAKE APU BLEED", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Aircraft},
+	{Name: "FAKE BLEED", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Aircraft},
+	{Name: "FAKE ISOLATION VALVE L", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Aircraft},
+	{Name: "FAKE ISOLATION VALVE R", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Aircraft},
+	{Name: "FAKE AC FLT DECK", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Aircraft},
+	{Name: "FAKE AC PASS TEMP", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Aircraft},
+	{Name: "FAKE STANDBY POWER", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Aircraft},
+	{Name: "FAKE DEMAND PUMP SEL", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Aircraft},
+	{Name: "FAKE IRS C", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Aircraft},
+	{Name: "FAKE IRS L", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Aircraft},
+	{Name: "FAKE IRS R", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Aircraft},
+	{Name: "FAKE ANTI ICE NACELLE", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Aircraft},
+	{Name: "FAKE ANTI ICE WING", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Aircraft},
+	{Name: "FAKE OUTFLOW VALVES", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Aircraft},
+	{Name: "FAKE XFEED", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Aircraft},
+	{Name: "FAKE EEC", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Aircraft},
+	{Name: "FAKE PACK", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Aircraft},
+	{Name: "FAKE EMERG LIGHTS", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Aircraft},
+	{Name: "FAKE TRIM STAB", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Aircraft},
+	{Name: "FAKE CARGO ARM AFT", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Aircraft},
+	{Name: "FAKE XPNDR", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Aircraft},
+	{Name: "FAKE IDENT", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Aircraft},
+	{Name: "FAKE NO SMOKING", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Aircraft},
+	{Name: "FAKE SEATBELTS", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Aircraft},
+	{Name: "FAKE CARGO TEMP", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Aircraft},
+	{Name: "FAKE EMERGENCY LIGHT", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Aircraft},
+	{Name: "AUTOPILOT DISENGAGED", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Autopilot},
+	{Name: "FAKE APU GEN SWITCH", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Aircraft},
+	{Name: "BREAKER AVNFAN", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Electrical},
+	{Name: "BREAKER AUTOPILOT", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Electrical},
+	{Name: "BREAKER GPS", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Electrical},
+	{Name: "BREAKER NAVCOM1", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Electrical},
+	{Name: "BREAKER NAVCOM2", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Electrical},
+	{Name: "BREAKER NAVCOM3", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Electrical},
+	{Name: "BREAKER ADF", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Electrical},
+	{Name: "BREAKER XPNDR", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Electrical},
+	{Name: "BREAKER FLAP", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Electrical},
+	{Name: "BREAKER INST", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Electrical},
+	{Name: "BREAKER AVNBUS1", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Electrical},
+	{Name: "BREAKER AVNBUS2", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Electrical},
+	{Name: "BREAKER TURNCOORD", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Electrical},
+	{Name: "BREAKER INSTLTS", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Electrical},
+	{Name: "BREAKER ALTFLD", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Electrical},
+	{Name: "BREAKER WARN", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Electrical},
+	{Name: "BREAKER LTS PWR", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Electrical},
+	{Name: "PILOT TRANSMITTER TYPE", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Radios},
+	{Name: "COPILOT TRANSMITTER TYPE", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Radios},
+	{Name: "PILOT TRANSMITTING", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Radios},
+	{Name: "COPILOT TRANSMITTING", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Radios},
+	{Name: "SPEAKER ACTIVE", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Radios},
+	{Name: "INTERCOM SYSTEM ACTIVE", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Radios},
+	{Name: "AUDIO PANEL VOLUME", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Radios},
+	{Name: "MARKER BEACON SENSITIVITY HIGH", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Radios},
+	{Name: "MARKER BEACON TEST MUTE", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Radios},
+	{Name: "INTERCOM MODE", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Radios},
+	{Name: "COM RECEIVE", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Radios},
+	{Name: "AUTOPILOT ALTITUDE ARM", Unit: "feet", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Autopilot},
+	{Name: "COM VOLUME", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Radios},
+	{Name: "NAV VOLUME", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Radios},
+	{Name: "ATC CLEARED IFR", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: ATC},
+	{Name: "ATC IFR FP TO REQUEST", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: ATC},
+	{Name: "ATC RUNWAY SELECTED", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: ATC},
+	{Name: "ATC TAXIPATH DISTANCE", Unit: "meters", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: ATC},
+	{Name: "ATC RUNWAY START DISTANCE", Unit: "feet", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: ATC},
+	{Name: "ATC RUNWAY END DISTANCE", Unit: "feet", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: ATC},
+	{Name: "ATC RUNWAY DISTANCE", Unit: "feet", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: ATC},
+	{Name: "ATC RUNWAY RELATIVE POSITION X", Unit: "meters", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: ATC},
+	{Name: "ATC RUNWAY RELATIVE POSITION Y", Unit: "meters", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: ATC},
+	{Name: "ATC RUNWAY RELATIVE POSITION Z", Unit: "meters", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: ATC},
+	{Name: "ATC RUNWAY TDPOINT RELATIVE POSITION X", Unit: "meters", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: ATC},
+	{Name: "ATC RUNWAY TDPOINT RELATIVE POSITION Y", Unit: "meters", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: ATC},
+	{Name: "ATC RUNWAY TDPOINT RELATIVE POSITION Z", Unit: "meters", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: ATC},
+	{Name: "ATC RUNWAY HEADING DEGREES TRUE", Unit: "degrees", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: ATC},
+	{Name: "ATC RUNWAY LENGTH", Unit: "feet", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: ATC},
+	{Name: "ATC RUNWAY WIDTH", Unit: "feet", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: ATC},
+	{Name: "ATC RUNWAY AIRPORT NAME", Unit: "", DataType: simconnect.DataTypeString256, Settable: false, Indexed: false, Category: ATC},
+	{Name: "SLOPE TO ATC RUNWAY", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: ATC},
+	{Name: "ATC CLEARED TAKEOFF", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: ATC},
+	{Name: "ATC CLEARED LANDING", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: ATC},
+	{Name: "ATC CLEARED TAXI", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: ATC},
+	{Name: "ON ANY RUNWAY", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: ATC},
+	{Name: "ATC FLIGHTPLAN DIFF HEADING", Unit: "degrees", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: ATC},
+	{Name: "ATC FLIGHTPLAN DIFF ALT", Unit: "feet", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: ATC},
+	{Name: "ATC FLIGHTPLAN DIFF DISTANCE", Unit: "feet", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: ATC},
+	{Name: "ATC PREVIOUS WAYPOINT ALTITUDE", Unit: "feet", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: ATC},
+	{Name: "ATC CURRENT WAYPOINT ALTITUDE", Unit: "feet", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: ATC},
+	{Name: "ASSISTANCE LANDING ENABLED", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: ATC},
+	{Name: "COM1 STORED FREQUENCY", Unit: "MHz", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Radios},
+	{Name: "COM2 STORED FREQUENCY", Unit: "MHz", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Radios},
+	{Name: "COM3 STORED FREQUENCY", Unit: "MHz", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Radios},
+	{Name: "RUDDER TRIM DISABLED", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Controls},
+	{Name: "AILERON TRIM DISABLED", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Controls},
+	{Name: "ELEVATOR TRIM DISABLED", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Controls},
+	{Name: "PLANE TOUCHDOWN LATITUDE", Unit: "degrees", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Flight},
+	{Name: "PLANE TOUCHDOWN LONGITUDE", Unit: "degrees", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Flight},
+	{Name: "PLANE TOUCHDOWN PITCH DEGREES", Unit: "degrees", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Flight},
+	{Name: "PLANE TOUCHDOWN BANK DEGREES", Unit: "degrees", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Flight},
+	{Name: "PLANE TOUCHDOWN HEADING DEGREES MAGNETIC", Unit: "degrees", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Flight},
+	{Name: "PLANE TOUCHDOWN HEADING DEGREES TRUE", Unit: "degrees", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Flight},
+	{Name: "PLANE TOUCHDOWN NORMAL VELOCITY", Unit: "feet per second", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Flight},
+	{Name: "TURB ENG IGNITION SWITCH EX1", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Engines},
+	{Name: "TURB ENG IS IGNITING", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: true, Category: Engines},
+	{Name: "PLANE IN PARKING STATE", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Flight},
+	{Name: "ELT ACTIVATED", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Radios},
+	{Name: "RECIP ENG ENGINE MASTER SWITCH", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: true, Category: Engines},
+	{Name: "RECIP ENG GLOW PLUG ACTIVE", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: true, Category: Engines},
+	{Name: "LIGHT GLARESHIELD", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: true, Indexed: false, Category: Lights},
+	{Name: "LIGHT PEDESTRAL", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: true, Indexed: false, Category: Lights},
+	{Name: "LIGHT GLARESHIELD ON", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Lights},
+	{Name: "LIGHT PEDESTRAL ON", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Lights},
+	{Name: "CIRCUIT NAVCOM1 ON", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Electrical},
+	{Name: "CIRCUIT NAVCOM2 ON", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Electrical},
+	{Name: "CIRCUIT NAVCOM3 ON", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Electrical},
+	{Name: "AIRSPEED TRUE RAW", Unit: "knots", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Flight},
+	{Name: "GENERAL ENG FUEL PUMP SWITCH EX1", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Engines},
+	{Name: "FUEL TRANSFER PUMP ON", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: true, Category: Fuel},
+	{Name: "IS ANY INTERIOR LIGHT ON", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Lights},
+	{Name: "GPS FLIGHTPLAN TOTAL DISTANCE", Unit: "meters", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Navigation},
+	{Name: "CIRCUIT ON", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: true, Category: Electrical},
+	{Name: "CIRCUIT SWITCH ON", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: true, Category: Electrical},
+	{Name: "BUS LOOKUP INDEX", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Electrical},
+	{Name: "BUS CONNECTION ON", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: true, Category: Electrical},
+	{Name: "BATTERY CONNECTION ON", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: true, Category: Electrical},
+	{Name: "ALTERNATOR CONNECTION ON", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: true, Category: Electrical},
+	{Name: "CIRCUIT CONNECTION ON", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: true, Category: Electrical},
+	{Name: "BUS BREAKER PULLED", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: true, Category: Electrical},
+	{Name: "BATTERY BREAKER PULLED", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: true, Category: Electrical},
+	{Name: "ALTERNATOR BREAKER PULLED", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: true, Category: Electrical},
+	{Name: "CIRCUIT BREAKER PULLED", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: true, Category: Electrical},
+	{Name: "CAMERA STATE", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Simulation},
+	{Name: "CAMERA SUBSTATE", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Simulation},
+	{Name: "SMART CAMERA ACTIVE", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: false, Category: Simulation},
+	{Name: "CAMERA REQUEST ACTION", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Simulation},
+	{Name: "ADF VOLUME", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Radios},
+	{Name: "BLEED AIR APU", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Systems},
+	{Name: "BLEED AIR ENGINE", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: true, Category: Systems},
+	{Name: "APU BLEED TO ENGINE", Unit: "number", DataType: simconnect.DataTypeFloat64, Settable: false, Indexed: false, Category: Engines},
+	{Name: "EXTERNAL POWER CONNECTION ON", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: true, Category: Electrical},
+	{Name: "EXTERNAL POWER BREAKER PULLED", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: true, Category: Electrical},
+	{Name: "EXTERNAL POWER AVAILABLE", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: true, Category: Electrical},
+	{Name: "EXTERNAL POWER ON", Unit: "Bool", DataType: simconnect.DataTypeInt32, Settable: false, Indexed: true, Category: Electrical},
+}
@@ -0,0 +1,366 @@
+package simconnect
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+	"unsafe"
+
+	log "github.com/sirupsen/logrus"
+)
+
+var ErrClientDataTooLarge = errors.New("simconnect: client data area exceeds ClientDataMaxSize")
+
+// ClientDataDefinition is a client data definition built from the exported fields of a Go struct.
+// Each field is a datum at the offset the field has in the struct, which is the layout a C compiler
+// gives the same struct, so areas can be shared with WASM modules and other clients:
+//
+//	type Lights struct {
+//		Beacon  int8
+//		Landing int8
+//		_       [2]byte // padding, not part of the definition
+//		Panel   float32
+//		Label   [16]byte
+//	}
+//
+// Supported field types are the fixed-size integer types, float32, float64, bool, and arrays and structs of them
+// which have no padding. Unexported fields and fields tagged `clientdata:"-"` are not part of the definition,
+// but still take up room in the area. The datum ID of each datum is its index in Fields.
+type ClientDataDefinition struct {
+	DefineID DWord
+	Type     reflect.Type
+	Fields   []ClientDataField
+}
+
+// ClientDataField is a single datum of a ClientDataDefinition.
+type ClientDataField struct {
+	Name       string // name of the struct field
+	Offset     DWord  // offset in the area
+	SizeOrType DWord  // a ClientDataType for numbers, the size in bytes for everything else
+	Size       int    // size in bytes
+	index      []int
+}
+
+// NewClientDataDefinition builds the client data definition of v, which must be a struct or a pointer to a struct
+// of at most ClientDataMaxSize bytes. Nothing is sent to the simulator, see AddClientDataDefinition.
+func NewClientDataDefinition(defineID DWord, v interface{}) (*ClientDataDefinition, error) {
+	t := reflect.TypeOf(v)
+	if t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("client data definition: %T is no struct", v)
+	}
+	if t.Size() > uintptr(ClientDataMaxSize) {
+		return nil, fmt.Errorf("%w: %s has %d bytes", ErrClientDataTooLarge, t, t.Size())
+	}
+
+	def := &ClientDataDefinition{
+		DefineID: defineID,
+		Type:     t,
+	}
+	for i := 0; i < t.NumField(); i++ {
+		structField := t.Field(i)
+		if structField.PkgPath != "" || structField.Tag.Get("clientdata") == "-" {
+			continue
+		}
+		size := binary.Size(reflect.Zero(structField.Type).Interface())
+		if size <= 0 || uintptr(size) != structField.Type.Size() {
+			return nil, fmt.Errorf("client data definition: field %s.%s: unsupported type %s", t.Name(), structField.Name, structField.Type)
+		}
+		def.Fields = append(def.Fields, ClientDataField{
+			Name:       structField.Name,
+			Offset:     DWord(structField.Offset),
+			SizeOrType: clientDataType(structField.Type.Kind(), size),
+			Size:       size,
+			index:      structField.Index,
+		})
+	}
+	if len(def.Fields) == 0 {
+		return nil, fmt.Errorf("client data definition: %s has no exported fields", t.Name())
+	}
+	return def, nil
+}
+
+// AddClientDataDefinition adds all datums of def to the simulator's client data definition def.DefineID.
+func (simco *SimConnect) AddClientDataDefinition(def *ClientDataDefinition) error {
+	for i, field := range def.Fields {
+		if err := simco.addToClientDataDefinition(def.DefineID, field.Offset, field.SizeOrType, 0, DWord(i)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AreaSize returns the size of the area the definition describes, the size of the Go struct.
+func (def *ClientDataDefinition) AreaSize() DWord {
+	return DWord(def.Type.Size())
+}
+
+// Size returns the number of bytes of one data set, as passed to SetClientData.
+func (def *ClientDataDefinition) Size() int {
+	size := 0
+	for _, field := range def.Fields {
+		size += field.Size
+	}
+	return size
+}
+
+// Encode returns the data set of v, a struct or a pointer to a struct of def.Type, as expected by SetClientData.
+func (def *ClientDataDefinition) Encode(v interface{}) ([]byte, error) {
+	value := reflect.ValueOf(v)
+	if value.Kind() == reflect.Ptr && !value.IsNil() {
+		value = value.Elem()
+	}
+	if !value.IsValid() || value.Type() != def.Type {
+		return nil, fmt.Errorf("client data definition: cannot encode %T as %s", v, def.Type)
+	}
+	var buf bytes.Buffer
+	for _, field := range def.Fields {
+		if err := binary.Write(&buf, binary.LittleEndian, value.FieldByIndex(field.index).Interface()); err != nil {
+			return nil, fmt.Errorf("client data definition: field %s: %s", field.Name, err)
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+// DecodeMessage fills the struct v points to from a ClientDataMessage.
+// It honours ClientDataRequestFlagTagged, in which case only the datums contained in the message are set.
+func (def *ClientDataDefinition) DecodeMessage(msg *ClientDataMessage, v interface{}) error {
+	value := reflect.ValueOf(v)
+	if value.Kind() != reflect.Ptr || value.IsNil() || value.Elem().Type() != def.Type {
+		return fmt.Errorf("client data definition: cannot decode %s into %T", def.Type, v)
+	}
+	value = value.Elem()
+	if msg.DefineID != def.DefineID {
+		return fmt.Errorf("client data definition: message is for definition %d, not %d", msg.DefineID, def.DefineID)
+	}
+	tagged := msg.Flags&ClientDataRequestFlagTagged != 0
+	if !tagged && msg.DefineCount != DWord(len(def.Fields)) {
+		return fmt.Errorf("client data definition: message has %d datums, definition %d has %d", msg.DefineCount, def.DefineID, len(def.Fields))
+	}
+
+	data := msg.Data
+	for i := DWord(0); i < msg.DefineCount; i++ {
+		index := int(i)
+		if tagged {
+			if len(data) < 4 {
+				return fmt.Errorf("%w: datum %d of %d has no datum ID", ErrShortMessage, i, msg.DefineCount)
+			}
+			index = int(binary.LittleEndian.Uint32(data))
+			data = data[4:]
+			if index >= len(def.Fields) {
+				return fmt.Errorf("client data definition: unknown datum ID %d", index)
+			}
+		}
+		field := def.Fields[index]
+		if len(data) < field.Size {
+			return fmt.Errorf("%w: field %s needs %d bytes, got %d", ErrShortMessage, field.Name, field.Size, len(data))
+		}
+		fieldValue := value.FieldByIndex(field.index)
+		if err := binary.Read(bytes.NewReader(data[:field.Size]), binary.LittleEndian, fieldValue.Addr().Interface()); err != nil {
+			return fmt.Errorf("client data definition: field %s: %s", field.Name, err)
+		}
+		data = data[field.Size:]
+	}
+	return nil
+}
+
+// clientDataType returns the SIMCONNECT_CLIENTDATATYPE of a number, and size for everything else.
+func clientDataType(kind reflect.Kind, size int) DWord {
+	switch kind {
+	case reflect.Int8:
+		return ClientDataTypeInt8
+	case reflect.Int16:
+		return ClientDataTypeInt16
+	case reflect.Int32:
+		return ClientDataTypeInt32
+	case reflect.Int64:
+		return ClientDataTypeInt64
+	case reflect.Float32:
+		return ClientDataTypeFloat32
+	case reflect.Float64:
+		return ClientDataTypeFloat64
+	}
+	return DWord(size)
+}
+
+// ClientDataArea is a named client data area laid out like T, see ClientDataDefinition.
+// Clients share data through areas: one creates and writes it, the others attach to it and subscribe.
+type ClientDataArea[T any] struct {
+	Name       string
+	ID         DWord // SIMCONNECT_CLIENT_DATA_ID
+	Definition *ClientDataDefinition
+
+	simco     *SimConnect
+	lock      sync.Mutex
+	requestID DWord // of the subscription, 0 if there is none
+	onUpdate  func(T)
+	value     T // the last update, tagged updates are merged into it
+}
+
+// CreateClientDataArea names and creates a client data area of the size of T. flags is CreateClientDataFlagDefault,
+// or CreateClientDataFlagReadOnly to keep other clients from writing to it.
+func CreateClientDataArea[T any](simco *SimConnect, name string, flags DWord) (*ClientDataArea[T], error) {
+	area, err := newClientDataArea[T](simco, name)
+	if err != nil {
+		return nil, err
+	}
+	if err := simco.CreateClientData(area.ID, area.Definition.AreaSize(), flags); err != nil {
+		simco.ids.ClientDataDefine.Release(area.Definition.DefineID)
+		return nil, fmt.Errorf("client data area %s: %w", name, err)
+	}
+	if err := area.addDefinition(); err != nil {
+		return nil, err
+	}
+	return area, nil
+}
+
+// AttachClientDataArea attaches to a client data area which another client has created (or is going to create).
+func AttachClientDataArea[T any](simco *SimConnect, name string) (*ClientDataArea[T], error) {
+	area, err := newClientDataArea[T](simco, name)
+	if err != nil {
+		return nil, err
+	}
+	if err := area.addDefinition(); err != nil {
+		return nil, err
+	}
+	return area, nil
+}
+
+// newClientDataArea builds the definition of T and maps name to a new client data ID.
+// The client data ID is never released, since a name cannot be unmapped.
+func newClientDataArea[T any](simco *SimConnect, name string) (*ClientDataArea[T], error) {
+	var zero T
+	defineID := simco.ids.ClientDataDefine.New()
+	def, err := NewClientDataDefinition(defineID, &zero)
+	if err != nil {
+		simco.ids.ClientDataDefine.Release(defineID)
+		return nil, err
+	}
+	area := &ClientDataArea[T]{
+		Name:       name,
+		ID:         simco.ids.ClientData.New(),
+		Definition: def,
+		simco:      simco,
+	}
+	if err := simco.MapClientDataNameToID(name, area.ID); err != nil {
+		simco.ids.ClientDataDefine.Release(def.DefineID)
+		return nil, fmt.Errorf("client data area %s: %w", name, err)
+	}
+	return area, nil
+}
+
+func (area *ClientDataArea[T]) addDefinition() error {
+	if err := area.simco.AddClientDataDefinition(area.Definition); err != nil {
+		area.simco.ClearClientDataDefinition(area.Definition.DefineID)
+		area.simco.ids.ClientDataDefine.Release(area.Definition.DefineID)
+		return fmt.Errorf("client data area %s: %w", area.Name, err)
+	}
+	return nil
+}
+
+// Publish writes v to the area. Subscribers with ClientDataPeriodOnSet receive it right away.
+func (area *ClientDataArea[T]) Publish(v T) error {
+	data, err := area.Definition.Encode(&v)
+	if err != nil {
+		return err
+	}
+	return area.simco.SetClientData(area.ID, area.Definition.DefineID, ClientDataSetFlagDefault, DWord(len(data)), unsafe.Pointer(&data[0]))
+}
+
+// Subscribe calls onUpdate with the content of the area as often as period says, typically ClientDataPeriodOnSet.
+// With ClientDataRequestFlagChanged, only changes are sent; with ClientDataRequestFlagTagged as well, only the datums
+// which have changed, and onUpdate receives them merged into the previous update.
+// onUpdate runs on the goroutine of Run, RunSession or SimMate.Run. Subscribing again replaces the subscription.
+func (area *ClientDataArea[T]) Subscribe(period, flags DWord, onUpdate func(T)) error {
+	area.lock.Lock()
+	if area.requestID == 0 {
+		area.requestID = area.simco.ids.Request.New()
+	}
+	requestID := area.requestID
+	area.onUpdate = onUpdate
+	area.lock.Unlock()
+
+	area.simco.subscribe(requestID, area.receive)
+	if err := area.simco.RequestClientData(area.ID, requestID, area.Definition.DefineID, period, flags); err != nil {
+		area.unsubscribed()
+		return err
+	}
+	return nil
+}
+
+// Unsubscribe stops the updates of Subscribe.
+func (area *ClientDataArea[T]) Unsubscribe() error {
+	area.lock.Lock()
+	requestID := area.requestID
+	area.lock.Unlock()
+	if requestID == 0 {
+		return nil
+	}
+	err := area.simco.RequestClientData(area.ID, requestID, area.Definition.DefineID, ClientDataPeriodNever, ClientDataRequestFlagDefault)
+	area.unsubscribed()
+	return err
+}
+
+// Read requests the content of the area once and waits for it, see QueryObject.
+func (area *ClientDataArea[T]) Read(ctx context.Context) (T, error) {
+	var v T
+	requestID := area.simco.ids.Request.New()
+	messages, err := area.simco.request(ctx, requestID, scRequestClientData,
+		area.ID, requestID, area.Definition.DefineID, ClientDataPeriodOnce, ClientDataRequestFlagDefault, DWordZero, DWordZero, DWordZero)
+	if err != nil {
+		return v, fmt.Errorf("client data area %s: %w", area.Name, err)
+	}
+	msg, ok := messages[0].(*ClientDataMessage)
+	if !ok {
+		return v, fmt.Errorf("client data area %s: %w: %T", area.Name, ErrUnknownMessage, messages[0])
+	}
+	err = area.Definition.DecodeMessage(msg, &v)
+	return v, err
+}
+
+// Close unsubscribes and clears the client data definition. The area itself stays as long as its creator is connected.
+func (area *ClientDataArea[T]) Close() error {
+	if err := area.Unsubscribe(); err != nil {
+		return err
+	}
+	if err := area.simco.ClearClientDataDefinition(area.Definition.DefineID); err != nil {
+		return err
+	}
+	area.simco.ids.ClientDataDefine.Release(area.Definition.DefineID)
+	return nil
+}
+
+func (area *ClientDataArea[T]) receive(msg Message) {
+	clientData, ok := msg.(*ClientDataMessage)
+	if !ok {
+		return
+	}
+	area.lock.Lock()
+	if err := area.Definition.DecodeMessage(clientData, &area.value); err != nil {
+		area.lock.Unlock()
+		log.Tracef("Dropped client data of %s: %s", area.Name, err.Error())
+		return
+	}
+	value, onUpdate := area.value, area.onUpdate
+	area.lock.Unlock()
+	if onUpdate != nil {
+		onUpdate(value)
+	}
+}
+
+func (area *ClientDataArea[T]) unsubscribed() {
+	area.lock.Lock()
+	defer area.lock.Unlock()
+	if area.requestID != 0 {
+		area.simco.unsubscribe(area.requestID)
+		area.simco.ids.Request.Release(area.requestID)
+		area.requestID = 0
+	}
+	area.onUpdate = nil
+}
@@ -0,0 +1,256 @@
+package simconnect_test
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/grumpypixel/msfs2020-simconnect-go/simconnect"
+)
+
+type panelLights struct {
+	Beacon  int8
+	Landing int8
+	_       [2]byte // padding, not part of the definition
+	Panel   float32
+	Label   [3]byte
+	Speed   float64 // aligned to 8 bytes like in C
+	Count   uint16
+	Skipped int32 `clientdata:"-"`
+	hidden  int32
+	Flags   int64
+}
+
+func TestNewClientDataDefinition(t *testing.T) {
+	def, err := simconnect.NewClientDataDefinition(7, &panelLights{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	type field struct {
+		name       string
+		offset     simconnect.DWord
+		sizeOrType simconnect.DWord
+		size       int
+	}
+	want := []field{
+		{"Beacon", 0, simconnect.ClientDataTypeInt8, 1},
+		{"Landing", 1, simconnect.ClientDataTypeInt8, 1},
+		{"Panel", 4, simconnect.ClientDataTypeFloat32, 4},
+		{"Label", 8, 3, 3},
+		{"Speed", 16, simconnect.ClientDataTypeFloat64, 8},
+		{"Count", 24, 2, 2},
+		{"Flags", 40, simconnect.ClientDataTypeInt64, 8},
+	}
+	var got []field
+	for _, f := range def.Fields {
+		got = append(got, field{f.Name, f.Offset, f.SizeOrType, f.Size})
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+	if def.DefineID != 7 || def.AreaSize() != 48 || def.Size() != 27 {
+		t.Errorf("got define ID %d, area size %d and data set size %d, want 7, 48 and 27", def.DefineID, def.AreaSize(), def.Size())
+	}
+
+	// The datum ID of each datum is its index in Fields.
+	simco, sim := openSim(t)
+	if err := simco.AddClientDataDefinition(def); err != nil {
+		t.Fatal(err)
+	}
+	calls := sim.CallsTo("SimConnect_AddToClientDataDefinition")
+	if len(calls) != len(want) {
+		t.Fatalf("got %d datums added, want %d", len(calls), len(want))
+	}
+	for i, call := range calls {
+		wantArgs := []interface{}{simconnect.DWord(7), want[i].offset, want[i].sizeOrType, float32(0), simconnect.DWord(i)}
+		if !reflect.DeepEqual(call.Args, wantArgs) {
+			t.Errorf("datum %d: got %v, want %v", i, call.Args, wantArgs)
+		}
+	}
+}
+
+func TestNewClientDataDefinitionErrors(t *testing.T) {
+	type maxSize struct {
+		Data [simconnect.ClientDataMaxSize]byte
+	}
+	type tooLarge struct {
+		Data [simconnect.ClientDataMaxSize]byte
+		More int8
+	}
+	if _, err := simconnect.NewClientDataDefinition(1, maxSize{}); err != nil {
+		t.Errorf("ClientDataMaxSize bytes: %v", err)
+	}
+	if _, err := simconnect.NewClientDataDefinition(1, &tooLarge{}); !errors.Is(err, simconnect.ErrClientDataTooLarge) {
+		t.Errorf("ClientDataMaxSize+1 bytes: got %v, want ErrClientDataTooLarge", err)
+	}
+	for _, v := range []interface{}{
+		nil,
+		42,
+		&struct{ hidden int32 }{},
+		&struct{ Name string }{},
+		&struct{ Count int }{},
+		&struct{ Values []float64 }{},
+		&struct {
+			Padded struct {
+				A int8
+				B int32
+			}
+		}{},
+	} {
+		if _, err := simconnect.NewClientDataDefinition(1, v); err == nil {
+			t.Errorf("%T is accepted", v)
+		}
+	}
+}
+
+func TestCreateClientDataAreaTooLarge(t *testing.T) {
+	type tooLarge struct {
+		Data [simconnect.ClientDataMaxSize + 1]byte
+	}
+	simco, sim := openSim(t)
+	if _, err := simconnect.CreateClientDataArea[tooLarge](simco, "test.large", simconnect.CreateClientDataFlagDefault); !errors.Is(err, simconnect.ErrClientDataTooLarge) {
+		t.Errorf("got %v, want ErrClientDataTooLarge", err)
+	}
+	if calls := sim.Calls(); len(calls) != 0 {
+		t.Errorf("an area which is too large is sent: %+v", calls)
+	}
+	if simco.IDs().ClientDataDefine.InUse(1) {
+		t.Error("the define ID of the rejected definition is kept")
+	}
+}
+
+func le32(v uint32) []byte {
+	b := make([]byte, 4)
+	binary.LittleEndian.PutUint32(b, v)
+	return b
+}
+
+func TestDecodeMessageTagged(t *testing.T) {
+	def, err := simconnect.NewClientDataDefinition(7, panelLights{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tagged := func(parts ...interface{}) *simconnect.ClientDataMessage {
+		msg := &simconnect.ClientDataMessage{}
+		msg.DefineID, msg.Flags, msg.DefineCount = 7, simconnect.ClientDataRequestFlagTagged, simconnect.DWord(len(parts)/2)
+		for _, part := range parts {
+			switch part := part.(type) {
+			case int:
+				msg.Data = append(msg.Data, le32(uint32(part))...)
+			case []byte:
+				msg.Data = append(msg.Data, part...)
+			}
+		}
+		return msg
+	}
+
+	v := panelLights{Beacon: 1, Landing: 1, Panel: 0.5, Speed: 100, Flags: -1}
+	// Only the datums in the message are set, the others keep their values.
+	msg := tagged(2, le32(math.Float32bits(0.75)), 0, []byte{0})
+	if err := def.DecodeMessage(msg, &v); err != nil {
+		t.Fatal(err)
+	}
+	if want := (panelLights{Beacon: 0, Landing: 1, Panel: 0.75, Speed: 100, Flags: -1}); v != want {
+		t.Errorf("got %+v, want %+v", v, want)
+	}
+
+	for _, test := range []struct {
+		name string
+		msg  *simconnect.ClientDataMessage
+	}{
+		{"unknown datum ID", tagged(7, []byte{1})},
+		{"short datum", tagged(2, []byte{1, 2})},
+		{"no datum ID", &simconnect.ClientDataMessage{RecvClientData: simconnect.RecvClientData{RecvSimObjectData: simconnect.RecvSimObjectData{
+			DefineID: 7, Flags: simconnect.ClientDataRequestFlagTagged, DefineCount: 1}}, Data: []byte{1}}},
+	} {
+		if err := def.DecodeMessage(test.msg, &v); err == nil {
+			t.Errorf("%s: no error", test.name)
+		}
+	}
+	untagged := &simconnect.ClientDataMessage{}
+	untagged.DefineID, untagged.DefineCount = 7, 1
+	if err := def.DecodeMessage(untagged, &v); err == nil {
+		t.Error("an untagged message with one of seven datums is accepted")
+	}
+}
+
+type switches struct {
+	Master  int32
+	Avionic int32
+	Pitot   int32
+}
+
+func TestClientDataAreaSubscribeTagged(t *testing.T) {
+	simco, sim := openSim(t)
+	area, err := simconnect.CreateClientDataArea[switches](simco, "test.switches", simconnect.CreateClientDataFlagDefault)
+	if err != nil {
+		t.Fatal(err)
+	}
+	updates := make(chan switches, 8)
+	if err := area.Subscribe(simconnect.ClientDataPeriodOnSet, simconnect.ClientDataRequestFlagChanged|simconnect.ClientDataRequestFlagTagged, func(v switches) {
+		updates <- v
+	}); err != nil {
+		t.Fatal(err)
+	}
+	runAsync(t, simco, nil)
+
+	if err := area.Publish(switches{Master: 1, Avionic: 1, Pitot: 0}); err != nil {
+		t.Fatal(err)
+	}
+	if got := receive(t, updates); got != (switches{1, 1, 0}) {
+		t.Errorf("first update: got %+v", got)
+	}
+	// Another client turns on the pitot heat: only that datum is sent and merged into the last update.
+	if err := sim.WriteClientData("test.switches", 8, []byte{1, 0, 0, 0}); err != nil {
+		t.Fatal(err)
+	}
+	if got := receive(t, updates); got != (switches{1, 1, 1}) {
+		t.Errorf("second update: got %+v", got)
+	}
+}
+
+func TestClientDataAreaUnsubscribe(t *testing.T) {
+	simco, sim := openSim(t)
+	sim.CreateClientData("test.switches", 12)
+	area, err := simconnect.AttachClientDataArea[switches](simco, "test.switches")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := area.Unsubscribe(); err != nil {
+		t.Errorf("Unsubscribe without subscription: %v", err)
+	}
+	if calls := sim.CallsTo("SimConnect_RequestClientData"); len(calls) != 0 {
+		t.Errorf("Unsubscribe without subscription sends %+v", calls)
+	}
+
+	updates := make(chan switches, 8)
+	if err := area.Subscribe(simconnect.ClientDataPeriodOnSet, simconnect.ClientDataRequestFlagDefault, func(v switches) {
+		updates <- v
+	}); err != nil {
+		t.Fatal(err)
+	}
+	stop := runAsync(t, simco, nil)
+	sim.WriteClientData("test.switches", 0, []byte{1, 0, 0, 0})
+	if got := receive(t, updates); got.Master != 1 {
+		t.Errorf("got %+v, want the master switch on", got)
+	}
+	requestID := sim.CallsTo("SimConnect_RequestClientData")[0].Args[1].(simconnect.DWord)
+
+	if err := area.Unsubscribe(); err != nil {
+		t.Fatal(err)
+	}
+	calls := sim.CallsTo("SimConnect_RequestClientData")
+	if len(calls) != 2 || calls[1].Args[1] != requestID || calls[1].Args[3] != simconnect.ClientDataPeriodNever {
+		t.Errorf("got %+v, want request %d stopped with ClientDataPeriodNever", calls, requestID)
+	}
+	if simco.IDs().Request.InUse(requestID) {
+		t.Errorf("request ID %d is kept after unsubscribing", requestID)
+	}
+	sim.WriteClientData("test.switches", 4, []byte{1, 0, 0, 0})
+	stop()
+	if len(updates) != 0 {
+		t.Errorf("got %+v after unsubscribing", <-updates)
+	}
+}
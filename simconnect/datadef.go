@@ -0,0 +1,568 @@
+package simconnect
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"reflect"
+	"strings"
+	"unsafe"
+)
+
+var (
+	typeLatLonAlt    = reflect.TypeOf(LatLonAlt{})
+	typeXYZ          = reflect.TypeOf(XYZ{})
+	typeInitPosition = reflect.TypeOf(InitPosition{})
+)
+
+// DataDefinition is a data definition built from the tagged fields of a Go struct, so a single
+// RequestDataOnSimObject fills the whole struct:
+//
+//	type Aircraft struct {
+//		Title    string    `simvar:"TITLE" type:"string256"`
+//		Altitude float64   `simvar:"PLANE ALTITUDE" unit:"feet"`
+//		Heading  float64   `simvar:"PLANE HEADING DEGREES TRUE" unit:"degrees"`
+//		Position LatLonAlt `simvar:"STRUCT LATLONALT"`
+//	}
+//
+// The data type of a datum is derived from the field type: float64, float32, int32, int64,
+// [N]byte (N = 8, 32, 64, 128, 256 or 260), LatLonAlt, XYZ and InitPosition map to the matching
+// SIMCONNECT_DATATYPE. string fields are sent as string256 unless the type tag names another string type,
+// e.g. `type:"stringv"` for a variable-length string.
+// Fields without a simvar tag, or tagged with "-", are not part of the definition.
+//
+// The datum ID of each datum is its index in Fields, so DecodeMessage can also decode data requested with DataRequestFlagTagged.
+type DataDefinition struct {
+	DefineID DWord
+	Type     reflect.Type
+	Fields   []DataField
+}
+
+// DataField is a single datum of a DataDefinition.
+type DataField struct {
+	Name     string // name of the struct field
+	SimVar   string
+	Unit     string
+	DataType DWord
+	Size     int // size in bytes, 0 for DataTypeStringV
+	index    []int
+}
+
+// NewDataDefinition builds the data definition of v, which must be a struct or a pointer to a struct.
+// Nothing is sent to the simulator, see RegisterDataDefinition.
+func NewDataDefinition(defineID DWord, v interface{}) (*DataDefinition, error) {
+	t := reflect.TypeOf(v)
+	if t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("data definition: %T is no struct", v)
+	}
+
+	def := &DataDefinition{
+		DefineID: defineID,
+		Type:     t,
+	}
+	for i := 0; i < t.NumField(); i++ {
+		structField := t.Field(i)
+		simVar, ok := structField.Tag.Lookup("simvar")
+		if !ok || simVar == "-" {
+			continue
+		}
+		if structField.PkgPath != "" {
+			return nil, fmt.Errorf("data definition: field %s.%s is not exported", t.Name(), structField.Name)
+		}
+		dataType, size, err := fieldDataType(structField)
+		if err != nil {
+			return nil, fmt.Errorf("data definition: field %s.%s: %s", t.Name(), structField.Name, err)
+		}
+		def.Fields = append(def.Fields, DataField{
+			Name:     structField.Name,
+			SimVar:   simVar,
+			Unit:     structField.Tag.Get("unit"),
+			DataType: dataType,
+			Size:     size,
+			index:    structField.Index,
+		})
+	}
+	if len(def.Fields) == 0 {
+		return nil, fmt.Errorf("data definition: %s has no simvar fields", t.Name())
+	}
+	return def, nil
+}
+
+// RegisterDataDefinition builds the data definition of v and adds its datums with AddToDataDefinition.
+func (simco *SimConnect) RegisterDataDefinition(defineID DWord, v interface{}) (*DataDefinition, error) {
+	def, err := NewDataDefinition(defineID, v)
+	if err != nil {
+		return nil, err
+	}
+	if err := simco.AddDataDefinition(def); err != nil {
+		return nil, err
+	}
+	return def, nil
+}
+
+// AddDataDefinition adds all datums of def to the simulator's definition def.DefineID.
+func (simco *SimConnect) AddDataDefinition(def *DataDefinition) error {
+	_, err := simco.addDataDefinition(def, false)
+	return err
+}
+
+// addDataDefinition adds all datums of def and returns the packet IDs of the calls if needID is set.
+func (simco *SimConnect) addDataDefinition(def *DataDefinition, needID bool) ([]DWord, error) {
+	sendIDs := make([]DWord, 0, len(def.Fields))
+	for i, field := range def.Fields {
+		sendID, err := simco.addDatum(needID, def.DefineID, field.SimVar, field.Unit, field.DataType, 0, DWord(i))
+		if err != nil {
+			return nil, err
+		}
+		sendIDs = append(sendIDs, sendID)
+	}
+	return sendIDs, nil
+}
+
+// Size returns the number of bytes of one data set. StringV fields have a variable length and are not counted.
+func (def *DataDefinition) Size() int {
+	size := 0
+	for _, field := range def.Fields {
+		size += field.Size
+	}
+	return size
+}
+
+// Decode fills the struct v points to from data, the payload of a SimObjectDataMessage or SimObjectDataByTypeMessage
+// which was requested in the default format.
+func (def *DataDefinition) Decode(data []byte, v interface{}) error {
+	value, err := def.target(v)
+	if err != nil {
+		return err
+	}
+	if len(data) < def.Size() {
+		return fmt.Errorf("%w: data definition %d needs %d bytes, got %d", ErrShortMessage, def.DefineID, def.Size(), len(data))
+	}
+	for i := range def.Fields {
+		if data, err = def.decodeField(i, data, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DecodeMessage fills the struct v points to from a SimObjectDataMessage, SimObjectDataByTypeMessage or ClientDataMessage.
+// It honours DataRequestFlagTagged, in which case only the datums contained in the message are set.
+func (def *DataDefinition) DecodeMessage(msg Message, v interface{}) error {
+	var recv *RecvSimObjectData
+	var data []byte
+	switch msg := msg.(type) {
+	case *SimObjectDataMessage:
+		recv, data = &msg.RecvSimObjectData, msg.Data
+	case *SimObjectDataByTypeMessage:
+		recv, data = &msg.RecvSimObjectData, msg.Data
+	case *ClientDataMessage:
+		recv, data = &msg.RecvSimObjectData, msg.Data
+	default:
+		return fmt.Errorf("data definition: cannot decode %T", msg)
+	}
+	if recv.DefineID != def.DefineID {
+		return fmt.Errorf("data definition: message is for definition %d, not %d", recv.DefineID, def.DefineID)
+	}
+	if recv.Flags&DataRequestFlagTagged == 0 {
+		if recv.DefineCount != DWord(len(def.Fields)) {
+			return fmt.Errorf("data definition: message has %d datums, definition %d has %d", recv.DefineCount, def.DefineID, len(def.Fields))
+		}
+		return def.Decode(data, v)
+	}
+
+	value, err := def.target(v)
+	if err != nil {
+		return err
+	}
+	for i := DWord(0); i < recv.DefineCount; i++ {
+		if len(data) < 4 {
+			return fmt.Errorf("%w: datum %d of %d has no datum ID", ErrShortMessage, i, recv.DefineCount)
+		}
+		datumID := binary.LittleEndian.Uint32(data)
+		if int(datumID) >= len(def.Fields) {
+			return fmt.Errorf("data definition: unknown datum ID %d", datumID)
+		}
+		if data, err = def.decodeField(int(datumID), data[4:], value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// target returns the struct v points to.
+func (def *DataDefinition) target(v interface{}) (reflect.Value, error) {
+	value := reflect.ValueOf(v)
+	if value.Kind() != reflect.Ptr || value.IsNil() || value.Elem().Type() != def.Type {
+		return reflect.Value{}, fmt.Errorf("data definition: cannot decode %s into %T", def.Type, v)
+	}
+	return value.Elem(), nil
+}
+
+// decodeField sets field i of value from the start of data and returns the bytes which follow the datum.
+func (def *DataDefinition) decodeField(i int, data []byte, value reflect.Value) ([]byte, error) {
+	field := def.Fields[i]
+	fieldValue := value.FieldByIndex(field.index)
+
+	if field.DataType == DataTypeStringV {
+		str, size, err := RetrieveString(data)
+		if err != nil {
+			return nil, fmt.Errorf("data definition: field %s: %w", field.Name, err)
+		}
+		fieldValue.SetString(str)
+		return data[size:], nil
+	}
+	if len(data) < field.Size {
+		return nil, fmt.Errorf("%w: field %s needs %d bytes, got %d", ErrShortMessage, field.Name, field.Size, len(data))
+	}
+	if fieldValue.Kind() == reflect.String {
+		fieldValue.SetString(stringFromBytes(data[:field.Size]))
+	} else if err := binary.Read(bytes.NewReader(data[:field.Size]), binary.LittleEndian, fieldValue.Addr().Interface()); err != nil {
+		return nil, fmt.Errorf("data definition: field %s: %s", field.Name, err)
+	}
+	return data[field.Size:], nil
+}
+
+// RetrieveString reads the DataTypeStringV at the start of data, the counterpart of SimConnect_RetrieveString.
+// It returns the string and the number of bytes it occupies including the terminating null.
+func RetrieveString(data []byte) (string, int, error) {
+	end := bytes.IndexByte(data, 0)
+	if end < 0 {
+		return "", 0, fmt.Errorf("%w: unterminated stringv", ErrShortMessage)
+	}
+	return string(data[:end]), end + 1, nil
+}
+
+// Encode returns the data set of v, a struct or a pointer to a struct of def.Type, as expected by SetDataOnSimObject.
+func (def *DataDefinition) Encode(v interface{}) ([]byte, error) {
+	value := reflect.ValueOf(v)
+	if value.Kind() == reflect.Ptr && !value.IsNil() {
+		value = value.Elem()
+	}
+	if !value.IsValid() || value.Type() != def.Type {
+		return nil, fmt.Errorf("data definition: cannot encode %T as %s", v, def.Type)
+	}
+	var buf bytes.Buffer
+	for _, field := range def.Fields {
+		if err := encodeDatum(&buf, field.DataType, value.FieldByIndex(field.index).Interface()); err != nil {
+			return nil, fmt.Errorf("data definition: field %s: %s", field.Name, err)
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+// SetData writes the tagged struct v (see DataDefinition) to the object with SetDataOnSimObject.
+// The data definition of v's type is registered on first use and reused by later calls.
+func (simco *SimConnect) SetData(objectID DWord, v interface{}) error {
+	t := reflect.TypeOf(v)
+	if t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	def, err := simco.cachedDataDefinition(t, func(defineID DWord) (*DataDefinition, error) {
+		return NewDataDefinition(defineID, v)
+	})
+	if err != nil {
+		return err
+	}
+	data, err := def.Encode(v)
+	if err != nil {
+		return err
+	}
+	return simco.setData(def.DefineID, objectID, data)
+}
+
+// SetSimVarValue writes a single simulation variable to the object with SetDataOnSimObject.
+// value is converted to dataType; numbers convert into each other, strings and byte arrays
+// into the fixed-size string types, and strings into DataTypeStringV. The data definition is registered on first use and reused by later calls,
+// unless the simulator rejects it.
+func (simco *SimConnect) SetSimVarValue(objectID DWord, name, unit string, dataType DWord, value interface{}) error {
+	size := datumSize(dataType)
+	if size == 0 && dataType != DataTypeStringV {
+		return fmt.Errorf("SetSimVarValue %s: unsupported data type %s", name, DataTypeToString(dataType))
+	}
+	var buf bytes.Buffer
+	if err := encodeDatum(&buf, dataType, value); err != nil {
+		return fmt.Errorf("SetSimVarValue %s: %s", name, err)
+	}
+	def, err := simco.simVarDefinition(name, unit, dataType)
+	if err != nil {
+		return err
+	}
+	return simco.setData(def.DefineID, objectID, buf.Bytes())
+}
+
+// simVarDefinition returns the cached data definition of a single simulation variable, see SetSimVarValue and Query.
+func (simco *SimConnect) simVarDefinition(name, unit string, dataType DWord) (*DataDefinition, error) {
+	key := simVarKey{name, unit, dataType}
+	return simco.cachedDataDefinition(key, func(defineID DWord) (*DataDefinition, error) {
+		field := DataField{
+			Name:     name,
+			SimVar:   name,
+			Unit:     unit,
+			DataType: dataType,
+			Size:     datumSize(dataType),
+		}
+		return &DataDefinition{DefineID: defineID, Fields: []DataField{field}}, nil
+	})
+}
+
+type simVarKey struct {
+	name     string
+	unit     string
+	dataType DWord
+}
+
+// cachedDataDefinition returns the data definition cached under key. If there is none yet, it is
+// created with newDefinition and added to the simulator.
+func (simco *SimConnect) cachedDataDefinition(key interface{}, newDefinition func(defineID DWord) (*DataDefinition, error)) (*DataDefinition, error) {
+	simco.definitionLock.Lock()
+	if def, ok := simco.definitions[key]; ok {
+		simco.definitionLock.Unlock()
+		return def, nil
+	}
+	defineID := simco.ids.Define.New()
+	def, err := newDefinition(defineID)
+	if err != nil {
+		simco.ids.Define.Release(defineID)
+		simco.definitionLock.Unlock()
+		return nil, err
+	}
+	sendIDs, err := simco.addDataDefinition(def, true)
+	if err != nil {
+		simco.ClearDataDefinition(defineID)
+		simco.ids.Define.Release(defineID)
+		simco.definitionLock.Unlock()
+		return nil, err
+	}
+	if simco.definitions == nil {
+		simco.definitions = make(map[interface{}]*DataDefinition)
+	}
+	simco.definitions[key] = def
+	simco.definitionLock.Unlock()
+
+	// A datum the simulator does not know, e.g. a misspelled simulation variable, is only reported
+	// with an exception later on. The definition is dropped then, so the next use defines it anew.
+	for _, sendID := range sendIDs {
+		simco.errorOf(sendID, func(*SimConnectError) {
+			simco.dropDataDefinition(key, def)
+		})
+	}
+	return def, nil
+}
+
+// dropDataDefinition removes def from the cache and the simulator, unless it has been dropped before.
+func (simco *SimConnect) dropDataDefinition(key interface{}, def *DataDefinition) {
+	simco.definitionLock.Lock()
+	defer simco.definitionLock.Unlock()
+	if simco.definitions[key] != def {
+		return
+	}
+	delete(simco.definitions, key)
+	simco.ClearDataDefinition(def.DefineID)
+	simco.ids.Define.Release(def.DefineID)
+}
+
+func (simco *SimConnect) resetDataDefinitions() {
+	simco.definitionLock.Lock()
+	defer simco.definitionLock.Unlock()
+	simco.definitions = nil
+}
+
+func (simco *SimConnect) setData(defineID, objectID DWord, data []byte) error {
+	return simco.SetDataOnSimObject(defineID, objectID, DataSetFlagDefault, 0, DWord(len(data)), unsafe.Pointer(&data[0]))
+}
+
+// encodeDatum writes value as a datum of dataType.
+func encodeDatum(w *bytes.Buffer, dataType DWord, value interface{}) error {
+	if dataType == DataTypeStringV {
+		str, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("cannot convert %T to %s", value, DataTypeToString(dataType))
+		}
+		if strings.IndexByte(str, 0) >= 0 {
+			return fmt.Errorf("stringv must not contain a null character")
+		}
+		w.WriteString(str)
+		w.WriteByte(0)
+		return nil
+	}
+	if size := fixedStringSize(dataType); size > 0 {
+		b := make([]byte, size)
+		switch v := value.(type) {
+		case string:
+			copy(b[:size-1], v)
+		case []byte:
+			copy(b[:size-1], v)
+		default:
+			array := reflect.ValueOf(value)
+			if array.Kind() != reflect.Array || array.Type().Elem().Kind() != reflect.Uint8 {
+				return fmt.Errorf("cannot convert %T to %s", value, DataTypeToString(dataType))
+			}
+			reflect.Copy(reflect.ValueOf(b), array)
+		}
+		w.Write(b)
+		return nil
+	}
+
+	switch dataType {
+	case DataTypeInt32:
+		number, err := toInt64(value)
+		if err != nil {
+			return err
+		}
+		return binary.Write(w, binary.LittleEndian, int32(number))
+
+	case DataTypeInt64:
+		number, err := toInt64(value)
+		if err != nil {
+			return err
+		}
+		return binary.Write(w, binary.LittleEndian, number)
+
+	case DataTypeFloat32:
+		number, err := toFloat64(value)
+		if err != nil {
+			return err
+		}
+		return binary.Write(w, binary.LittleEndian, float32(number))
+
+	case DataTypeFloat64:
+		number, err := toFloat64(value)
+		if err != nil {
+			return err
+		}
+		return binary.Write(w, binary.LittleEndian, number)
+
+	case DataTypeInitPosition, DataTypeLatLonAlt, DataTypeXYZ:
+		t := reflect.TypeOf(value)
+		if (dataType == DataTypeInitPosition && t != typeInitPosition) ||
+			(dataType == DataTypeLatLonAlt && t != typeLatLonAlt) ||
+			(dataType == DataTypeXYZ && t != typeXYZ) {
+			return fmt.Errorf("cannot convert %T to %s", value, DataTypeToString(dataType))
+		}
+		return binary.Write(w, binary.LittleEndian, value)
+	}
+	return fmt.Errorf("unsupported data type %s", DataTypeToString(dataType))
+}
+
+// datumSize returns the number of bytes of a datum of dataType, or 0 if it has no fixed size.
+func datumSize(dataType DWord) int {
+	switch dataType {
+	case DataTypeInt32, DataTypeFloat32:
+		return 4
+	case DataTypeInt64, DataTypeFloat64:
+		return 8
+	case DataTypeInitPosition:
+		return binary.Size(InitPosition{})
+	case DataTypeLatLonAlt:
+		return binary.Size(LatLonAlt{})
+	case DataTypeXYZ:
+		return binary.Size(XYZ{})
+	}
+	return fixedStringSize(dataType)
+}
+
+func toInt64(value interface{}) (int64, error) {
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int(), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return int64(v.Uint()), nil
+	case reflect.Float32, reflect.Float64:
+		return int64(v.Float()), nil
+	case reflect.Bool:
+		if v.Bool() {
+			return 1, nil
+		}
+		return 0, nil
+	}
+	return 0, fmt.Errorf("cannot convert %T to a number", value)
+}
+
+func toFloat64(value interface{}) (float64, error) {
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), nil
+	}
+	number, err := toInt64(value)
+	return float64(number), err
+}
+
+// fieldDataType returns the SIMCONNECT_DATATYPE of a struct field and its size in bytes.
+func fieldDataType(field reflect.StructField) (DWord, int, error) {
+	typeName := strings.ToLower(field.Tag.Get("type"))
+	t := field.Type
+
+	var dataType DWord
+	switch {
+	case t == typeLatLonAlt:
+		dataType = DataTypeLatLonAlt
+	case t == typeXYZ:
+		dataType = DataTypeXYZ
+	case t == typeInitPosition:
+		dataType = DataTypeInitPosition
+	case t.Kind() == reflect.Float64:
+		dataType = DataTypeFloat64
+	case t.Kind() == reflect.Float32:
+		dataType = DataTypeFloat32
+	case t.Kind() == reflect.Int32:
+		dataType = DataTypeInt32
+	case t.Kind() == reflect.Int64:
+		dataType = DataTypeInt64
+	case t.Kind() == reflect.Array && t.Elem().Kind() == reflect.Uint8:
+		dataType = fixedStringDataType(t.Len())
+		if dataType == DataTypeInvalid {
+			return DataTypeInvalid, 0, fmt.Errorf("no string data type of %d bytes", t.Len())
+		}
+	case t.Kind() == reflect.String:
+		dataType = DataTypeString256
+		if typeName != "" {
+			dataType = StringToDataType(typeName)
+			if dataType == DataTypeStringV {
+				return dataType, 0, nil
+			}
+			if fixedStringSize(dataType) == 0 {
+				return DataTypeInvalid, 0, fmt.Errorf("type %q is no string type", typeName)
+			}
+		}
+		return dataType, fixedStringSize(dataType), nil
+	default:
+		return DataTypeInvalid, 0, fmt.Errorf("unsupported type %s", t)
+	}
+	if typeName != "" && StringToDataType(typeName) != dataType {
+		return DataTypeInvalid, 0, fmt.Errorf("type %q does not match %s", typeName, t)
+	}
+	return dataType, int(t.Size()), nil
+}
+
+func fixedStringSize(dataType DWord) int {
+	switch dataType {
+	case DataTypeString8:
+		return 8
+	case DataTypeString32:
+		return 32
+	case DataTypeString64:
+		return 64
+	case DataTypeString128:
+		return 128
+	case DataTypeString256:
+		return 256
+	case DataTypeString260:
+		return 260
+	}
+	return 0
+}
+
+func fixedStringDataType(size int) DWord {
+	for _, dataType := range []DWord{DataTypeString8, DataTypeString32, DataTypeString64, DataTypeString128, DataTypeString256, DataTypeString260} {
+		if fixedStringSize(dataType) == size {
+			return dataType
+		}
+	}
+	return DataTypeInvalid
+}
@@ -0,0 +1,268 @@
+package simconnect_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"testing"
+
+	"github.com/grumpypixel/msfs2020-simconnect-go/simconnect"
+)
+
+func TestSetSimVarValueReleasesDefineIDOnFailure(t *testing.T) {
+	simco, sim := openSim(t)
+	failure := errors.New("definition failed")
+	sim.FailNextCall(failure)
+	err := simco.SetSimVarValue(simconnect.ObjectIDUser, "PLANE ALTITUDE", "feet", simconnect.DataTypeFloat64, 1000.0)
+	if !errors.Is(err, failure) {
+		t.Fatalf("got %v, want the failure of AddToDataDefinition", err)
+	}
+	if simco.IDs().Define.InUse(1) {
+		t.Error("the define ID of the failed definition is still in use")
+	}
+
+	if err := simco.SetSimVarValue(simconnect.ObjectIDUser, "PLANE ALTITUDE", "feet", simconnect.DataTypeFloat64, 1000.0); err != nil {
+		t.Fatal(err)
+	}
+	if got, _ := sim.SimVar("PLANE ALTITUDE"); got != 1000.0 {
+		t.Errorf("PLANE ALTITUDE: got %v", got)
+	}
+	if calls := sim.CallsTo("SimConnect_AddToDataDefinition"); len(calls) != 2 || calls[1].Args[0] != simconnect.DWord(1) {
+		t.Errorf("the define ID is not handed out again: %+v", calls)
+	}
+}
+
+func TestNewDataDefinition(t *testing.T) {
+	type engine struct {
+		RPM float64 `simvar:"GENERAL ENG RPM:1" unit:"rpm"`
+	}
+	type aircraft struct {
+		Title    string               `simvar:"TITLE"`
+		ATCID    string               `simvar:"ATC ID" type:"String32"`
+		Model    string               `simvar:"ATC MODEL" type:"stringv"`
+		Callsign [64]byte             `simvar:"ATC AIRLINE"`
+		Altitude float64              `simvar:"PLANE ALTITUDE" unit:"feet"`
+		Flaps    float32              `simvar:"FLAPS HANDLE PERCENT" unit:"percent" type:"float32"`
+		Gear     int32                `simvar:"GEAR HANDLE POSITION" unit:"bool"`
+		Ticks    int64                `simvar:"ABSOLUTE TIME" unit:"seconds"`
+		Position simconnect.LatLonAlt `simvar:"STRUCT LATLONALT"`
+		Velocity simconnect.XYZ       `simvar:"STRUCT WORLDVELOCITY"`
+		Ignored  float64              `simvar:"-"`
+		Engine   engine               // untagged, nested structs are not searched
+		Comment  string
+	}
+
+	def, err := simconnect.NewDataDefinition(3, &aircraft{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// DataField also holds the unexported field index, so the exported fields are compared.
+	type datum struct {
+		Name, SimVar, Unit string
+		DataType           simconnect.DWord
+		Size               int
+	}
+	want := []datum{
+		{Name: "Title", SimVar: "TITLE", DataType: simconnect.DataTypeString256, Size: 256},
+		{Name: "ATCID", SimVar: "ATC ID", DataType: simconnect.DataTypeString32, Size: 32},
+		{Name: "Model", SimVar: "ATC MODEL", DataType: simconnect.DataTypeStringV, Size: 0},
+		{Name: "Callsign", SimVar: "ATC AIRLINE", DataType: simconnect.DataTypeString64, Size: 64},
+		{Name: "Altitude", SimVar: "PLANE ALTITUDE", Unit: "feet", DataType: simconnect.DataTypeFloat64, Size: 8},
+		{Name: "Flaps", SimVar: "FLAPS HANDLE PERCENT", Unit: "percent", DataType: simconnect.DataTypeFloat32, Size: 4},
+		{Name: "Gear", SimVar: "GEAR HANDLE POSITION", Unit: "bool", DataType: simconnect.DataTypeInt32, Size: 4},
+		{Name: "Ticks", SimVar: "ABSOLUTE TIME", Unit: "seconds", DataType: simconnect.DataTypeInt64, Size: 8},
+		{Name: "Position", SimVar: "STRUCT LATLONALT", DataType: simconnect.DataTypeLatLonAlt, Size: 24},
+		{Name: "Velocity", SimVar: "STRUCT WORLDVELOCITY", DataType: simconnect.DataTypeXYZ, Size: 24},
+	}
+	if def.DefineID != 3 || len(def.Fields) != len(want) {
+		t.Fatalf("got define ID %d and %d fields, want 3 and %d", def.DefineID, len(def.Fields), len(want))
+	}
+	for i, field := range def.Fields {
+		got := datum{field.Name, field.SimVar, field.Unit, field.DataType, field.Size}
+		if got != want[i] {
+			t.Errorf("field %d: got %+v, want %+v", i, got, want[i])
+		}
+	}
+	if size := def.Size(); size != 256+32+64+8+4+4+8+24+24 {
+		t.Errorf("size: got %d", size)
+	}
+}
+
+func TestNewDataDefinitionErrors(t *testing.T) {
+	type position struct {
+		Latitude, Longitude float64
+	}
+	tests := []struct {
+		name string
+		v    interface{}
+	}{
+		{"no struct", 42.0},
+		{"nil", nil},
+		{"no simvar fields", &struct{ Altitude float64 }{}},
+		{"only ignored fields", &struct {
+			Altitude float64 `simvar:"-"`
+		}{}},
+		{"unexported field", &struct {
+			altitude float64 `simvar:"PLANE ALTITUDE"`
+		}{}},
+		{"nested struct", &struct {
+			Position position `simvar:"PLANE POSITION"`
+		}{}},
+		{"unsupported type", &struct {
+			Gear bool `simvar:"GEAR HANDLE POSITION"`
+		}{}},
+		{"unsupported int", &struct {
+			Gear int `simvar:"GEAR HANDLE POSITION"`
+		}{}},
+		{"byte array size", &struct {
+			Title [100]byte `simvar:"TITLE"`
+		}{}},
+		{"type mismatch", &struct {
+			Altitude float64 `simvar:"PLANE ALTITUDE" type:"int32"`
+		}{}},
+		{"no string type", &struct {
+			Title string `simvar:"TITLE" type:"float64"`
+		}{}},
+		{"unknown type", &struct {
+			Title string `simvar:"TITLE" type:"string512"`
+		}{}},
+	}
+	for _, test := range tests {
+		if def, err := simconnect.NewDataDefinition(1, test.v); err == nil {
+			t.Errorf("%s: got %+v, want an error", test.name, def.Fields)
+		}
+	}
+}
+
+func TestSetSimVarValueDropsRejectedDefinition(t *testing.T) {
+	simco, sim := openSim(t)
+	runAsync(t, simco, simconnect.HandlerFunc(func(context.Context, simconnect.Message) {}))
+
+	// The simulator accepts the misspelled name at first and reports it with an exception later on.
+	if err := simco.SetSimVarValue(simconnect.ObjectIDUser, "PLANE ALTTUDE", "feet", simconnect.DataTypeFloat64, 1000.0); err != nil {
+		t.Fatal(err)
+	}
+	calls := sim.CallsTo("SimConnect_AddToDataDefinition")
+	if len(calls) != 1 {
+		t.Fatalf("got %d definition calls, want 1", len(calls))
+	}
+	sim.InjectExceptionFor(calls[0].SendID, simconnect.ExceptionNameUnrecognized, 2)
+	eventually(t, "the definition to be cleared", func() bool {
+		return len(sim.CallsTo("SimConnect_ClearDataDefinition")) == 1
+	})
+	if simco.IDs().Define.InUse(1) {
+		t.Error("the define ID of the rejected definition is still in use")
+	}
+
+	// The next use defines the simulation variable anew.
+	if err := simco.SetSimVarValue(simconnect.ObjectIDUser, "PLANE ALTTUDE", "feet", simconnect.DataTypeFloat64, 1000.0); err != nil {
+		t.Fatal(err)
+	}
+	if calls := sim.CallsTo("SimConnect_AddToDataDefinition"); len(calls) != 2 {
+		t.Errorf("got %d definition calls, want 2", len(calls))
+	}
+}
+
+type stringVAircraft struct {
+	Altitude float64 `simvar:"PLANE ALTITUDE" unit:"feet"`
+	Title    string  `simvar:"TITLE" type:"stringv"`
+	Heading  float64 `simvar:"PLANE HEADING DEGREES TRUE" unit:"degrees"`
+}
+
+// stringVData lays out the datums of stringVAircraft as the simulator sends them.
+func stringVData(altitude float64, title string, heading float64) []byte {
+	var buf bytes.Buffer
+	binary.Write(&buf, binary.LittleEndian, altitude)
+	buf.WriteString(title)
+	buf.WriteByte(0)
+	binary.Write(&buf, binary.LittleEndian, heading)
+	return buf.Bytes()
+}
+
+func TestRetrieveString(t *testing.T) {
+	tests := []struct {
+		data []byte
+		want string
+		size int
+	}{
+		{[]byte("Cessna Skyhawk\x00\x01\x02"), "Cessna Skyhawk", 15},
+		{[]byte("\x00Cessna"), "", 1},
+		{[]byte("A\x00B\x00"), "A", 2},
+	}
+	for _, test := range tests {
+		got, size, err := simconnect.RetrieveString(test.data)
+		if err != nil || got != test.want || size != test.size {
+			t.Errorf("%q: got %q, %d, %v, want %q, %d", test.data, got, size, err, test.want, test.size)
+		}
+	}
+	for _, data := range [][]byte{nil, []byte("Cessna")} {
+		if _, _, err := simconnect.RetrieveString(data); !errors.Is(err, simconnect.ErrShortMessage) {
+			t.Errorf("%q: got %v, want ErrShortMessage", data, err)
+		}
+	}
+}
+
+func TestDecodeStringV(t *testing.T) {
+	def, err := simconnect.NewDataDefinition(1, &stringVAircraft{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if size := def.Size(); size != 16 {
+		t.Errorf("size: got %d, want 16 bytes without the stringv", size)
+	}
+
+	var aircraft stringVAircraft
+	if err := def.Decode(stringVData(1500, "Cessna Skyhawk", 270), &aircraft); err != nil {
+		t.Fatal(err)
+	}
+	if aircraft != (stringVAircraft{1500, "Cessna Skyhawk", 270}) {
+		t.Errorf("got %+v", aircraft)
+	}
+	if err := def.Decode(stringVData(0, "", 90), &aircraft); err != nil || aircraft.Title != "" || aircraft.Heading != 90 {
+		t.Errorf("empty stringv: got %+v, %v", aircraft, err)
+	}
+
+	data := stringVData(1500, "Cessna Skyhawk", 270)
+	truncated := map[string][]byte{
+		"in the first datum":  data[:6],
+		"in the stringv":      data[:8+6],
+		"without the null":    data[:8+14],
+		"in the second datum": data[:len(data)-1],
+	}
+	for name, data := range truncated {
+		if err := def.Decode(data, &aircraft); !errors.Is(err, simconnect.ErrShortMessage) {
+			t.Errorf("truncated %s: got %v, want ErrShortMessage", name, err)
+		}
+	}
+}
+
+func TestRequestStringV(t *testing.T) {
+	simco, sim := openSim(t)
+	sim.SetSimVar("PLANE ALTITUDE", 1500.0)
+	sim.SetSimVar("TITLE", "Cessna Skyhawk")
+	sim.SetSimVar("PLANE HEADING DEGREES TRUE", 270.0)
+	def, err := simco.RegisterDataDefinition(1, &stringVAircraft{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	messages := make(chan *simconnect.SimObjectDataMessage, 4)
+	runAsync(t, simco, simconnect.HandlerFunc(func(ctx context.Context, msg simconnect.Message) {
+		if msg, ok := msg.(*simconnect.SimObjectDataMessage); ok {
+			messages <- msg
+		}
+	}))
+	flags := []simconnect.DWord{simconnect.DataRequestFlagDefault, simconnect.DataRequestFlagTagged}
+	for i, flag := range flags {
+		if err := simco.RequestDataOnSimObject(simconnect.DWord(i+1), 1, simconnect.ObjectIDUser, simconnect.PeriodOnce, flag); err != nil {
+			t.Fatal(err)
+		}
+		var aircraft stringVAircraft
+		if err := def.DecodeMessage(receive(t, messages), &aircraft); err != nil {
+			t.Fatal(err)
+		}
+		if aircraft != (stringVAircraft{1500, "Cessna Skyhawk", 270}) {
+			t.Errorf("flags %d: got %+v", flag, aircraft)
+		}
+	}
+}
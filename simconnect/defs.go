@@ -32,6 +32,7 @@ const (
 	scAddClientEventToNotificationGroup = "SimConnect_AddClientEventToNotificationGroup"
 	scRemoveClientEvent                 = "SimConnect_RemoveClientEvent"
 	scTransmitClientEvent               = "SimConnect_TransmitClientEvent"
+	scTransmitClientEventEx1            = "SimConnect_TransmitClientEvent_EX1"
 	scMapClientDataNameToID             = "SimConnect_MapClientDataNameToID"
 	scRequestClientData                 = "SimConnect_RequestClientData"
 	scCreateClientData                  = "SimConnect_CreateClientData"
@@ -67,9 +68,10 @@ const (
 	scInsertString         = "SimConnect_InsertString"         // Not implemented
 	scRetrieveString       = "SimConnect_RetrieveString"       // Not implemented
 	// Facilities
-	scRequestFacilitiesList   = "SimConnect_RequestFacilitiesList"
-	scSubscribeToFacilities   = "SimConnect_SubscribeToFacilities"
-	scUnsubscribeToFacilities = "SimConnect_UnsubscribeToFacilities"
+	scRequestFacilitiesList    = "SimConnect_RequestFacilitiesList"
+	scSubscribeToFacilities    = "SimConnect_SubscribeToFacilities"
+	scSubscribeToFacilitiesEx1 = "SimConnect_SubscribeToFacilities_EX1"
+	scUnsubscribeToFacilities  = "SimConnect_UnsubscribeToFacilities"
 	// Missions
 	// scCompleteCustomMissionAction = "SimConnect_CompleteCustomMissionAction" // Not implemented
 	// scExecuteMissionAction        = "SimConnect_ExecuteMissionAction"        // Not implemented
@@ -88,6 +90,7 @@ type DWord uint32 // DWORD
 const (
 	DWordMax          DWord   = 0xffffffff      // DWORD_MAX
 	EFail             uint32  = 0x80004005      // E_FAIL
+	EUnexpected       uint32  = 0x8000ffff      // E_UNEXPECTED
 	Unused            DWord   = DWordMax        // SIMCONNECT_UNUSED
 	ObjectIDUser      DWord   = 0               // SIMCONNECT_OBJECT_ID_USER
 	CameraIgnoreField float32 = math.MaxFloat32 // SIMCONNECT_CAMERA_IGNORE_FIELD: Used to tell the Camera API to NOT modify the value in this part of the argument.
@@ -366,6 +369,18 @@ const (
 	ClientDataSetFlagTagged  DWord = 0x00000001 // SIMCONNECT_CLIENT_DATA_SET_FLAG_TAGGED: data is in tagged format
 )
 
+// SIMCONNECT_CLIENTDATATYPE: passed to SimConnect_AddToClientDataDefinition instead of a size in bytes
+const (
+	ClientDataTypeInt8    DWord = 0xffffffff // SIMCONNECT_CLIENTDATATYPE_INT8: 8-bit integer number
+	ClientDataTypeInt16   DWord = 0xfffffffe // SIMCONNECT_CLIENTDATATYPE_INT16: 16-bit integer number
+	ClientDataTypeInt32   DWord = 0xfffffffd // SIMCONNECT_CLIENTDATATYPE_INT32: 32-bit integer number
+	ClientDataTypeInt64   DWord = 0xfffffffc // SIMCONNECT_CLIENTDATATYPE_INT64: 64-bit integer number
+	ClientDataTypeFloat32 DWord = 0xfffffffb // SIMCONNECT_CLIENTDATATYPE_FLOAT32: 32-bit floating-point number (float)
+	ClientDataTypeFloat64 DWord = 0xfffffffa // SIMCONNECT_CLIENTDATATYPE_FLOAT64: 64-bit floating-point number (double)
+)
+
+const ClientDataOffsetAuto DWord = 0xffffffff // SIMCONNECT_CLIENTDATAOFFSET_AUTO: the datum follows the previous one
+
 // SIMCONNECT_VIEW_SYSTEM_EVENT_DATA: dwData contains these flags for the "View" System Event
 const (
 	ViewSystemEventDataCockpit2D      DWord = 0x00000001 // SIMCONNECT_VIEW_SYSTEM_EVENT_DATA_COCKPIT_2D: 2D Panels in cockpit view
@@ -466,56 +481,57 @@ type RecvEventFrame struct {
 }
 
 // SIMCONNECT_RECV_EVENT_MULTIPLAYER_SERVER_STARTED: when dwID == SIMCONNECT_RECV_ID_EVENT_MULTIPLAYER_SERVER_STARTED
-// type RecvEventMultiplayerServerStarted struct {
-// 	RecvEvent
-// 	// No event specific data, for now
-// }
+type RecvEventMultiplayerServerStarted struct {
+	RecvEvent
+	// No event specific data, for now
+}
 
 // SIMCONNECT_RECV_EVENT_MULTIPLAYER_CLIENT_STARTED: when dwID == SIMCONNECT_RECV_ID_EVENT_MULTIPLAYER_CLIENT_STARTED
-// type RecvEventMultiplayerClientStarted struct {
-// 	RecvEvent
-// 	// No event specific data, for now
-// }
+type RecvEventMultiplayerClientStarted struct {
+	RecvEvent
+	// No event specific data, for now
+}
 
 // SIMCONNECT_RECV_EVENT_MULTIPLAYER_SESSION_ENDED: when dwID == SIMCONNECT_RECV_ID_EVENT_MULTIPLAYER_SESSION_ENDED
-// type RecvEventMultiplayerSessionEnded struct {
-// 	RecvEvent
-// 	// No event specific data, for now
-// }
+type RecvEventMultiplayerSessionEnded struct {
+	RecvEvent
+	// No event specific data, for now
+}
 
 // GUID structure from guiddef.h
-// typedef struct _GUID {
-// 	unsigned long  Data1;
-// 	unsigned short Data2;
-// 	unsigned short Data3;
-// 	unsigned char  Data4[8];
-// } GUID;
+type GUID struct {
+	Data1 uint32
+	Data2 uint16
+	Data3 uint16
+	Data4 [8]byte
+}
 
 // SIMCONNECT_DATA_RACE_RESULT
-// type DataRaceResult struct {
-// 	NumberOfRacers DWord        // The total number of racers
-// 	MissionGUID    windows.GUID // The name of the mission to execute, NULL if no mission
-// 	PlayerName     [260]byte    // The name of the player
-// 	SessionType    [260]byte    // The type of the multiplayer session: "LAN", "GAMESPY")
-// 	Aircraft       [260]byte    // The aircraft type
-// 	PlayerRole     [260]byte    // The player role in the mission
-// 	TotalTime      float64      // Total time in seconds, 0 means DNF
-// 	PenaltyTime    float64      // Total penalty time in seconds
-// 	IsDisqualified DWord        // non 0 - disqualified, 0 - not disqualified
-// }
+type DataRaceResult struct {
+	NumberOfRacers DWord     // The total number of racers
+	MissionGUID    GUID      // The name of the mission to execute, NULL if no mission
+	PlayerName     [260]byte // The name of the player
+	SessionType    [260]byte // The type of the multiplayer session: "LAN", "GAMESPY")
+	Aircraft       [260]byte // The aircraft type
+	PlayerRole     [260]byte // The player role in the mission
+	TotalTime      float64   // Total time in seconds, 0 means DNF
+	PenaltyTime    float64   // Total penalty time in seconds
+	IsDisqualified DWord     // non 0 - disqualified, 0 - not disqualified
+}
 
 // SIMCONNECT_RECV_EVENT_RACE_END: when dwID == SIMCONNECT_RECV_ID_EVENT_RACE_END
-// type RecvEventRaceEnd struct {
-// 	RecvEvent
-// 	RacerNumber DWord // The index of the racer the results are for
-// 	RacerData   DataRaceResult
-// }
+type RecvEventRaceEnd struct {
+	RecvEvent
+	RacerNumber DWord // The index of the racer the results are for
+	RacerData   DataRaceResult
+}
 
 // SIMCONNECT_RECV_EVENT_RACE_LAP: when dwID == SIMCONNECT_RECV_ID_EVENT_RACE_LAP
-// type RecvEventRaceLap struct {
-// 	LapIndex  DWord // The index of the lap the results are for
-// 	RacerData DataRaceResult
-// }
+type RecvEventRaceLap struct {
+	RecvEvent
+	LapIndex  DWord // The index of the lap the results are for
+	RacerData DataRaceResult
+}
 
 // SIMCONNECT_RECV_SIMOBJECT_DATA: when dwID == SIMCONNECT_RECV_ID_SIMOBJECT_DATA
 // Will be received by the client after a successful call to SimConnect_RequestDataOnSimObject or SimConnect_RequestDataOnSimObjectType.
@@ -547,24 +563,24 @@ type RecvClientData struct {
 }
 
 // SIMCONNECT_RECV_WEATHER_OBSERVATION: when dwID == SIMCONNECT_RECV_ID_WEATHER_OBSERVATION
-// type RecvWeatherObservation struct {
-// 	Recv
-// 	requestID DWord
-// 	metar     [1]byte // SIMCONNECT_STRINGV(szMetar): Variable length string whose maximum size is MAX_METAR_LENGTH
-// }
+type RecvWeatherObservation struct {
+	Recv
+	RequestID DWord
+	// SIMCONNECT_STRINGV(szMetar): Variable length string whose maximum size is MAX_METAR_LENGTH
+}
 
-// const (
-// 	CloudStateArrayWidth int = 64                                          // SIMCONNECT_CLOUD_STATE_ARRAY_WIDTH
-// 	CloudStateArraySize  int = CloudStateArrayWidth * CloudStateArrayWidth // SIMCONNECT_CLOUD_STATE_ARRAY_SIZE
-// )
+const (
+	CloudStateArrayWidth int = 64                                          // SIMCONNECT_CLOUD_STATE_ARRAY_WIDTH
+	CloudStateArraySize  int = CloudStateArrayWidth * CloudStateArrayWidth // SIMCONNECT_CLOUD_STATE_ARRAY_SIZE
+)
 
 // SIMCONNECT_RECV_CLOUD_STATE: when dwID == SIMCONNECT_RECV_ID_CLOUD_STATE
-// type RecvCloudState struct {
-// 	Recv
-// 	RequestID DWord
-// 	ArraySize DWord
-// 	// SIMCONNECT_FIXEDTYPE_DATAV(BYTE, rgbData, dwArraySize, U1 /*member of UnmanagedType enum*/ , System::Byte /*cli type*/);
-// }
+type RecvCloudState struct {
+	Recv
+	RequestID DWord
+	ArraySize DWord
+	// SIMCONNECT_FIXEDTYPE_DATAV(BYTE, rgbData, dwArraySize, U1 /*member of UnmanagedType enum*/ , System::Byte /*cli type*/);
+}
 
 // SIMCONNECT_RECV_ASSIGNED_OBJECT_ID: when dwID == SIMCONNECT_RECV_ID_ASSIGNED_OBJECT_ID
 // Used to return an object ID that matches a request ID.
@@ -596,17 +612,18 @@ type RecvSystemState struct {
 }
 
 // SIMCONNECT_RECV_CUSTOM_ACTION : public SIMCONNECT_RECV_EVENT
-// type RecvCustomAction struct {
-// 	RecvEvent
-// 	InstanceID        windows.GUID // Instance id of the action that executed
-// 	WaitForCompletion DWord        // Wait for completion flag on the action
-// 	Payload           [1]byte      // SIMCONNECT_STRINGV(szPayLoad): Variable length string payload associated with the mission action
-// }
+type RecvCustomAction struct {
+	RecvEvent
+	InstanceID        GUID  // Instance id of the action that executed
+	WaitForCompletion DWord // Wait for completion flag on the action
+	// SIMCONNECT_STRINGV(szPayLoad): Variable length string payload associated with the mission action
+}
 
 // SIMCONNECT_RECV_EVENT_WEATHER_MODE : public SIMCONNECT_RECV_EVENT
-// type RecvEventWeatherMode struct {
-// 	// No event specific data - the new weather mode is in the base structure dwData member
-// }
+type RecvEventWeatherMode struct {
+	RecvEvent
+	// No event specific data - the new weather mode is in the base structure dwData member
+}
 
 // SIMCONNECT_RECV_FACILITIES_LIST
 // Used to provide information on the number of elements in a list of facilities returned to the client, and the number of packets that were used to transmit the data.
@@ -721,12 +738,15 @@ type InitPosition struct {
 // SIMCONNECT_DATA_LATLONALT
 // Used to hold a world position.
 // https://docs.flightsimulator.com/html/Programming_Tools/SimConnect/API_Reference/Structures_And_Enumerations/SIMCONNECT_DATA_LATLONALT.htm
-type LatLogAlt struct {
+type LatLonAlt struct {
 	Latitude  float64
 	Longitude float64
 	Altitude  float64
 }
 
+// Deprecated: LatLogAlt is a misspelling of LatLonAlt and only kept for compatibility.
+type LatLogAlt = LatLonAlt
+
 // SIMCONNECT_DATA_XYZ
 // Used to hold a 3D co-ordinate.
 // https://docs.flightsimulator.com/html/Programming_Tools/SimConnect/API_Reference/Structures_And_Enumerations/SIMCONNECT_DATA_XYZ.htm
@@ -0,0 +1,193 @@
+package simconnect
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// PollInterval is how often Run checks for messages if the transport is no Notifier.
+var PollInterval = 10 * time.Millisecond
+
+// Notifier is implemented by transports which signal pending messages, so that Run does not need to poll.
+// The DLL transport waits on the event handle passed to SimConnect_Open, the network transport on its socket.
+type Notifier interface {
+	// Notify returns a channel which receives a value whenever new messages may be pending.
+	// The channel is buffered, so a signal sent while nobody is waiting is not lost.
+	Notify() <-chan struct{}
+}
+
+// Handler receives the messages dispatched by Run.
+type Handler interface {
+	HandleMessage(ctx context.Context, msg Message)
+}
+
+// exceptionHandler is implemented by handlers which take exceptions together with their resolution,
+// so that they are resolved only once.
+type exceptionHandler interface {
+	handleException(ctx context.Context, recv *RecvException, err *SimConnectError)
+}
+
+// HandlerFunc adapts a function to a Handler.
+type HandlerFunc func(ctx context.Context, msg Message)
+
+func (f HandlerFunc) HandleMessage(ctx context.Context, msg Message) {
+	f(ctx, msg)
+}
+
+// Channels is a Handler which delivers messages on typed channels.
+// Messages without a channel of their own go to Other; if that is nil as well they are dropped.
+// Sends block until the message is received or the context of Run is done, so every channel that is set must be drained.
+type Channels struct {
+	Open                chan *RecvOpen
+	Quit                chan *RecvQuit
+	Exception           chan *RecvException
+	Event               chan *RecvEvent
+	SimObjectData       chan *SimObjectDataMessage
+	SimObjectDataByType chan *SimObjectDataByTypeMessage
+	SystemState         chan *RecvSystemState
+	ClientData          chan *ClientDataMessage
+	Other               chan Message
+}
+
+func (ch *Channels) HandleMessage(ctx context.Context, msg Message) {
+	switch msg := msg.(type) {
+	case *RecvOpen:
+		if ch.Open != nil {
+			send(ctx, ch.Open, msg)
+			return
+		}
+	case *RecvQuit:
+		if ch.Quit != nil {
+			send(ctx, ch.Quit, msg)
+			return
+		}
+	case *RecvException:
+		if ch.Exception != nil {
+			send(ctx, ch.Exception, msg)
+			return
+		}
+	case *RecvEvent:
+		if ch.Event != nil {
+			send(ctx, ch.Event, msg)
+			return
+		}
+	case *SimObjectDataMessage:
+		if ch.SimObjectData != nil {
+			send(ctx, ch.SimObjectData, msg)
+			return
+		}
+	case *SimObjectDataByTypeMessage:
+		if ch.SimObjectDataByType != nil {
+			send(ctx, ch.SimObjectDataByType, msg)
+			return
+		}
+	case *RecvSystemState:
+		if ch.SystemState != nil {
+			send(ctx, ch.SystemState, msg)
+			return
+		}
+	case *ClientDataMessage:
+		if ch.ClientData != nil {
+			send(ctx, ch.ClientData, msg)
+			return
+		}
+	}
+	if ch.Other != nil {
+		send(ctx, ch.Other, msg)
+	}
+}
+
+// send blocks until ch receives v or ctx is done.
+func send[T any](ctx context.Context, ch chan<- T, v T) {
+	select {
+	case ch <- v:
+	case <-ctx.Done():
+	}
+}
+
+// Run waits for messages and hands them to handler until ctx is done or the connection fails.
+// It returns ctx.Err() after a cancellation, and the transport's error otherwise.
+// Messages which cannot be decoded are logged and skipped.
+func (simco *SimConnect) Run(ctx context.Context, handler Handler) error {
+	return simco.run(ctx, PollInterval, nil, nil, handler)
+}
+
+// run is the loop behind Run. onTick is called on every tick, between two batches of messages.
+func (simco *SimConnect) run(ctx context.Context, pollInterval time.Duration, tick <-chan time.Time, onTick func(), handler Handler) error {
+	if simco.transport == nil {
+		return ErrNoTransport
+	}
+	atomic.AddInt32(&simco.running, 1)
+	defer atomic.AddInt32(&simco.running, -1)
+
+	var notify <-chan struct{}
+	var poll <-chan time.Time
+	if notifier, ok := simco.transport.(Notifier); ok {
+		notify = notifier.Notify()
+	} else {
+		ticker := time.NewTicker(pollInterval)
+		defer ticker.Stop()
+		poll = ticker.C
+	}
+
+	for {
+		if err := simco.dispatchPending(ctx, handler); err != nil {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-notify:
+		case <-poll:
+		case <-tick:
+			onTick()
+		}
+	}
+}
+
+// dispatchPending hands all pending messages to handler.
+// Answers to Query, SystemState, RequestFacilities and CreateAIObject are delivered to their callers instead,
+// and the messages of requests passed to HandleRequest to their receivers.
+func (simco *SimConnect) dispatchPending(ctx context.Context, handler Handler) error {
+	simco.dispatchLock.Lock()
+	defer simco.dispatchLock.Unlock()
+	return simco.dispatch(ctx, handler)
+}
+
+func (simco *SimConnect) dispatch(ctx context.Context, handler Handler) error {
+	for ctx.Err() == nil {
+		msg, err := simco.GetNextMessage()
+		if err != nil {
+			if errors.Is(err, ErrShortMessage) || errors.Is(err, ErrUnknownMessage) {
+				log.Tracef("Dropped message: %s", err.Error())
+				continue
+			}
+			return err
+		}
+		if msg == nil {
+			return nil
+		}
+		var resolved *SimConnectError
+		exception, isException := msg.(*RecvException)
+		if isException {
+			resolved = simco.ResolveException(exception)
+		}
+		if simco.deliver(msg) {
+			continue
+		}
+		simco.notifySystemEvent(msg)
+		if h, ok := handler.(exceptionHandler); ok && isException {
+			h.handleException(ctx, exception, resolved)
+		} else if handler != nil {
+			handler.HandleMessage(ctx, msg)
+		}
+		if _, quit := msg.(*RecvQuit); quit && atomic.LoadInt32(&simco.supervised) != 0 {
+			return ErrQuit
+		}
+	}
+	return ctx.Err()
+}
@@ -0,0 +1,318 @@
+package simconnect_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/grumpypixel/msfs2020-simconnect-go/simconnect"
+	"github.com/grumpypixel/msfs2020-simconnect-go/simconnect/simconnecttest"
+)
+
+const testTimeout = 2 * time.Second
+
+func openSim(t *testing.T) (*simconnect.SimConnect, *simconnecttest.Sim) {
+	t.Helper()
+	sim := simconnecttest.NewSim()
+	simco := simconnect.NewSimConnectWithTransport(sim)
+	if err := simco.Open("Dispatch Test"); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { simco.Close() })
+	return simco, sim
+}
+
+// runAsync runs simco.Run with handler on its own goroutine. The returned function cancels Run and returns its error.
+func runAsync(t *testing.T, simco *simconnect.SimConnect, handler simconnect.Handler) (stop func() error) {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	errc := make(chan error, 1)
+	go func() {
+		errc <- simco.Run(ctx, handler)
+	}()
+	t.Cleanup(cancel)
+	return func() error {
+		cancel()
+		select {
+		case err := <-errc:
+			return err
+		case <-time.After(testTimeout):
+			t.Fatal("Run did not return")
+			return nil
+		}
+	}
+}
+
+func receive[T any](t *testing.T, ch <-chan T) T {
+	t.Helper()
+	select {
+	case v := <-ch:
+		return v
+	case <-time.After(testTimeout):
+		var zero T
+		t.Fatalf("no %T received", zero)
+		return zero
+	}
+}
+
+func TestRunHandlerFunc(t *testing.T) {
+	simco, sim := openSim(t)
+	messages := make(chan simconnect.Message, 16)
+	stop := runAsync(t, simco, simconnect.HandlerFunc(func(ctx context.Context, msg simconnect.Message) {
+		messages <- msg
+	}))
+
+	open, ok := receive(t, messages).(*simconnect.RecvOpen)
+	if !ok {
+		t.Fatal("first message is no RecvOpen")
+	}
+	if name := string(open.ApplicationName[:len(simconnecttest.ApplicationName)]); name != simconnecttest.ApplicationName {
+		t.Errorf("application name: got %q", name)
+	}
+	sim.Quit()
+	if msg := receive(t, messages); msg.Header().ID != simconnect.RecvIDQuit {
+		t.Errorf("got %T, want *RecvQuit", msg)
+	}
+	if err := stop(); !errors.Is(err, context.Canceled) {
+		t.Errorf("Run: got %v, want context.Canceled", err)
+	}
+}
+
+func TestRunChannels(t *testing.T) {
+	simco, sim := openSim(t)
+	ch := &simconnect.Channels{
+		Open:      make(chan *simconnect.RecvOpen, 1),
+		Quit:      make(chan *simconnect.RecvQuit, 1),
+		Event:     make(chan *simconnect.RecvEvent, 1),
+		Exception: make(chan *simconnect.RecvException, 1),
+		Other:     make(chan simconnect.Message, 1),
+	}
+	stop := runAsync(t, simco, ch)
+	receive(t, ch.Open)
+
+	const eventID = 7
+	if err := simco.SubscribeToSystemEvent(eventID, simconnect.SystemEventPause); err != nil {
+		t.Fatal(err)
+	}
+	sim.FireSystemEvent(simconnect.SystemEventPause, 1)
+	if event := receive(t, ch.Event); event.EventID != eventID || event.Data != 1 {
+		t.Errorf("event: got ID %d data %d", event.EventID, event.Data)
+	}
+
+	// System states have no channel here, so they go to Other.
+	sim.SetSystemState(simconnect.SystemStateSim, simconnect.DWord(1))
+	if err := simco.RequestSystemState(3, simconnect.SystemStateSim); err != nil {
+		t.Fatal(err)
+	}
+	if state, ok := receive(t, ch.Other).(*simconnect.RecvSystemState); !ok || state.RequestID != 3 || state.Integer != 1 {
+		t.Errorf("other: got %+v", state)
+	}
+
+	if err := simco.RequestDataOnSimObject(1, 99, simconnect.ObjectIDUser, simconnect.PeriodOnce, 0); err != nil {
+		t.Fatal(err)
+	}
+	if exception := receive(t, ch.Exception); exception.Exception != simconnect.ExceptionUnrecognizedID {
+		t.Errorf("exception: got %s", simconnect.ExceptionName(exception.Exception))
+	}
+
+	sim.Quit()
+	receive(t, ch.Quit)
+	if err := stop(); !errors.Is(err, context.Canceled) {
+		t.Errorf("Run: got %v, want context.Canceled", err)
+	}
+}
+
+func TestRunStopsWhenChannelIsNotDrained(t *testing.T) {
+	simco, sim := openSim(t)
+	ch := &simconnect.Channels{Quit: make(chan *simconnect.RecvQuit)}
+	stop := runAsync(t, simco, ch)
+	sim.Quit()
+	// Nobody receives the RecvQuit, still Run returns once its context is cancelled.
+	if err := stop(); !errors.Is(err, context.Canceled) {
+		t.Errorf("Run: got %v, want context.Canceled", err)
+	}
+}
+
+func TestRunDeliversPendingRequests(t *testing.T) {
+	simco, sim := openSim(t)
+	sim.SetSimVar("PLANE ALTITUDE", 1234.5)
+	sim.SetSystemState(simconnect.SystemStateAircraftLoaded, "SimObjects/Airplanes/Asobo_C172SP_AS1000/aircraft.cfg")
+	messages := make(chan simconnect.Message, 16)
+	stop := runAsync(t, simco, simconnect.HandlerFunc(func(ctx context.Context, msg simconnect.Message) {
+		messages <- msg
+	}))
+	receive(t, messages)
+
+	ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
+	defer cancel()
+	altitude, err := simconnect.QueryAs[float64](ctx, simco, "PLANE ALTITUDE", "feet")
+	if err != nil || altitude != 1234.5 {
+		t.Errorf("Query: got %v, %v", altitude, err)
+	}
+	state, err := simco.SystemState(ctx, simconnect.SystemStateAircraftLoaded)
+	if err != nil || state.String != "SimObjects/Airplanes/Asobo_C172SP_AS1000/aircraft.cfg" {
+		t.Errorf("SystemState: got %q, %v", state.String, err)
+	}
+	_, err = simco.SystemState(ctx, "NoSuchState")
+	var simErr *simconnect.SimConnectError
+	if !errors.As(err, &simErr) || simErr.Exception != simconnect.ExceptionNameUnrecognized {
+		t.Errorf("SystemState of an unknown state: got %v", err)
+	}
+
+	if err := stop(); !errors.Is(err, context.Canceled) {
+		t.Errorf("Run: got %v, want context.Canceled", err)
+	}
+	// The answers went to their callers, the handler only saw the exception.
+	close(messages)
+	for msg := range messages {
+		if msg.Header().ID != simconnect.RecvIDException {
+			t.Errorf("handler received %T", msg)
+		}
+	}
+}
+
+func TestRunHandleRequest(t *testing.T) {
+	simco, sim := openSim(t)
+	sim.SetSystemState(simconnect.SystemStateSim, simconnect.DWord(1))
+	states := make(chan *simconnect.RecvSystemState, 1)
+	simco.HandleRequest(5, func(msg simconnect.Message) {
+		states <- msg.(*simconnect.RecvSystemState)
+	})
+	other := make(chan simconnect.Message, 16)
+	stop := runAsync(t, simco, &simconnect.Channels{Other: other})
+	receive(t, other)
+
+	if err := simco.RequestSystemState(5, simconnect.SystemStateSim); err != nil {
+		t.Fatal(err)
+	}
+	if state := receive(t, states); state.Integer != 1 {
+		t.Errorf("got %d, want 1", state.Integer)
+	}
+	simco.HandleRequest(5, nil)
+	if err := simco.RequestSystemState(5, simconnect.SystemStateSim); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := receive(t, other).(*simconnect.RecvSystemState); !ok {
+		t.Error("without a receiver the answer goes to the Handler")
+	}
+	stop()
+}
+
+func TestRunResolvesExceptions(t *testing.T) {
+	simco, sim := openSim(t)
+	exceptions := make(chan *simconnect.RecvException, 1)
+	stop := runAsync(t, simco, &simconnect.Channels{Exception: exceptions})
+
+	if err := simco.AddToDataDefinition(1, "PLANE ALTITUDE", "feet", simconnect.DataTypeFloat64); err != nil {
+		t.Fatal(err)
+	}
+	if err := simco.SetSystemEventState(42, simconnect.StateOff); err != nil {
+		t.Fatal(err)
+	}
+	sendID := simco.LastSendID()
+	future := simco.ErrorOf(sendID)
+	sim.InjectException(simconnect.ExceptionUnrecognizedID, 1)
+
+	recv := receive(t, exceptions)
+	ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
+	defer cancel()
+	err := future.Wait(ctx)
+	var simErr *simconnect.SimConnectError
+	if !errors.As(err, &simErr) {
+		t.Fatalf("ErrorOf: got %v", err)
+	}
+	if simErr.SendID != sendID || simErr.ProcName != "SimConnect_SetSystemEventState" || simErr.Index != 1 {
+		t.Errorf("got %+v", simErr)
+	}
+	if want := `simconnect: SimConnect_SetSystemEventState(42, 0) failed with UNRECOGNIZED_ID at parameter 1`; err.Error() != want {
+		t.Errorf("got %q, want %q", err.Error(), want)
+	}
+	if resolved := simco.ResolveException(recv); resolved != simErr {
+		t.Errorf("ResolveException: got %v, want the error of the future", resolved)
+	}
+	// A future for a call which has already failed is resolved right away.
+	if simco.ErrorOf(sendID).Err() != simErr {
+		t.Error("ErrorOf after the exception is not resolved")
+	}
+	stop()
+}
+
+func TestRunWithoutTransport(t *testing.T) {
+	var simco simconnect.SimConnect
+	if err := simco.Run(context.Background(), nil); !errors.Is(err, simconnect.ErrNoTransport) {
+		t.Errorf("got %v, want ErrNoTransport", err)
+	}
+}
+
+func TestRunReturnsTransportError(t *testing.T) {
+	simco, sim := openSim(t)
+	errc := make(chan error, 1)
+	go func() {
+		errc <- simco.Run(context.Background(), nil)
+	}()
+	sim.Close()
+	sim.Push(make([]byte, 12)) // wakes up Run, which finds the connection closed
+	select {
+	case err := <-errc:
+		if !errors.Is(err, simconnecttest.ErrNotOpen) {
+			t.Errorf("got %v, want ErrNotOpen", err)
+		}
+	case <-time.After(testTimeout):
+		t.Fatal("Run did not return")
+	}
+}
+
+func TestOnSystemEvent(t *testing.T) {
+	simco, sim := openSim(t)
+	hooked := make(chan simconnect.Message, 16)
+	remove, err := simco.OnSystemEvent(simconnect.SystemEventPause, func(msg simconnect.Message) {
+		hooked <- msg
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	events := make(chan *simconnect.RecvEvent, 16)
+	stop := runAsync(t, simco, &simconnect.Channels{Event: events})
+
+	sim.FireSystemEvent(simconnect.SystemEventPause, 1)
+	if event, ok := receive(t, hooked).(*simconnect.RecvEvent); !ok || event.Data != 1 {
+		t.Errorf("hook: got %+v", event)
+	}
+	// The Handler still sees the event.
+	receive(t, events)
+
+	remove()
+	sim.FireSystemEvent(simconnect.SystemEventPause, 0)
+	receive(t, events)
+	stop()
+	select {
+	case msg := <-hooked:
+		t.Errorf("removed hook received %+v", msg)
+	default:
+	}
+}
+
+func TestRequestDataOnSimObjectEx(t *testing.T) {
+	simco, sim := openSim(t)
+	if err := simco.RequestDataOnSimObject(1, 7, simconnect.ObjectIDUser, simconnect.PeriodSecond, simconnect.DataRequestFlagChanged); err != nil {
+		t.Fatal(err)
+	}
+	if err := simco.RequestDataOnSimObjectEx(2, 7, simconnect.ObjectIDUser, simconnect.PeriodSimFrame, 0, 10, 2, 5); err != nil {
+		t.Fatal(err)
+	}
+	want := [][]interface{}{
+		{simconnect.DWord(1), simconnect.DWord(7), simconnect.ObjectIDUser, simconnect.PeriodSecond, simconnect.DataRequestFlagChanged, simconnect.DWord(0), simconnect.DWord(0), simconnect.DWord(0)},
+		{simconnect.DWord(2), simconnect.DWord(7), simconnect.ObjectIDUser, simconnect.PeriodSimFrame, simconnect.DWord(0), simconnect.DWord(10), simconnect.DWord(2), simconnect.DWord(5)},
+	}
+	calls := sim.CallsTo("SimConnect_RequestDataOnSimObject")
+	if len(calls) != len(want) {
+		t.Fatalf("got %d calls, want %d", len(calls), len(want))
+	}
+	for i, call := range calls {
+		if !reflect.DeepEqual(call.Args, want[i]) {
+			t.Errorf("call %d: got %v, want %v", i, call.Args, want[i])
+		}
+	}
+}
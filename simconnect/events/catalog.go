@@ -0,0 +1,226 @@
+package events
+
+// Events of the catalog, named after the key event in CamelCase.
+
+// Radios
+var (
+	ComRadioSet        = New("COM_RADIO_SET", BCD16)
+	ComRadioSetHz      = New("COM_RADIO_SET_HZ", Hz)
+	ComStbyRadioSet    = New("COM_STBY_RADIO_SET", BCD16)
+	ComStbyRadioSetHz  = New("COM_STBY_RADIO_SET_HZ", Hz)
+	ComRadioSwap       = New("COM_RADIO_SWAP", None)
+	ComRadioWholeInc   = New("COM_RADIO_WHOLE_INC", None)
+	ComRadioWholeDec   = New("COM_RADIO_WHOLE_DEC", None)
+	ComRadioFractInc   = New("COM_RADIO_FRACT_INC", None)
+	ComRadioFractDec   = New("COM_RADIO_FRACT_DEC", None)
+	Com2RadioSet       = New("COM2_RADIO_SET", BCD16)
+	Com2RadioSetHz     = New("COM2_RADIO_SET_HZ", Hz)
+	Com2StbyRadioSet   = New("COM2_STBY_RADIO_SET", BCD16)
+	Com2StbyRadioSetHz = New("COM2_STBY_RADIO_SET_HZ", Hz)
+	Com2RadioSwap      = New("COM2_RADIO_SWAP", None)
+	Nav1RadioSet       = New("NAV1_RADIO_SET", BCD16)
+	Nav1RadioSetHz     = New("NAV1_RADIO_SET_HZ", Hz)
+	Nav1StbySet        = New("NAV1_STBY_SET", BCD16)
+	Nav1StbySetHz      = New("NAV1_STBY_SET_HZ", Hz)
+	Nav1RadioSwap      = New("NAV1_RADIO_SWAP", None)
+	Nav2RadioSet       = New("NAV2_RADIO_SET", BCD16)
+	Nav2RadioSetHz     = New("NAV2_RADIO_SET_HZ", Hz)
+	Nav2StbySet        = New("NAV2_STBY_SET", BCD16)
+	Nav2StbySetHz      = New("NAV2_STBY_SET_HZ", Hz)
+	Nav2RadioSwap      = New("NAV2_RADIO_SWAP", None)
+	ADFCompleteSet     = New("ADF_COMPLETE_SET", ADFBCD32)
+	ADFActiveSet       = New("ADF_ACTIVE_SET", ADFBCD32)
+	ADFStbySet         = New("ADF_STBY_SET", ADFBCD32)
+	ADF1RadioSwap      = New("ADF1_RADIO_SWAP", None)
+	VOR1Set            = New("VOR1_SET", Degrees)
+	VOR2Set            = New("VOR2_SET", Degrees)
+	XpndrSet           = New("XPNDR_SET", BCO16)
+	XpndrIdentOn       = New("XPNDR_IDENT_ON", None)
+)
+
+// Autopilot
+var (
+	APMaster             = New("AP_MASTER", None)
+	AutopilotOn          = New("AUTOPILOT_ON", None)
+	AutopilotOff         = New("AUTOPILOT_OFF", None)
+	APHdgHold            = New("AP_HDG_HOLD", None)
+	APPanelHeadingHold   = New("AP_PANEL_HEADING_HOLD", None)
+	HeadingBugSet        = New("HEADING_BUG_SET", Degrees)
+	HeadingBugInc        = New("HEADING_BUG_INC", None)
+	HeadingBugDec        = New("HEADING_BUG_DEC", None)
+	APAltHold            = New("AP_ALT_HOLD", None)
+	APPanelAltitudeHold  = New("AP_PANEL_ALTITUDE_HOLD", None)
+	APAltVarSetEnglish   = New("AP_ALT_VAR_SET_ENGLISH", Feet)
+	APAltVarInc          = New("AP_ALT_VAR_INC", None)
+	APAltVarDec          = New("AP_ALT_VAR_DEC", None)
+	APVSHold             = New("AP_VS_HOLD", None)
+	APVSVarSetEnglish    = New("AP_VS_VAR_SET_ENGLISH", FeetPerMinute)
+	APVSVarInc           = New("AP_VS_VAR_INC", None)
+	APVSVarDec           = New("AP_VS_VAR_DEC", None)
+	APPanelSpeedHold     = New("AP_PANEL_SPEED_HOLD", None)
+	APSpdVarSet          = New("AP_SPD_VAR_SET", Knots)
+	APSpdVarInc          = New("AP_SPD_VAR_INC", None)
+	APSpdVarDec          = New("AP_SPD_VAR_DEC", None)
+	APNav1Hold           = New("AP_NAV1_HOLD", None)
+	APAprHold            = New("AP_APR_HOLD", None)
+	APLocHold            = New("AP_LOC_HOLD", None)
+	APBCHold             = New("AP_BC_HOLD", None)
+	APWingLeveler        = New("AP_WING_LEVELER", None)
+	APN1Hold             = New("AP_N1_HOLD", None)
+	YawDamperToggle      = New("YAW_DAMPER_TOGGLE", None)
+	AutoThrottleArm      = New("AUTO_THROTTLE_ARM", None)
+	ToggleFlightDirector = New("TOGGLE_FLIGHT_DIRECTOR", None)
+)
+
+// Engines
+var (
+	ThrottleSet         = New("THROTTLE_SET", Percent)
+	ThrottleFull        = New("THROTTLE_FULL", None)
+	ThrottleCut         = New("THROTTLE_CUT", None)
+	ThrottleIncr        = New("THROTTLE_INCR", None)
+	ThrottleDecr        = New("THROTTLE_DECR", None)
+	Throttle1Set        = New("THROTTLE1_SET", Percent)
+	Throttle2Set        = New("THROTTLE2_SET", Percent)
+	MixtureSet          = New("MIXTURE_SET", Percent)
+	MixtureRich         = New("MIXTURE_RICH", None)
+	MixtureLean         = New("MIXTURE_LEAN", None)
+	PropPitchSet        = New("PROP_PITCH_SET", Percent)
+	AxisThrottleSet     = New("AXIS_THROTTLE_SET", Axis)
+	AxisMixtureSet      = New("AXIS_MIXTURE_SET", Axis)
+	AxisPropellerSet    = New("AXIS_PROPELLER_SET", Axis)
+	EngineAutoStart     = New("ENGINE_AUTO_START", None)
+	EngineAutoShutdown  = New("ENGINE_AUTO_SHUTDOWN", None)
+	Magneto1Off         = New("MAGNETO1_OFF", None)
+	Magneto1Both        = New("MAGNETO1_BOTH", None)
+	Magneto1Start       = New("MAGNETO1_START", None)
+	ToggleStarter1      = New("TOGGLE_STARTER1", None)
+	FuelPump            = New("FUEL_PUMP", None)
+	ToggleElectFuelPump = New("TOGGLE_ELECT_FUEL_PUMP", None)
+)
+
+// Controls
+var (
+	AxisElevatorSet   = New("AXIS_ELEVATOR_SET", Axis)
+	AxisAileronsSet   = New("AXIS_AILERONS_SET", Axis)
+	AxisRudderSet     = New("AXIS_RUDDER_SET", Axis)
+	ElevatorTrimSet   = New("ELEVATOR_TRIM_SET", Number)
+	FlapsUp           = New("FLAPS_UP", None)
+	FlapsDown         = New("FLAPS_DOWN", None)
+	FlapsIncr         = New("FLAPS_INCR", None)
+	FlapsDecr         = New("FLAPS_DECR", None)
+	FlapsSet          = New("FLAPS_SET", Percent)
+	GearUp            = New("GEAR_UP", None)
+	GearDown          = New("GEAR_DOWN", None)
+	GearToggle        = New("GEAR_TOGGLE", None)
+	GearSet           = New("GEAR_SET", Bool)
+	ParkingBrakes     = New("PARKING_BRAKES", None)
+	ParkingBrakeSet   = New("PARKING_BRAKE_SET", Bool)
+	Brakes            = New("BRAKES", None)
+	SpoilersArmToggle = New("SPOILERS_ARM_TOGGLE", None)
+	SpoilersOn        = New("SPOILERS_ON", None)
+	SpoilersOff       = New("SPOILERS_OFF", None)
+	SpoilersSet       = New("SPOILERS_SET", Percent)
+)
+
+// Lights
+var (
+	AllLightsToggle     = New("ALL_LIGHTS_TOGGLE", None)
+	StrobesToggle       = New("STROBES_TOGGLE", None)
+	StrobesSet          = New("STROBES_SET", Bool)
+	LandingLightsToggle = New("LANDING_LIGHTS_TOGGLE", None)
+	LandingLightsSet    = New("LANDING_LIGHTS_SET", Bool)
+	ToggleNavLights     = New("TOGGLE_NAV_LIGHTS", None)
+	NavLightsSet        = New("NAV_LIGHTS_SET", Bool)
+	ToggleBeaconLights  = New("TOGGLE_BEACON_LIGHTS", None)
+	BeaconLightsSet     = New("BEACON_LIGHTS_SET", Bool)
+	ToggleTaxiLights    = New("TOGGLE_TAXI_LIGHTS", None)
+	TaxiLightsSet       = New("TAXI_LIGHTS_SET", Bool)
+	PanelLightsToggle   = New("PANEL_LIGHTS_TOGGLE", None)
+	PanelLightsSet      = New("PANEL_LIGHTS_SET", Bool)
+	ToggleLogoLights    = New("TOGGLE_LOGO_LIGHTS", None)
+	LogoLightsSet       = New("LOGO_LIGHTS_SET", Bool)
+	ToggleWingLights    = New("TOGGLE_WING_LIGHTS", None)
+	WingLightsSet       = New("WING_LIGHTS_SET", Bool)
+	ToggleCabinLights   = New("TOGGLE_CABIN_LIGHTS", None)
+	CabinLightsSet      = New("CABIN_LIGHTS_SET", Bool)
+)
+
+// Electrical and anti-ice
+var (
+	ToggleMasterBattery    = New("TOGGLE_MASTER_BATTERY", None)
+	MasterBatteryOn        = New("MASTER_BATTERY_ON", None)
+	MasterBatteryOff       = New("MASTER_BATTERY_OFF", None)
+	ToggleMasterAlternator = New("TOGGLE_MASTER_ALTERNATOR", None)
+	ToggleAvionicsMaster   = New("TOGGLE_AVIONICS_MASTER", None)
+	AvionicsMasterSet      = New("AVIONICS_MASTER_SET", Bool)
+	PitotHeatToggle        = New("PITOT_HEAT_TOGGLE", None)
+	PitotHeatSet           = New("PITOT_HEAT_SET", Bool)
+	AntiIceToggle          = New("ANTI_ICE_TOGGLE", None)
+	AntiIceSet             = New("ANTI_ICE_SET", Bool)
+)
+
+// Instruments
+var (
+	KohlsmanSet    = New("KOHLSMAN_SET", Millibars)
+	KohlsmanInc    = New("KOHLSMAN_INC", None)
+	KohlsmanDec    = New("KOHLSMAN_DEC", None)
+	Barometric     = New("BAROMETRIC", None)
+	HeadingGyroSet = New("HEADING_GYRO_SET", Degrees)
+)
+
+// Simulation
+var (
+	PauseToggle                   = New("PAUSE_TOGGLE", None)
+	PauseOn                       = New("PAUSE_ON", None)
+	PauseOff                      = New("PAUSE_OFF", None)
+	PauseSet                      = New("PAUSE_SET", Bool)
+	SimRateIncr                   = New("SIM_RATE_INCR", None)
+	SimRateDecr                   = New("SIM_RATE_DECR", None)
+	SlewToggle                    = New("SLEW_TOGGLE", None)
+	SlewOn                        = New("SLEW_ON", None)
+	SlewOff                       = New("SLEW_OFF", None)
+	FreezeLatitudeLongitudeToggle = New("FREEZE_LATITUDE_LONGITUDE_TOGGLE", None)
+	FreezeAltitudeToggle          = New("FREEZE_ALTITUDE_TOGGLE", None)
+	FreezeAttitudeToggle          = New("FREEZE_ATTITUDE_TOGGLE", None)
+	TogglePushback                = New("TOGGLE_PUSHBACK", None)
+	SmokeToggle                   = New("SMOKE_TOGGLE", None)
+	SmokeOn                       = New("SMOKE_ON", None)
+	SmokeOff                      = New("SMOKE_OFF", None)
+)
+
+var all = []Event{
+	ComRadioSet, ComRadioSetHz, ComStbyRadioSet, ComStbyRadioSetHz, ComRadioSwap, ComRadioWholeInc,
+	ComRadioWholeDec, ComRadioFractInc, ComRadioFractDec, Com2RadioSet, Com2RadioSetHz, Com2StbyRadioSet,
+	Com2StbyRadioSetHz, Com2RadioSwap, Nav1RadioSet, Nav1RadioSetHz, Nav1StbySet, Nav1StbySetHz, Nav1RadioSwap,
+	Nav2RadioSet, Nav2RadioSetHz, Nav2StbySet, Nav2StbySetHz, Nav2RadioSwap, ADFCompleteSet, ADFActiveSet,
+	ADFStbySet, ADF1RadioSwap, VOR1Set, VOR2Set, XpndrSet, XpndrIdentOn, APMaster, AutopilotOn, AutopilotOff,
+	APHdgHold, APPanelHeadingHold, HeadingBugSet, HeadingBugInc, HeadingBugDec, APAltHold, APPanelAltitudeHold,
+	APAltVarSetEnglish, APAltVarInc, APAltVarDec, APVSHold, APVSVarSetEnglish, APVSVarInc, APVSVarDec,
+	APPanelSpeedHold, APSpdVarSet, APSpdVarInc, APSpdVarDec, APNav1Hold, APAprHold, APLocHold, APBCHold,
+	APWingLeveler, APN1Hold, YawDamperToggle, AutoThrottleArm, ToggleFlightDirector, ThrottleSet, ThrottleFull,
+	ThrottleCut, ThrottleIncr, ThrottleDecr, Throttle1Set, Throttle2Set, MixtureSet, MixtureRich, MixtureLean,
+	PropPitchSet, AxisThrottleSet, AxisMixtureSet, AxisPropellerSet, EngineAutoStart, EngineAutoShutdown,
+	Magneto1Off, Magneto1Both, Magneto1Start, ToggleStarter1, FuelPump, ToggleElectFuelPump, AxisElevatorSet,
+	AxisAileronsSet, AxisRudderSet, ElevatorTrimSet, FlapsUp, FlapsDown, FlapsIncr, FlapsDecr, FlapsSet, GearUp,
+	GearDown, GearToggle, GearSet, ParkingBrakes, ParkingBrakeSet, Brakes, SpoilersArmToggle, SpoilersOn,
+	SpoilersOff, SpoilersSet, AllLightsToggle, StrobesToggle, StrobesSet, LandingLightsToggle, LandingLightsSet,
+	ToggleNavLights, NavLightsSet, ToggleBeaconLights, BeaconLightsSet, ToggleTaxiLights, TaxiLightsSet,
+	PanelLightsToggle, PanelLightsSet, ToggleLogoLights, LogoLightsSet, ToggleWingLights, WingLightsSet,
+	ToggleCabinLights, CabinLightsSet, ToggleMasterBattery, MasterBatteryOn, MasterBatteryOff,
+	ToggleMasterAlternator, ToggleAvionicsMaster, AvionicsMasterSet, PitotHeatToggle, PitotHeatSet,
+	AntiIceToggle, AntiIceSet, KohlsmanSet, KohlsmanInc, KohlsmanDec, Barometric, HeadingGyroSet, PauseToggle,
+	PauseOn, PauseOff, PauseSet, SimRateIncr, SimRateDecr, SlewToggle, SlewOn, SlewOff,
+	FreezeLatitudeLongitudeToggle, FreezeAltitudeToggle, FreezeAttitudeToggle, TogglePushback, SmokeToggle,
+	SmokeOn, SmokeOff, LightPotentiometerSet, ElectricalBusToBusConnectionToggle, ElectricalBusToCircuitConnectionToggle,
+	ElectricalCircuitToggle, FuelsystemPumpToggle, FuelsystemValveToggle,
+}
+
+// Indexed events, sent with SimConnect_TransmitClientEvent_EX1
+var (
+	LightPotentiometerSet                  = New("LIGHT_POTENTIOMETER_SET", Number, Number)
+	ElectricalBusToBusConnectionToggle     = New("ELECTRICAL_BUS_TO_BUS_CONNECTION_TOGGLE", Number, Number)
+	ElectricalBusToCircuitConnectionToggle = New("ELECTRICAL_BUS_TO_CIRCUIT_CONNECTION_TOGGLE", Number, Number)
+	ElectricalCircuitToggle                = New("ELECTRICAL_CIRCUIT_TOGGLE", Number)
+	FuelsystemPumpToggle                   = New("FUELSYSTEM_PUMP_TOGGLE", Number)
+	FuelsystemValveToggle                  = New("FUELSYSTEM_VALVE_TOGGLE", Number)
+)
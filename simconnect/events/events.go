@@ -0,0 +1,237 @@
+// Package events is a catalog of key events (the names passed to MapClientEventToSimEvent) together with the meaning
+// of their parameter, so that a value can be given in the unit a human would use and is encoded the way the
+// simulator expects it: 123.45 MHz becomes 0x2345 for COM_STBY_RADIO_SET, squawk 7700 becomes 0x7700 for XPNDR_SET.
+//
+// SimConnect.Send maps an event on first use and transmits it:
+//
+//	simConnect.Send(events.ComStbyRadioSetHz, 123450000)
+package events
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/grumpypixel/msfs2020-simconnect-go/simconnect/units"
+)
+
+var ErrRange = errors.New("events: parameter out of range")
+
+// Param describes the parameter of an event, and thereby the value Encode expects.
+type Param int
+
+const (
+	None          Param = iota // no parameter, the value is ignored
+	Number                     // a signed integer, passed on as is
+	Bool                       // 0 is off, everything else is on
+	Hz                         // a frequency in Hz
+	BCD16                      // a COM or NAV frequency in MHz, sent as BCD16 (123.45 MHz as 0x2345)
+	ADFBCD32                   // an ADF frequency in kHz, sent as Frequency ADF BCD32
+	BCO16                      // a transponder code such as 7700, sent as BCO16
+	Degrees                    // an angle in degrees, normalized to 0-359
+	Feet                       // an altitude in feet
+	FeetPerMinute              // a vertical speed in feet per minute, may be negative
+	Knots                      // a speed in knots
+	Percent                    // 0 to 100, sent as 0 to 16383
+	Axis                       // -100 to 100, sent as -16383 to 16383
+	Millibars                  // a pressure in millibars, sent as millibars * 16
+)
+
+var paramNames = [...]string{
+	None:          "none",
+	Number:        "number",
+	Bool:          "bool",
+	Hz:            "Hz",
+	BCD16:         "BCD16",
+	ADFBCD32:      "ADF BCD32",
+	BCO16:         "BCO16",
+	Degrees:       "degrees",
+	Feet:          "feet",
+	FeetPerMinute: "feet per minute",
+	Knots:         "knots",
+	Percent:       "percent",
+	Axis:          "axis",
+	Millibars:     "millibars",
+}
+
+func (p Param) String() string {
+	if p >= 0 && int(p) < len(paramNames) {
+		return paramNames[p]
+	}
+	return fmt.Sprintf("Param(%d)", int(p))
+}
+
+// Event is a key event. Events which take several parameters (mostly the indexed ones of MSFS) list them in Params
+// and are sent with SimConnect_TransmitClientEvent_EX1.
+type Event struct {
+	Name   string
+	Param  Param   // the first parameter
+	Params []Param // all parameters if there are more than one
+}
+
+// New returns an event which is not in the catalog. Without params the event takes no parameter.
+func New(name string, params ...Param) Event {
+	e := Event{Name: name}
+	if len(params) > 0 {
+		e.Param = params[0]
+	}
+	if len(params) > 1 {
+		e.Params = params
+	}
+	return e
+}
+
+func (e Event) String() string {
+	return e.Name
+}
+
+// Ex1 reports whether the event needs SimConnect_TransmitClientEvent_EX1.
+func (e Event) Ex1() bool {
+	return len(e.Params) > 1
+}
+
+// EncodeAll converts up to five values into the parameters of SimConnect_TransmitClientEvent_EX1.
+// Values beyond the declared parameters are encoded as Number.
+func (e Event) EncodeAll(values ...float64) ([]uint32, error) {
+	if len(values) > 5 {
+		return nil, fmt.Errorf("%w: %s takes at most 5 parameters, got %d", ErrRange, e.Name, len(values))
+	}
+	params := e.Params
+	if len(params) == 0 {
+		params = []Param{e.Param}
+	}
+	data := make([]uint32, len(values))
+	for i, value := range values {
+		param := Number
+		if i < len(params) {
+			param = params[i]
+		}
+		encoded, err := Event{Name: e.Name, Param: param}.Encode(value)
+		if err != nil {
+			return nil, err
+		}
+		data[i] = encoded
+	}
+	return data, nil
+}
+
+// Encode converts value into the dwData of SimConnect_TransmitClientEvent.
+func (e Event) Encode(value float64) (uint32, error) {
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return 0, fmt.Errorf("%w: %s(%v)", ErrRange, e.Name, value)
+	}
+	outOfRange := func() (uint32, error) {
+		return 0, fmt.Errorf("%w: %s(%v) takes %s", ErrRange, e.Name, value, e.Param)
+	}
+
+	switch e.Param {
+	case None:
+		return 0, nil
+
+	case Bool:
+		if value != 0 {
+			return 1, nil
+		}
+		return 0, nil
+
+	case Number, FeetPerMinute:
+		if value < math.MinInt32 || value > math.MaxUint32 {
+			return outOfRange()
+		}
+		if value < 0 {
+			return uint32(int32(math.Round(value))), nil
+		}
+		return uint32(math.Round(value)), nil
+
+	case Hz, Feet, Knots:
+		if value < 0 || value > math.MaxUint32 {
+			return outOfRange()
+		}
+		return uint32(math.Round(value)), nil
+
+	case BCD16:
+		if value < 100 || value >= 200 {
+			return outOfRange()
+		}
+		bcd, err := units.Convert(value, "MHz", "Frequency BCD16")
+		if err != nil {
+			return 0, err
+		}
+		return uint32(bcd), nil
+
+	case ADFBCD32:
+		if value < 0 || value >= 10000 {
+			return outOfRange()
+		}
+		bcd, err := units.Convert(value, "KHz", "Frequency ADF BCD32")
+		if err != nil {
+			return 0, err
+		}
+		return uint32(bcd), nil
+
+	case BCO16:
+		code := int(value)
+		if float64(code) != value || code < 0 || code > 7777 {
+			return outOfRange()
+		}
+		var bco uint32
+		for shift := 0; code > 0; shift += 4 {
+			digit := code % 10
+			if digit > 7 {
+				return outOfRange()
+			}
+			bco |= uint32(digit) << shift
+			code /= 10
+		}
+		return bco, nil
+
+	case Degrees:
+		degrees := math.Mod(math.Round(value), 360)
+		if degrees < 0 {
+			degrees += 360
+		}
+		return uint32(degrees), nil
+
+	case Percent:
+		if value < 0 || value > 100 {
+			return outOfRange()
+		}
+		return uint32(math.Round(value / 100 * 16383)), nil
+
+	case Axis:
+		if value < -100 || value > 100 {
+			return outOfRange()
+		}
+		return uint32(int32(math.Round(value / 100 * 16383))), nil
+
+	case Millibars:
+		if value < 0 || value*16 > math.MaxUint32 {
+			return outOfRange()
+		}
+		return uint32(math.Round(value * 16)), nil
+	}
+	return 0, fmt.Errorf("events: %s has unknown parameter %s", e.Name, e.Param)
+}
+
+// Lookup returns the catalog event with the given name, regardless of case.
+func Lookup(name string) (Event, bool) {
+	e, ok := byName[strings.ToUpper(strings.TrimSpace(name))]
+	return e, ok
+}
+
+// All returns the events of the catalog, sorted by name.
+func All() []Event {
+	result := append([]Event(nil), all...)
+	sort.Slice(result, func(i, j int) bool { return result[i].Name < result[j].Name })
+	return result
+}
+
+var byName = make(map[string]Event, len(all))
+
+func init() {
+	for _, e := range all {
+		byName[e.Name] = e
+	}
+}
@@ -0,0 +1,329 @@
+package simconnect
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// sentCallsKept is the number of calls whose arguments are kept to explain exceptions.
+const sentCallsKept = 256
+
+var exceptionNames = [...]string{
+	ExceptionNone:                          "NONE",
+	ExceptionError:                         "ERROR",
+	ExceptionSizeMismatch:                  "SIZE_MISMATCH",
+	ExceptionUnrecognizedID:                "UNRECOGNIZED_ID",
+	ExceptionUnopened:                      "UNOPENED",
+	ExceptionVersionMismatch:               "VERSION_MISMATCH",
+	ExceptionTooManyGroups:                 "TOO_MANY_GROUPS",
+	ExceptionNameUnrecognized:              "NAME_UNRECOGNIZED",
+	ExceptionTooManyEventNames:             "TOO_MANY_EVENT_NAMES",
+	ExceptionEventIDDuplicate:              "EVENT_ID_DUPLICATE",
+	ExceptionTooManyMaps:                   "TOO_MANY_MAPS",
+	ExceptionTooManyObjects:                "TOO_MANY_OBJECTS",
+	ExceptionTooManyRequests:               "TOO_MANY_REQUESTS",
+	ExceptionWeatherInvalidPort:            "WEATHER_INVALID_PORT",
+	ExceptionWeatherInvalidMetar:           "WEATHER_INVALID_METAR",
+	ExceptionWeatherUnableToGetObservation: "WEATHER_UNABLE_TO_GET_OBSERVATION",
+	ExceptionWeatherUnableToCreateStation:  "WEATHER_UNABLE_TO_CREATE_STATION",
+	ExceptionWeatherUnableToRemoveStation:  "WEATHER_UNABLE_TO_REMOVE_STATION",
+	ExceptionInvalidDataType:               "INVALID_DATA_TYPE",
+	ExceptionInvalidDataSize:               "INVALID_DATA_SIZE",
+	ExceptionDataError:                     "DATA_ERROR",
+	ExceptionInvalidArray:                  "INVALID_ARRAY",
+	ExceptionCreateObjectFailed:            "CREATE_OBJECT_FAILED",
+	ExceptionLoadFlightplanFailed:          "LOAD_FLIGHTPLAN_FAILED",
+	ExceptionOperationInvalidForObjectType: "OPERATION_INVALID_FOR_OBJECT_TYPE",
+	ExceptionIllegalOperation:              "ILLEGAL_OPERATION",
+	ExceptionAlreadySubscribed:             "ALREADY_SUBSCRIBED",
+	ExceptionInvalidEnum:                   "INVALID_ENUM",
+	ExceptionDefinitionError:               "DEFINITION_ERROR",
+	ExceptionDuplicateID:                   "DUPLICATE_ID",
+	ExceptionDatumID:                       "DATUM_ID",
+	ExceptionOutOfBounds:                   "OUT_OF_BOUNDS",
+	ExceptionAlreadyCreated:                "ALREADY_CREATED",
+	ExceptionObjectOutsideRealityBubble:    "OBJECT_OUTSIDE_REALITY_BUBBLE",
+	ExceptionObjectContainer:               "OBJECT_CONTAINER",
+	ExceptionObjectAt:                      "OBJECT_AI",
+	ExceptionObjectATC:                     "OBJECT_ATC",
+	ExceptionObjectSchedule:                "OBJECT_SCHEDULE",
+}
+
+// ExceptionName returns the name of a SIMCONNECT_EXCEPTION without prefix, e.g. "NAME_UNRECOGNIZED".
+func ExceptionName(exception DWord) string {
+	if int(exception) < len(exceptionNames) {
+		return exceptionNames[exception]
+	}
+	return fmt.Sprintf("EXCEPTION_%d", exception)
+}
+
+// SimConnectError is an exception reported by the simulator, resolved to the call which caused it.
+type SimConnectError struct {
+	Exception DWord         // SIMCONNECT_EXCEPTION
+	Name      string        // the name of the exception, see ExceptionName
+	SendID    DWord         // the packet ID of the failing call
+	Index     DWord         // the index of the parameter which caused the exception, DWordMax if unknown
+	ProcName  string        // the failing call, empty if it is unknown or no longer remembered
+	Args      []interface{} // the arguments of the failing call
+}
+
+func (e *SimConnectError) Error() string {
+	var b strings.Builder
+	b.WriteString("simconnect: ")
+	if e.ProcName != "" {
+		b.WriteString(e.ProcName)
+		b.WriteByte('(')
+		for i, arg := range e.Args {
+			if i > 0 {
+				b.WriteString(", ")
+			}
+			switch arg := arg.(type) {
+			case string:
+				fmt.Fprintf(&b, "%q", arg)
+			case []byte:
+				fmt.Fprintf(&b, "[%d bytes]", len(arg))
+			default:
+				fmt.Fprintf(&b, "%v", arg)
+			}
+		}
+		b.WriteString(") failed with ")
+	} else {
+		fmt.Fprintf(&b, "call %d failed with ", e.SendID)
+	}
+	b.WriteString(e.Name)
+	if e.Index != DWordMax && e.Index != 0 {
+		fmt.Fprintf(&b, " at parameter %d", e.Index)
+	}
+	return b.String()
+}
+
+// ErrorFuture is resolved once the simulator reports an exception for a call.
+// Since successful calls are not acknowledged, wait for it with a deadline.
+type ErrorFuture struct {
+	done chan struct{}
+	err  *SimConnectError
+	hook func(err *SimConnectError)
+}
+
+// Done is closed when the call has failed.
+func (f *ErrorFuture) Done() <-chan struct{} {
+	return f.done
+}
+
+// Err returns the exception of the call once Done is closed, and nil before.
+func (f *ErrorFuture) Err() *SimConnectError {
+	select {
+	case <-f.done:
+		return f.err
+	default:
+		return nil
+	}
+}
+
+// Wait returns the exception of the call, or ctx.Err() if ctx is done first.
+func (f *ErrorFuture) Wait(ctx context.Context) error {
+	select {
+	case <-f.done:
+		return f.err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// LastSendID returns the packet ID of the last call made through simco.
+// Calls made from several goroutines are serialized, so the ID belongs to the last call which returned.
+// If the transport is no SendIDReporter, the ID is asked for with GetLastSentPacketID.
+func (simco *SimConnect) LastSendID() DWord {
+	simco.callLock.Lock()
+	defer simco.callLock.Unlock()
+	simco.sent.lock.Lock()
+	unknown := simco.sent.unknown
+	simco.sent.lock.Unlock()
+	if unknown && simco.transport != nil {
+		if sendID, err := simco.lastSentPacketID(); err == nil {
+			simco.sent.lock.Lock()
+			simco.sent.setLast(sendID)
+			simco.sent.lock.Unlock()
+		}
+	}
+	simco.sent.lock.Lock()
+	defer simco.sent.lock.Unlock()
+	return simco.sent.last
+}
+
+// ErrorOf returns a future for the exception of the call with the given packet ID, see LastSendID.
+// Exceptions are resolved while Run, RunSession or SimMate.Run dispatch messages.
+// Only the last few hundred calls are remembered; the future of an older call is never resolved.
+// The same goes for calls whose packet ID was never asked for, see callSent.
+func (simco *SimConnect) ErrorOf(sendID DWord) *ErrorFuture {
+	return simco.errorOf(sendID, nil)
+}
+
+// errorOf is ErrorOf with a hook which is run once the future is resolved, without any lock held.
+func (simco *SimConnect) errorOf(sendID DWord, hook func(err *SimConnectError)) *ErrorFuture {
+	simco.sent.lock.Lock()
+	future := &ErrorFuture{done: make(chan struct{}), hook: hook}
+	call := simco.sent.find(sendID)
+	switch {
+	case call == nil:
+		// The call is unknown or has been forgotten, so the future is never resolved.
+	case call.err != nil:
+		future.err = call.err
+		close(future.done)
+	default:
+		call.futures = append(call.futures, future)
+	}
+	err := future.err
+	simco.sent.lock.Unlock()
+	if err != nil && hook != nil {
+		hook(err)
+	}
+	return future
+}
+
+// ResolveException returns the exception as a *SimConnectError, with the call which caused it if that is remembered.
+// Run resolves every exception it dispatches, so calling it again is cheap. SimMate passes the resolved
+// exception on to EventListener.OnError.
+func (simco *SimConnect) ResolveException(recv *RecvException) *SimConnectError {
+	simco.sent.lock.Lock()
+	err := &SimConnectError{
+		Exception: recv.Exception,
+		Name:      ExceptionName(recv.Exception),
+		SendID:    recv.SendID,
+		Index:     recv.Index,
+	}
+	var futures []*ErrorFuture
+	call := simco.sent.find(recv.SendID)
+	if call != nil {
+		if call.err != nil && call.err.Exception == recv.Exception && call.err.Index == recv.Index {
+			simco.sent.lock.Unlock()
+			return call.err
+		}
+		err.ProcName = call.procName
+		err.Args = call.args
+		call.err = err
+		futures, call.futures = call.futures, nil
+		for _, future := range futures {
+			future.err = err
+			close(future.done)
+		}
+	}
+	simco.sent.lock.Unlock()
+
+	for _, future := range futures {
+		if future.hook != nil {
+			future.hook(err)
+		}
+	}
+	return err
+}
+
+// callSent remembers a successful call and returns its packet ID, or 0 if it is not known.
+// Transports which are a SendIDReporter tell the packet ID themselves. Other transports are only asked with
+// GetLastSentPacketID if needID is set or the call creates an AI object, which CreateAIObject has to track;
+// for all other calls the packet ID is left to LastSendID. An exception of a call without packet ID
+// is reported without the call.
+func (simco *SimConnect) callSent(procName string, args []interface{}, needID bool) DWord {
+	if procName == scGetLastSentPacketID {
+		return 0
+	}
+	var sendID DWord
+	if reporter, ok := simco.transport.(SendIDReporter); ok {
+		sendID = reporter.LastSendID()
+	} else if needID || isAICreateProc(procName) {
+		sendID, _ = simco.lastSentPacketID()
+	}
+	simco.sent.lock.Lock()
+	defer simco.sent.lock.Unlock()
+	simco.sent.add(sentCall{sendID: sendID, procName: procName, args: append([]interface{}(nil), args...)})
+	return sendID
+}
+
+// lastSentPacketID asks the transport for the packet ID of the last call. callLock must be held.
+func (simco *SimConnect) lastSentPacketID() (DWord, error) {
+	var sendID DWord
+	err := simco.transport.Call(scGetLastSentPacketID, &sendID)
+	return sendID, err
+}
+
+func isAICreateProc(procName string) bool {
+	for _, name := range aiCreateProcs {
+		if name == procName {
+			return true
+		}
+	}
+	return false
+}
+
+// sentCalls is a ring buffer of the last calls.
+type sentCalls struct {
+	lock    sync.Mutex
+	calls   [sentCallsKept]sentCall
+	next    int
+	last    DWord
+	unknown bool // the packet ID of the last call has not been asked for yet
+}
+
+type sentCall struct {
+	sendID   DWord
+	procName string
+	args     []interface{}
+	err      *SimConnectError
+	futures  []*ErrorFuture
+}
+
+func (s *sentCalls) add(call sentCall) {
+	s.calls[s.next] = call
+	s.next = (s.next + 1) % len(s.calls)
+	s.last = call.sendID
+	s.unknown = call.sendID == 0
+}
+
+// setLast fills in the packet ID of the last call, which was added without one.
+func (s *sentCalls) setLast(sendID DWord) {
+	s.calls[(s.next-1+len(s.calls))%len(s.calls)].sendID = sendID
+	s.last = sendID
+	s.unknown = false
+}
+
+// reset forgets all calls. Packet IDs start anew with every connection.
+func (s *sentCalls) reset() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.calls = [sentCallsKept]sentCall{}
+	s.next = 0
+	s.last = 0
+	s.unknown = false
+}
+
+func (s *sentCalls) find(sendID DWord) *sentCall {
+	if sendID == 0 {
+		return nil
+	}
+	for i := range s.calls {
+		if s.calls[i].sendID == sendID && s.calls[i].procName != "" {
+			return &s.calls[i]
+		}
+	}
+	return nil
+}
+
+// findRequest returns the packet ID of the latest call of one of procNames whose last argument is requestID, 0 if there is none.
+func (s *sentCalls) findRequest(requestID DWord, procNames ...string) DWord {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for i := 1; i <= len(s.calls); i++ {
+		call := &s.calls[(s.next-i+len(s.calls))%len(s.calls)]
+		if len(call.args) == 0 || call.args[len(call.args)-1] != requestID {
+			continue
+		}
+		for _, procName := range procNames {
+			if call.procName == procName {
+				return call.sendID
+			}
+		}
+	}
+	return 0
+}
@@ -0,0 +1,68 @@
+package simconnect_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+
+	"github.com/grumpypixel/msfs2020-simconnect-go/simconnect"
+	"github.com/grumpypixel/msfs2020-simconnect-go/simconnect/simconnecttest"
+)
+
+// plainTransport hides that the fake server is a SendIDReporter and counts the calls of GetLastSentPacketID.
+type plainTransport struct {
+	simconnect.Transport
+	packetIDCalls int32
+}
+
+func (t *plainTransport) Call(procName string, args ...interface{}) error {
+	if procName == "SimConnect_GetLastSentPacketID" {
+		atomic.AddInt32(&t.packetIDCalls, 1)
+	}
+	return t.Transport.Call(procName, args...)
+}
+
+func TestLastSendIDWithoutReporter(t *testing.T) {
+	sim := simconnecttest.NewSim()
+	transport := &plainTransport{Transport: sim}
+	simco := simconnect.NewSimConnectWithTransport(transport)
+	if err := simco.Open("Exceptions Test"); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { simco.Close() })
+
+	if err := simco.AddToDataDefinition(1, "PLANE ALTITUDE", "feet", simconnect.DataTypeFloat64); err != nil {
+		t.Fatal(err)
+	}
+	if err := simco.SetSystemEventState(42, simconnect.StateOff); err != nil {
+		t.Fatal(err)
+	}
+	if n := atomic.LoadInt32(&transport.packetIDCalls); n != 0 {
+		t.Errorf("GetLastSentPacketID called %d times before the packet ID was needed", n)
+	}
+	sendID := simco.LastSendID()
+	if sendID != sim.LastSendID() {
+		t.Errorf("LastSendID: got %d, want %d", sendID, sim.LastSendID())
+	}
+	if simco.LastSendID() != sendID || atomic.LoadInt32(&transport.packetIDCalls) != 1 {
+		t.Error("the packet ID is asked for more than once")
+	}
+
+	future := simco.ErrorOf(sendID)
+	sim.InjectException(simconnect.ExceptionUnrecognizedID, 1)
+	stop := runAsync(t, simco, nil)
+	ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
+	defer cancel()
+	var simErr *simconnect.SimConnectError
+	if err := future.Wait(ctx); !errors.As(err, &simErr) || simErr.ProcName != "SimConnect_SetSystemEventState" {
+		t.Errorf("ErrorOf: got %v", err)
+	}
+
+	// Requests need the packet ID to fail with their exception, so they ask for it right away.
+	_, err := simco.SystemState(ctx, "NoSuchState")
+	if !errors.As(err, &simErr) || simErr.ProcName != "SimConnect_RequestSystemState" {
+		t.Errorf("SystemState of an unknown state: got %v", err)
+	}
+	stop()
+}
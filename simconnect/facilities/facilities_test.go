@@ -0,0 +1,255 @@
+package facilities_test
+
+import (
+	"context"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/grumpypixel/msfs2020-simconnect-go/simconnect"
+	"github.com/grumpypixel/msfs2020-simconnect-go/simconnect/facilities"
+	"github.com/grumpypixel/msfs2020-simconnect-go/simconnect/simconnecttest"
+)
+
+const testTimeout = 2 * time.Second
+
+func airport(icao string, latitude, longitude float64) simconnect.DataFacilityAirport {
+	var airport simconnect.DataFacilityAirport
+	copy(airport.Icao[:], icao)
+	airport.Latitude, airport.Longitude = latitude, longitude
+	return airport
+}
+
+// page returns message entry of a list of airports chopped into outOf messages.
+func page(requestID, entry, outOf simconnect.DWord, icaos ...string) *simconnect.AirportListMessage {
+	msg := &simconnect.AirportListMessage{}
+	msg.RequestID, msg.EntryNumber, msg.OutOf = requestID, entry, outOf
+	msg.ArraySize = simconnect.DWord(len(icaos))
+	for _, icao := range icaos {
+		msg.Airports = append(msg.Airports, airport(icao, 0, 0))
+	}
+	return msg
+}
+
+func icaos(facilities []facilities.Facility) []string {
+	result := make([]string, 0, len(facilities))
+	for _, facility := range facilities {
+		result = append(result, facility.ICAO)
+	}
+	return result
+}
+
+func TestAssembler(t *testing.T) {
+	tests := []struct {
+		name     string
+		messages []simconnect.Message
+		want     map[int][]string // the lists completed by the messages at these indices
+	}{
+		{
+			name:     "single message",
+			messages: []simconnect.Message{page(1, 0, 1, "KSEA", "KBFI")},
+			want:     map[int][]string{0: {"KSEA", "KBFI"}},
+		},
+		{
+			name:     "out of order",
+			messages: []simconnect.Message{page(1, 2, 3, "KPAE"), page(1, 0, 3, "KSEA"), page(1, 1, 3, "KBFI")},
+			want:     map[int][]string{2: {"KSEA", "KBFI", "KPAE"}},
+		},
+		{
+			// A page received twice starts a new list.
+			name:     "duplicated page",
+			messages: []simconnect.Message{page(1, 0, 2, "KSEA"), page(1, 0, 2, "KRNT"), page(1, 1, 2, "KBFI")},
+			want:     map[int][]string{2: {"KRNT", "KBFI"}},
+		},
+		{
+			name:     "OutOf changed",
+			messages: []simconnect.Message{page(1, 0, 3, "KSEA"), page(1, 0, 2, "KTCM"), page(1, 2, 3, "KPAE"), page(1, 1, 2, "KBFI")},
+			want:     map[int][]string{},
+		},
+		{
+			name:     "OutOf changed back",
+			messages: []simconnect.Message{page(1, 0, 3, "KSEA"), page(1, 0, 2, "KTCM"), page(1, 1, 2, "KBFI")},
+			want:     map[int][]string{2: {"KTCM", "KBFI"}},
+		},
+		{
+			name: "interleaved request IDs",
+			messages: []simconnect.Message{
+				page(1, 0, 2, "KSEA"), page(2, 1, 2, "EGLL"), page(2, 0, 2, "EDDF"), page(1, 1, 2, "KBFI"),
+			},
+			want: map[int][]string{2: {"EDDF", "EGLL"}, 3: {"KSEA", "KBFI"}},
+		},
+		{
+			name:     "entry out of range",
+			messages: []simconnect.Message{page(1, 2, 2, "KSEA"), page(1, 0, 2, "KBFI")},
+			want:     map[int][]string{},
+		},
+		{
+			name:     "OutOf out of range",
+			messages: []simconnect.Message{page(1, 0, 1<<30, "KSEA"), page(1, 0, 0xffffffff, "KBFI")},
+			want:     map[int][]string{},
+		},
+		{
+			name:     "no facility list",
+			messages: []simconnect.Message{&simconnect.RecvQuit{}},
+			want:     map[int][]string{},
+		},
+	}
+	for _, test := range tests {
+		var assembler facilities.Assembler
+		for i, msg := range test.messages {
+			list, ok := assembler.Add(msg)
+			want, complete := test.want[i]
+			if ok != complete {
+				t.Errorf("%s: message %d: got complete %v, want %v", test.name, i, ok, complete)
+				continue
+			}
+			if ok && !reflect.DeepEqual(icaos(list.Facilities), want) {
+				t.Errorf("%s: message %d: got %v, want %v", test.name, i, icaos(list.Facilities), want)
+			}
+		}
+	}
+}
+
+// openStore connects a Store to a fake simulator and dispatches its messages until the test ends.
+func openStore(t *testing.T) (*facilities.Store, *simconnect.SimConnect, *simconnecttest.Sim) {
+	t.Helper()
+	sim := simconnecttest.NewSim()
+	simco := simconnect.NewSimConnectWithTransport(sim)
+	if err := simco.Open("Facilities Test"); err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		simco.Run(ctx, nil)
+	}()
+	t.Cleanup(func() {
+		cancel()
+		<-done
+		simco.Close()
+	})
+	return facilities.NewStore(simco), simco, sim
+}
+
+// eventually fails the test unless condition holds within testTimeout.
+func eventually(t *testing.T, what string, condition func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(testTimeout)
+	for !condition() {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for %s", what)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+// changes records the calls of OnInRange and OnOutOfRange.
+type changes struct {
+	lock       sync.Mutex
+	inRange    [][]string
+	outOfRange [][]string
+}
+
+func (c *changes) watch(store *facilities.Store) {
+	store.OnInRange = func(facilities []facilities.Facility) {
+		c.lock.Lock()
+		defer c.lock.Unlock()
+		c.inRange = append(c.inRange, icaos(facilities))
+	}
+	store.OnOutOfRange = func(facilities []facilities.Facility) {
+		c.lock.Lock()
+		defer c.lock.Unlock()
+		c.outOfRange = append(c.outOfRange, icaos(facilities))
+	}
+}
+
+func (c *changes) get() (inRange, outOfRange [][]string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return append([][]string(nil), c.inRange...), append([][]string(nil), c.outOfRange...)
+}
+
+func TestSubscribe(t *testing.T) {
+	store, _, sim := openStore(t)
+	var c changes
+	c.watch(store)
+	sim.SetListSize(2)
+	sim.SetAirports(airport("KSEA", 47.45, -122.31), airport("KBFI", 47.53, -122.30), airport("KPAE", 47.91, -122.28))
+
+	if err := store.Subscribe(simconnect.FacilityListTypeAirport); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.Subscribe(simconnect.FacilityListTypeAirport); err != nil {
+		t.Fatal(err)
+	}
+	if calls := sim.CallsTo("SimConnect_SubscribeToFacilities_EX1"); len(calls) != 1 {
+		t.Errorf("got %d subscriptions, want subscribing twice to do nothing", len(calls))
+	}
+	// The list of airports in range comes in two messages, which are put back together.
+	eventually(t, "the airports in range", func() bool { return store.Len(simconnect.FacilityListTypeAirport) == 3 })
+	if inRange, _ := c.get(); !reflect.DeepEqual(inRange, [][]string{{"KSEA", "KBFI", "KPAE"}}) {
+		t.Errorf("OnInRange: got %v", inRange)
+	}
+	if facility, ok := store.Get(simconnect.FacilityListTypeAirport, "kbfi"); !ok || facility.Latitude != 47.53 {
+		t.Errorf("KBFI: got %+v, %v", facility, ok)
+	}
+
+	// KPAE goes out of range, KRNT comes into range.
+	sim.SetAirports(airport("KSEA", 47.45, -122.31), airport("KBFI", 47.53, -122.30), airport("KRNT", 47.49, -122.22))
+	eventually(t, "KPAE to go out of range", func() bool {
+		_, ok := store.Get(simconnect.FacilityListTypeAirport, "KPAE")
+		return !ok
+	})
+	eventually(t, "KRNT to come into range", func() bool {
+		_, ok := store.Get(simconnect.FacilityListTypeAirport, "KRNT")
+		return ok
+	})
+	inRange, outOfRange := c.get()
+	if !reflect.DeepEqual(inRange, [][]string{{"KSEA", "KBFI", "KPAE"}, {"KRNT"}}) || !reflect.DeepEqual(outOfRange, [][]string{{"KPAE"}}) {
+		t.Errorf("got in range %v, out of range %v", inRange, outOfRange)
+	}
+
+	if err := store.Unsubscribe(simconnect.FacilityListTypeAirport); err != nil {
+		t.Fatal(err)
+	}
+	sim.SetAirports(airport("KSEA", 47.45, -122.31))
+	if calls := sim.CallsTo("SimConnect_UnsubscribeToFacilities"); len(calls) != 1 {
+		t.Errorf("got %d calls to SimConnect_UnsubscribeToFacilities, want 1", len(calls))
+	}
+	if store.Len(simconnect.FacilityListTypeAirport) != 3 {
+		t.Errorf("the facilities are not kept after unsubscribing: %v", store.All(simconnect.FacilityListTypeAirport))
+	}
+}
+
+func TestSubscribeWithoutEx1(t *testing.T) {
+	store, _, sim := openStore(t)
+	var c changes
+	c.watch(store)
+	sim.Handle("SimConnect_SubscribeToFacilities_EX1", func(sim *simconnecttest.Sim, call simconnecttest.Call) error {
+		return simconnect.ErrUnsupported
+	})
+	sim.SetAirports(airport("KSEA", 47.45, -122.31), airport("KBFI", 47.53, -122.30))
+
+	if err := store.Subscribe(simconnect.FacilityListTypeAirport); err != nil {
+		t.Fatal(err)
+	}
+	if calls := sim.CallsTo("SimConnect_SubscribeToFacilities"); len(calls) != 1 {
+		t.Fatalf("got %d calls to SimConnect_SubscribeToFacilities, want the fallback", len(calls))
+	}
+	eventually(t, "the airports in range", func() bool { return store.Len(simconnect.FacilityListTypeAirport) == 2 })
+
+	// Only the facilities coming into range are reported.
+	sim.SetAirports(airport("KSEA", 47.45, -122.31), airport("KRNT", 47.49, -122.22))
+	eventually(t, "KRNT to come into range", func() bool {
+		_, ok := store.Get(simconnect.FacilityListTypeAirport, "KRNT")
+		return ok
+	})
+	if _, ok := store.Get(simconnect.FacilityListTypeAirport, "KBFI"); !ok {
+		t.Error("KBFI is removed without an out of range subscription")
+	}
+	if _, outOfRange := c.get(); len(outOfRange) != 0 {
+		t.Errorf("OnOutOfRange: got %v", outOfRange)
+	}
+}
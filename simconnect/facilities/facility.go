@@ -0,0 +1,211 @@
+// Package facilities keeps track of the airports, waypoints, NDBs and VORs in the simulator's facilities cache.
+// It decodes the facility list messages into Facility values, puts lists back together which the simulator has
+// chopped into several messages, and keeps a Store indexed by type and ICAO, either loaded once or kept up to date
+// with the facilities entering and leaving the reality bubble.
+package facilities
+
+import (
+	"bytes"
+	"errors"
+
+	"github.com/grumpypixel/msfs2020-simconnect-go/simconnect"
+)
+
+type DWord = simconnect.DWord
+
+var (
+	ErrListType     = errors.New("facilities: invalid list type")
+	ErrNoConnection = errors.New("facilities: store has no connection")
+)
+
+// Facility is an airport, waypoint, NDB or VOR. The fields which do not apply to its Type are zero.
+type Facility struct {
+	Type      DWord // simconnect.FacilityListTypeAirport, ...
+	ICAO      string
+	Latitude  float64 // degrees
+	Longitude float64 // degrees
+	Altitude  float64 // meters
+	MagVar    float32 // degrees; waypoints, NDBs and VORs
+	Frequency DWord   // Hz; NDBs and VORs
+
+	// VORs only
+	Flags           DWord   // simconnect.RecvIDVORListHasNAVSignal, ...
+	Localizer       float32 // degrees
+	GlideLat        float64 // degrees
+	GlideLon        float64 // degrees
+	GlideAlt        float64 // meters
+	GlideSlopeAngle float32 // degrees
+}
+
+// List is the content of a facility list message, or of all messages of a list which was chopped into several.
+type List struct {
+	Type       DWord
+	RequestID  DWord
+	Facilities []Facility
+}
+
+// FromAirport converts a simconnect.DataFacilityAirport.
+func FromAirport(airport simconnect.DataFacilityAirport) Facility {
+	return Facility{
+		Type:      simconnect.FacilityListTypeAirport,
+		ICAO:      icaoString(airport.Icao),
+		Latitude:  airport.Latitude,
+		Longitude: airport.Longitude,
+		Altitude:  airport.Altitude,
+	}
+}
+
+// FromWaypoint converts a simconnect.DataFacilityWaypoint.
+func FromWaypoint(waypoint simconnect.DataFacilityWaypoint) Facility {
+	facility := FromAirport(waypoint.DataFacilityAirport)
+	facility.Type = simconnect.FacilityListTypeWaypoint
+	facility.MagVar = waypoint.MagVar
+	return facility
+}
+
+// FromNDB converts a simconnect.DataFacilityNDB.
+func FromNDB(ndb simconnect.DataFacilityNDB) Facility {
+	facility := FromWaypoint(ndb.DataFacilityWaypoint)
+	facility.Type = simconnect.FacilityListTypeNDB
+	facility.Frequency = ndb.Frequency
+	return facility
+}
+
+// FromVOR converts a simconnect.DataFacilityVOR.
+func FromVOR(vor simconnect.DataFacilityVOR) Facility {
+	facility := FromNDB(vor.DataFacilityNDB)
+	facility.Type = simconnect.FacilityListTypeVOR
+	facility.Flags = vor.Flags
+	facility.Localizer = vor.Localizer
+	facility.GlideLat = vor.GlideLat
+	facility.GlideLon = vor.GlideLon
+	facility.GlideAlt = vor.GlideAlt
+	facility.GlideSlopeAngle = vor.GlideSlopeAngle
+	return facility
+}
+
+// FromFacilities converts the answer to SimConnect.RequestFacilities.
+func FromFacilities(facilities *simconnect.Facilities) []Facility {
+	var result []Facility
+	for _, airport := range facilities.Airports {
+		result = append(result, FromAirport(airport))
+	}
+	for _, waypoint := range facilities.Waypoints {
+		result = append(result, FromWaypoint(waypoint))
+	}
+	for _, ndb := range facilities.NDBs {
+		result = append(result, FromNDB(ndb))
+	}
+	for _, vor := range facilities.VORs {
+		result = append(result, FromVOR(vor))
+	}
+	return result
+}
+
+// Decode returns the facilities of a single facility list message (*simconnect.AirportListMessage, ...),
+// and false if msg is none. Use an Assembler for lists which may span several messages.
+func Decode(msg simconnect.Message) (*List, bool) {
+	_, list, ok := decode(msg)
+	return list, ok
+}
+
+func decode(msg simconnect.Message) (simconnect.RecvFacilitiesList, *List, bool) {
+	var header simconnect.RecvFacilitiesList
+	list := &List{}
+	switch msg := msg.(type) {
+	case *simconnect.AirportListMessage:
+		header, list.Type = msg.RecvFacilitiesList, simconnect.FacilityListTypeAirport
+		for _, airport := range msg.Airports {
+			list.Facilities = append(list.Facilities, FromAirport(airport))
+		}
+	case *simconnect.WaypointListMessage:
+		header, list.Type = msg.RecvFacilitiesList, simconnect.FacilityListTypeWaypoint
+		for _, waypoint := range msg.Waypoints {
+			list.Facilities = append(list.Facilities, FromWaypoint(waypoint))
+		}
+	case *simconnect.NDBListMessage:
+		header, list.Type = msg.RecvFacilitiesList, simconnect.FacilityListTypeNDB
+		for _, ndb := range msg.NDBs {
+			list.Facilities = append(list.Facilities, FromNDB(ndb))
+		}
+	case *simconnect.VORListMessage:
+		header, list.Type = msg.RecvFacilitiesList, simconnect.FacilityListTypeVOR
+		for _, vor := range msg.VORs {
+			list.Facilities = append(list.Facilities, FromVOR(vor))
+		}
+	default:
+		return header, nil, false
+	}
+	list.RequestID = header.RequestID
+	return header, list, true
+}
+
+// maxPages is the most messages a list may be chopped into. Each carries at least one facility, and the facilities
+// cache only holds those around the user aircraft, so larger OutOf values are taken for garbage.
+const maxPages = 4096
+
+// Assembler puts facility lists back together which the simulator has chopped into several messages,
+// numbered by EntryNumber from 0 to OutOf-1. The zero value is ready to use; it is not safe for concurrent use.
+type Assembler struct {
+	partial map[DWord]*partialList // by request ID
+}
+
+type partialList struct {
+	listType DWord
+	pages    [][]Facility
+	received []bool
+	count    int
+}
+
+// Add adds a facility list message. It returns the complete list once all messages of the list have arrived,
+// with the facilities in the order of the messages. Messages which are no facility lists are ignored, and so are
+// those whose EntryNumber or OutOf are out of range.
+func (assembler *Assembler) Add(msg simconnect.Message) (*List, bool) {
+	header, list, ok := decode(msg)
+	if !ok || header.EntryNumber >= header.OutOf && header.OutOf > 0 || header.OutOf > maxPages {
+		return nil, false
+	}
+	if header.OutOf <= 1 {
+		return list, true
+	}
+
+	if assembler.partial == nil {
+		assembler.partial = make(map[DWord]*partialList)
+	}
+	partial := assembler.partial[header.RequestID]
+	if partial == nil || partial.listType != list.Type || len(partial.pages) != int(header.OutOf) || partial.received[header.EntryNumber] {
+		// A new list; what is left of an earlier one with this request ID will never be complete.
+		partial = &partialList{
+			listType: list.Type,
+			pages:    make([][]Facility, header.OutOf),
+			received: make([]bool, header.OutOf),
+		}
+		assembler.partial[header.RequestID] = partial
+	}
+	partial.received[header.EntryNumber] = true
+	partial.count++
+	partial.pages[header.EntryNumber] = list.Facilities
+	if partial.count < len(partial.pages) {
+		return nil, false
+	}
+
+	delete(assembler.partial, header.RequestID)
+	list.Facilities = nil
+	for _, page := range partial.pages {
+		list.Facilities = append(list.Facilities, page...)
+	}
+	return list, true
+}
+
+// Reset forgets the lists which are not complete yet.
+func (assembler *Assembler) Reset() {
+	assembler.partial = nil
+}
+
+func icaoString(icao [9]byte) string {
+	data := icao[:]
+	if i := bytes.IndexByte(data, 0); i >= 0 {
+		data = data[:i]
+	}
+	return string(data)
+}
@@ -0,0 +1,136 @@
+package facilities
+
+import (
+	"context"
+	"math"
+	"sort"
+
+	"github.com/grumpypixel/msfs2020-simconnect-go/simconnect"
+)
+
+// EarthRadius is the mean radius of the earth in nautical miles, used for distances.
+const EarthRadius = 3440.065
+
+// Position is a point on the earth's surface.
+type Position struct {
+	Latitude  float64 // degrees
+	Longitude float64 // degrees
+}
+
+// Position returns the position of the facility.
+func (facility Facility) Position() Position {
+	return Position{Latitude: facility.Latitude, Longitude: facility.Longitude}
+}
+
+// Has reports whether all of flags (simconnect.RecvIDVORListHasDME, ...) are set. Only VORs have flags.
+func (facility Facility) Has(flags DWord) bool {
+	return facility.Flags&flags == flags
+}
+
+// UserPosition queries the position of the user aircraft. It blocks like SimConnect.Query.
+func UserPosition(ctx context.Context, simco *simconnect.SimConnect) (Position, error) {
+	latitude, err := simconnect.QueryAs[float64](ctx, simco, "PLANE LATITUDE", "degrees")
+	if err != nil {
+		return Position{}, err
+	}
+	longitude, err := simconnect.QueryAs[float64](ctx, simco, "PLANE LONGITUDE", "degrees")
+	if err != nil {
+		return Position{}, err
+	}
+	return Position{Latitude: latitude, Longitude: longitude}, nil
+}
+
+// Distance returns the great circle distance between two positions in nautical miles.
+func Distance(from, to Position) float64 {
+	lat1, lat2 := radians(from.Latitude), radians(to.Latitude)
+	dLat := lat2 - lat1
+	dLon := radians(to.Longitude - from.Longitude)
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return 2 * EarthRadius * math.Asin(math.Min(1, math.Sqrt(a)))
+}
+
+// Bearing returns the initial true bearing from one position to another in degrees, from 0 up to 360.
+func Bearing(from, to Position) float64 {
+	lat1, lat2 := radians(from.Latitude), radians(to.Latitude)
+	dLon := radians(to.Longitude - from.Longitude)
+	y := math.Sin(dLon) * math.Cos(lat2)
+	x := math.Cos(lat1)*math.Sin(lat2) - math.Sin(lat1)*math.Cos(lat2)*math.Cos(dLon)
+	bearing := math.Mod(math.Atan2(y, x)*180/math.Pi+360, 360)
+	if bearing >= 360 {
+		bearing = 0
+	}
+	return bearing
+}
+
+func radians(degrees float64) float64 {
+	return degrees * math.Pi / 180
+}
+
+// Result is a facility as seen from a position.
+type Result struct {
+	Facility
+	Distance float64 // nautical miles
+	Bearing  float64 // true degrees from the position to the facility
+}
+
+// Measure returns the distance and bearing from a position to a facility.
+func Measure(from Position, facility Facility) Result {
+	to := facility.Position()
+	return Result{Facility: facility, Distance: Distance(from, to), Bearing: Bearing(from, to)}
+}
+
+// Filter selects facilities in Nearest and Within.
+type Filter func(facility Facility) bool
+
+// WithFlags selects the VORs which have all of flags, e.g. simconnect.RecvIDVORListHasDME.
+func WithFlags(flags DWord) Filter {
+	return func(facility Facility) bool {
+		return facility.Has(flags)
+	}
+}
+
+// Nearest returns up to n facilities of listType which pass all filters, nearest first; all of them if n is negative.
+func (store *Store) Nearest(listType DWord, from Position, n int, filters ...Filter) []Result {
+	results := store.measure(listType, from, math.Inf(1), filters)
+	if n >= 0 && len(results) > n {
+		results = results[:n]
+	}
+	return results
+}
+
+// Within returns the facilities of listType within radius nautical miles which pass all filters, nearest first.
+func (store *Store) Within(listType DWord, from Position, radius float64, filters ...Filter) []Result {
+	return store.measure(listType, from, radius, filters)
+}
+
+func (store *Store) measure(listType DWord, from Position, radius float64, filters []Filter) []Result {
+	if checkListType(listType) != nil {
+		return nil
+	}
+	store.lock.RLock()
+	facilities := make([]Facility, 0, len(store.byType[listType]))
+	for _, facility := range store.byType[listType] {
+		facilities = append(facilities, facility)
+	}
+	store.lock.RUnlock()
+
+	var results []Result
+next:
+	for _, facility := range facilities {
+		for _, filter := range filters {
+			if !filter(facility) {
+				continue next
+			}
+		}
+		if result := Measure(from, facility); result.Distance <= radius {
+			results = append(results, result)
+		}
+	}
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].Distance != results[j].Distance {
+			return results[i].Distance < results[j].Distance
+		}
+		return results[i].ICAO < results[j].ICAO
+	})
+	return results
+}
@@ -0,0 +1,146 @@
+package facilities_test
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/grumpypixel/msfs2020-simconnect-go/simconnect"
+	"github.com/grumpypixel/msfs2020-simconnect-go/simconnect/facilities"
+)
+
+func TestDistanceAndBearing(t *testing.T) {
+	tests := []struct {
+		name              string
+		from, to          facilities.Position
+		distance, bearing float64
+	}{
+		{"KJFK to EGLL", facilities.Position{Latitude: 40.6398, Longitude: -73.7789}, facilities.Position{Latitude: 51.4700, Longitude: -0.4543}, 2999, 51.3},
+		{"EGLL to KJFK", facilities.Position{Latitude: 51.4700, Longitude: -0.4543}, facilities.Position{Latitude: 40.6398, Longitude: -73.7789}, 2999, 288.1},
+		{"east across the antimeridian", facilities.Position{Longitude: 179.5}, facilities.Position{Longitude: -179.5}, 60.04, 90},
+		{"west across the antimeridian", facilities.Position{Longitude: -179.5}, facilities.Position{Longitude: 179.5}, 60.04, 270},
+		{"due north", facilities.Position{Latitude: 10}, facilities.Position{Latitude: 11}, 60.04, 0},
+		{"due south", facilities.Position{Latitude: 11}, facilities.Position{Latitude: 10}, 60.04, 180},
+		{"to the pole", facilities.Position{Latitude: 89, Longitude: 45}, facilities.Position{Latitude: 90}, 60.04, 0},
+		{"same position", facilities.Position{Latitude: 47.45, Longitude: -122.31}, facilities.Position{Latitude: 47.45, Longitude: -122.31}, 0, 0},
+	}
+	for _, test := range tests {
+		// The earth is no sphere: the distances along the ellipsoid differ by up to 0.5%.
+		if got := facilities.Distance(test.from, test.to); math.Abs(got-test.distance) > math.Max(0.1, 0.005*test.distance) {
+			t.Errorf("%s: got %.2f NM, want %.2f NM", test.name, got, test.distance)
+		}
+		got := facilities.Bearing(test.from, test.to)
+		diff := math.Mod(math.Abs(got-test.bearing), 360)
+		if got < 0 || got >= 360 || math.Min(diff, 360-diff) > 0.5 {
+			t.Errorf("%s: got a bearing of %.2f°, want %.2f°", test.name, got, test.bearing)
+		}
+	}
+}
+
+// nearestStore returns a Store without connection holding airports east of a position on the equator,
+// one at the position and then one every 60 nautical miles or so.
+func nearestStore() *facilities.Store {
+	store := facilities.NewStore(nil)
+	for i, icao := range []string{"ZERO", "ONE", "TWO", "THREE"} {
+		store.Add(facilities.Facility{Type: simconnect.FacilityListTypeAirport, ICAO: icao, Longitude: float64(i)})
+	}
+	return store
+}
+
+func resultICAOs(results []facilities.Result) []string {
+	icaos := make([]string, 0, len(results))
+	for _, result := range results {
+		icaos = append(icaos, result.ICAO)
+	}
+	return icaos
+}
+
+func TestNearest(t *testing.T) {
+	store := nearestStore()
+	from := facilities.Position{Longitude: 1.2}
+	tests := []struct {
+		n    int
+		want []string
+	}{
+		{-1, []string{"ONE", "TWO", "ZERO", "THREE"}},
+		{0, []string{}},
+		{2, []string{"ONE", "TWO"}},
+		{10, []string{"ONE", "TWO", "ZERO", "THREE"}},
+	}
+	for _, test := range tests {
+		if got := resultICAOs(store.Nearest(simconnect.FacilityListTypeAirport, from, test.n)); !reflect.DeepEqual(got, test.want) {
+			t.Errorf("n = %d: got %v, want %v", test.n, got, test.want)
+		}
+	}
+	results := store.Nearest(simconnect.FacilityListTypeAirport, from, 1)
+	if len(results) != 1 || math.Abs(results[0].Distance-0.2*60.04) > 0.1 || math.Abs(results[0].Bearing-270) > 0.01 {
+		t.Errorf("got %+v, want ONE 12 NM west", results)
+	}
+	if got := store.Nearest(simconnect.FacilityListTypeVOR, from, -1); len(got) != 0 {
+		t.Errorf("VORs: got %v", got)
+	}
+	if got := store.Nearest(simconnect.FacilityListTypeCount, from, -1); got != nil {
+		t.Errorf("invalid list type: got %v", got)
+	}
+}
+
+func TestWithin(t *testing.T) {
+	store := nearestStore()
+	tests := []struct {
+		name   string
+		from   facilities.Position
+		radius float64
+		want   []string
+	}{
+		{"zero radius at a facility", facilities.Position{Longitude: 1}, 0, []string{"ONE"}},
+		{"zero radius between facilities", facilities.Position{Longitude: 1.5}, 0, []string{}},
+		{"negative radius", facilities.Position{Longitude: 1}, -1, []string{}},
+		{"one neighbour", facilities.Position{Longitude: 1}, 61, []string{"ONE", "TWO", "ZERO"}},
+		{"just short of the neighbours", facilities.Position{Longitude: 1}, 59, []string{"ONE"}},
+		{"all", facilities.Position{}, 200, []string{"ZERO", "ONE", "TWO", "THREE"}},
+	}
+	for _, test := range tests {
+		got := resultICAOs(store.Within(simconnect.FacilityListTypeAirport, test.from, test.radius))
+		// Equidistant facilities are ordered by ICAO.
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%s: got %v, want %v", test.name, got, test.want)
+		}
+	}
+}
+
+func TestWithFlags(t *testing.T) {
+	store := facilities.NewStore(nil)
+	vors := []facilities.Facility{
+		{ICAO: "SEA", Flags: simconnect.RecvIDVORListHasNAVSignal | simconnect.RecvIDVORListHasDME, Longitude: 0.1},
+		{ICAO: "PAE", Flags: simconnect.RecvIDVORListHasNAVSignal, Longitude: 0.2},
+		{ICAO: "OLM", Flags: simconnect.RecvIDVORListHasDME, Longitude: 0.3},
+		{ICAO: "ILS", Flags: simconnect.RecvIDVORListHasLocalizer | simconnect.RecvIDVORListHasGlideScope | simconnect.RecvIDVORListHasDME, Longitude: 0.4},
+		{ICAO: "NDB"},
+	}
+	for _, vor := range vors {
+		vor.Type = simconnect.FacilityListTypeVOR
+		store.Add(vor)
+	}
+	tests := []struct {
+		name    string
+		filters []facilities.Filter
+		want    []string
+	}{
+		{"none", nil, []string{"NDB", "SEA", "PAE", "OLM", "ILS"}},
+		{"DME", []facilities.Filter{facilities.WithFlags(simconnect.RecvIDVORListHasDME)}, []string{"SEA", "OLM", "ILS"}},
+		{"NAV and DME", []facilities.Filter{facilities.WithFlags(simconnect.RecvIDVORListHasNAVSignal | simconnect.RecvIDVORListHasDME)}, []string{"SEA"}},
+		{"DME, then glide slope", []facilities.Filter{
+			facilities.WithFlags(simconnect.RecvIDVORListHasDME), facilities.WithFlags(simconnect.RecvIDVORListHasGlideScope),
+		}, []string{"ILS"}},
+		{"no flags", []facilities.Filter{facilities.WithFlags(0)}, []string{"NDB", "SEA", "PAE", "OLM", "ILS"}},
+	}
+	for _, test := range tests {
+		got := resultICAOs(store.Nearest(simconnect.FacilityListTypeVOR, facilities.Position{}, -1, test.filters...))
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%s: got %v, want %v", test.name, got, test.want)
+		}
+	}
+	if got := resultICAOs(store.Within(simconnect.FacilityListTypeVOR, facilities.Position{}, 20, facilities.WithFlags(simconnect.RecvIDVORListHasDME))); !reflect.DeepEqual(got, []string{"SEA", "OLM"}) {
+		t.Errorf("Within 20 NM with DME: got %v, want [SEA OLM]", got)
+	}
+}
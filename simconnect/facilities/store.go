@@ -0,0 +1,253 @@
+package facilities
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/grumpypixel/msfs2020-simconnect-go/simconnect"
+)
+
+// Store holds facilities indexed by type and ICAO. It is safe for concurrent use.
+//
+// A Store is filled by Load, which replaces the facilities of a type with those in the simulator's cache,
+// and by Subscribe, which adds the facilities coming into range and removes those going out of range.
+// Stores without a connection are filled with Add, Remove and Replace.
+type Store struct {
+	// OnInRange and OnOutOfRange, if set, are called with the facilities a subscription has added or removed,
+	// on the goroutine which dispatches messages. Set them before calling Subscribe.
+	OnInRange    func(facilities []Facility)
+	OnOutOfRange func(facilities []Facility)
+
+	simco *simconnect.SimConnect
+
+	lock          sync.RWMutex
+	byType        [simconnect.FacilityListTypeCount]map[string]Facility // by normalized ICAO
+	subscriptions [simconnect.FacilityListTypeCount]*subscription
+}
+
+// subscription is a SimConnect_SubscribeToFacilities_EX1 subscription of a list type,
+// or a SimConnect_SubscribeToFacilities one where the transport lacks the former.
+type subscription struct {
+	inRange    DWord
+	outOfRange DWord
+	assembler  Assembler // only used on the goroutine which dispatches messages
+}
+
+// NewStore returns an empty Store which loads and subscribes through simco, which may be nil.
+func NewStore(simco *simconnect.SimConnect) *Store {
+	store := &Store{simco: simco}
+	for i := range store.byType {
+		store.byType[i] = make(map[string]Facility)
+	}
+	return store
+}
+
+// Load replaces the facilities of listType with those in the simulator's facilities cache.
+// It blocks like SimConnect.RequestFacilities.
+func (store *Store) Load(ctx context.Context, listType DWord) error {
+	if err := checkListType(listType); err != nil {
+		return err
+	}
+	if store.simco == nil {
+		return ErrNoConnection
+	}
+	facilities, err := store.simco.RequestFacilities(ctx, listType)
+	if err != nil {
+		return err
+	}
+	store.Replace(listType, FromFacilities(facilities))
+	return nil
+}
+
+// Subscribe keeps the facilities of listType up to date: the simulator first sends all facilities in range,
+// then those coming into range, which are added, and those going out of range, which are removed.
+// Messages must be dispatched by Run, RunSession or SimMate.Run. Subscribing twice to a type does nothing.
+// Transports without SimConnect_SubscribeToFacilities_EX1 only report the facilities coming into range.
+func (store *Store) Subscribe(listType DWord) error {
+	if err := checkListType(listType); err != nil {
+		return err
+	}
+	if store.simco == nil {
+		return ErrNoConnection
+	}
+	store.lock.Lock()
+	if store.subscriptions[listType] != nil {
+		store.lock.Unlock()
+		return nil
+	}
+	ids := store.simco.IDs()
+	sub := &subscription{inRange: ids.Request.New(), outOfRange: ids.Request.New()}
+	store.subscriptions[listType] = sub
+	store.lock.Unlock()
+
+	store.simco.HandleRequest(sub.inRange, func(msg simconnect.Message) {
+		if list, ok := sub.assembler.Add(msg); ok {
+			store.Add(list.Facilities...)
+			if store.OnInRange != nil && len(list.Facilities) > 0 {
+				store.OnInRange(list.Facilities)
+			}
+		}
+	})
+	store.simco.HandleRequest(sub.outOfRange, func(msg simconnect.Message) {
+		if list, ok := sub.assembler.Add(msg); ok {
+			store.Remove(list.Facilities...)
+			if store.OnOutOfRange != nil && len(list.Facilities) > 0 {
+				store.OnOutOfRange(list.Facilities)
+			}
+		}
+	})
+	err := store.simco.SubscribeToFacilitiesEx1(listType, sub.inRange, sub.outOfRange)
+	if errors.Is(err, simconnect.ErrUnsupported) {
+		// The network transport has no SimConnect_SubscribeToFacilities_EX1.
+		err = store.simco.SubscribeToFacilities(listType, sub.inRange)
+	}
+	if err != nil {
+		store.drop(listType, sub)
+		return err
+	}
+	return nil
+}
+
+// Unsubscribe stops the subscription of listType. The facilities stay in the Store.
+func (store *Store) Unsubscribe(listType DWord) error {
+	if err := checkListType(listType); err != nil {
+		return err
+	}
+	store.lock.Lock()
+	sub := store.subscriptions[listType]
+	store.lock.Unlock()
+	if sub == nil {
+		return nil
+	}
+	err := store.simco.UnsubscribeToFacilities(listType)
+	store.drop(listType, sub)
+	return err
+}
+
+// Close stops all subscriptions.
+func (store *Store) Close() error {
+	var err error
+	for listType := range store.subscriptions {
+		if e := store.Unsubscribe(DWord(listType)); e != nil && err == nil {
+			err = e
+		}
+	}
+	return err
+}
+
+func (store *Store) drop(listType DWord, sub *subscription) {
+	store.simco.HandleRequest(sub.inRange, nil)
+	store.simco.HandleRequest(sub.outOfRange, nil)
+	store.simco.IDs().Request.Release(sub.inRange)
+	store.simco.IDs().Request.Release(sub.outOfRange)
+	store.lock.Lock()
+	if store.subscriptions[listType] == sub {
+		store.subscriptions[listType] = nil
+	}
+	store.lock.Unlock()
+}
+
+// Get returns the facility of listType with the given ICAO. ICAOs are case-insensitive.
+func (store *Store) Get(listType DWord, icao string) (Facility, bool) {
+	if checkListType(listType) != nil {
+		return Facility{}, false
+	}
+	store.lock.RLock()
+	defer store.lock.RUnlock()
+	facility, ok := store.byType[listType][normalizeICAO(icao)]
+	return facility, ok
+}
+
+// Find returns the facilities of all types with the given ICAO, e.g. a VOR and the waypoint of the same name.
+func (store *Store) Find(icao string) []Facility {
+	key := normalizeICAO(icao)
+	store.lock.RLock()
+	defer store.lock.RUnlock()
+	var found []Facility
+	for _, facilities := range store.byType {
+		if facility, ok := facilities[key]; ok {
+			found = append(found, facility)
+		}
+	}
+	return found
+}
+
+// All returns the facilities of listType, sorted by ICAO.
+func (store *Store) All(listType DWord) []Facility {
+	if checkListType(listType) != nil {
+		return nil
+	}
+	store.lock.RLock()
+	all := make([]Facility, 0, len(store.byType[listType]))
+	for _, facility := range store.byType[listType] {
+		all = append(all, facility)
+	}
+	store.lock.RUnlock()
+	sort.Slice(all, func(i, j int) bool {
+		return all[i].ICAO < all[j].ICAO
+	})
+	return all
+}
+
+// Len returns the number of facilities of listType.
+func (store *Store) Len(listType DWord) int {
+	if checkListType(listType) != nil {
+		return 0
+	}
+	store.lock.RLock()
+	defer store.lock.RUnlock()
+	return len(store.byType[listType])
+}
+
+// Add adds facilities, replacing those of the same type and ICAO. Facilities of an invalid type are ignored.
+func (store *Store) Add(facilities ...Facility) {
+	store.lock.Lock()
+	defer store.lock.Unlock()
+	for _, facility := range facilities {
+		if checkListType(facility.Type) == nil {
+			store.byType[facility.Type][normalizeICAO(facility.ICAO)] = facility
+		}
+	}
+}
+
+// Remove removes the facilities of the same type and ICAO.
+func (store *Store) Remove(facilities ...Facility) {
+	store.lock.Lock()
+	defer store.lock.Unlock()
+	for _, facility := range facilities {
+		if checkListType(facility.Type) == nil {
+			delete(store.byType[facility.Type], normalizeICAO(facility.ICAO))
+		}
+	}
+}
+
+// Replace replaces the facilities of listType. Facilities of other types are ignored.
+func (store *Store) Replace(listType DWord, facilities []Facility) {
+	if checkListType(listType) != nil {
+		return
+	}
+	byICAO := make(map[string]Facility, len(facilities))
+	for _, facility := range facilities {
+		if facility.Type == listType {
+			byICAO[normalizeICAO(facility.ICAO)] = facility
+		}
+	}
+	store.lock.Lock()
+	defer store.lock.Unlock()
+	store.byType[listType] = byICAO
+}
+
+func checkListType(listType DWord) error {
+	if listType >= simconnect.FacilityListTypeCount {
+		return fmt.Errorf("%w: %d", ErrListType, listType)
+	}
+	return nil
+}
+
+func normalizeICAO(icao string) string {
+	return strings.ToUpper(strings.TrimSpace(icao))
+}
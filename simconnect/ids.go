@@ -0,0 +1,111 @@
+package simconnect
+
+import (
+	"sync"
+)
+
+// IDs are the ID spaces of a connection. Each space is allocated on its own, starting at 1,
+// so several connections in one process (two simulators, or a simulator and a fake) do not get in each other's way.
+type IDs struct {
+	Define            IDAllocator // SIMCONNECT_DATA_DEFINITION_ID
+	Request           IDAllocator // SIMCONNECT_DATA_REQUEST_ID
+	Event             IDAllocator // SIMCONNECT_CLIENT_EVENT_ID, menu items are identified by client events as well
+	NotificationGroup IDAllocator // SIMCONNECT_NOTIFICATION_GROUP_ID
+	InputGroup        IDAllocator // SIMCONNECT_INPUT_GROUP_ID
+	ClientData        IDAllocator // SIMCONNECT_CLIENT_DATA_ID
+	ClientDataDefine  IDAllocator // SIMCONNECT_CLIENT_DATA_DEFINITION_ID
+}
+
+// IDs returns the ID spaces of the connection. They are kept when the connection is opened again,
+// since RunSession replays the calls which use them.
+func (simco *SimConnect) IDs() *IDs {
+	return &simco.ids
+}
+
+// NewMenuEventID returns a client event ID for MenuAddItem and MenuAddSubItem.
+func (ids *IDs) NewMenuEventID() DWord {
+	return ids.Event.New()
+}
+
+// IDAllocator hands out IDs. Released IDs are handed out again, lowest first.
+// The zero value is ready to use and safe for concurrent use.
+type IDAllocator struct {
+	lock sync.Mutex
+	last DWord          // the highest ID New has handed out; reserved IDs above it are skipped when New gets there
+	used map[DWord]bool // IDs handed out or reserved
+	free []DWord        // released IDs up to last
+}
+
+// New returns an unused ID.
+func (a *IDAllocator) New() DWord {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	if len(a.free) > 0 {
+		lowest := 0
+		for i, id := range a.free {
+			if id < a.free[lowest] {
+				lowest = i
+			}
+		}
+		id := a.free[lowest]
+		a.free[lowest] = a.free[len(a.free)-1]
+		a.free = a.free[:len(a.free)-1]
+		a.use(id)
+		return id
+	}
+	for {
+		a.last++
+		if !a.used[a.last] {
+			a.use(a.last)
+			return a.last
+		}
+	}
+}
+
+// Reserve marks id as used, e.g. because it is hard-coded. It returns false if id is in use already.
+func (a *IDAllocator) Reserve(id DWord) bool {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	if a.used[id] {
+		return false
+	}
+	for i, free := range a.free {
+		if free == id {
+			a.free = append(a.free[:i], a.free[i+1:]...)
+			break
+		}
+	}
+	a.use(id)
+	return true
+}
+
+// Release returns id to the allocator, so that New can hand it out again.
+// Releasing an ID which is not in use has no effect.
+func (a *IDAllocator) Release(id DWord) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	if !a.used[id] {
+		return
+	}
+	delete(a.used, id)
+	if id <= a.last {
+		a.free = append(a.free, id)
+	}
+}
+
+// InUse reports whether id has been handed out or reserved and not released since.
+func (a *IDAllocator) InUse(id DWord) bool {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	return a.used[id]
+}
+
+func (a *IDAllocator) use(id DWord) {
+	if a.used == nil {
+		a.used = make(map[DWord]bool)
+	}
+	a.used[id] = true
+}
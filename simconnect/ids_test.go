@@ -0,0 +1,72 @@
+package simconnect_test
+
+import (
+	"testing"
+
+	"github.com/grumpypixel/msfs2020-simconnect-go/simconnect"
+)
+
+// newIDs calls New n times and returns the IDs.
+func newIDs(a *simconnect.IDAllocator, n int) []simconnect.DWord {
+	ids := make([]simconnect.DWord, n)
+	for i := range ids {
+		ids[i] = a.New()
+	}
+	return ids
+}
+
+func TestIDAllocatorReusesLowestFirst(t *testing.T) {
+	var a simconnect.IDAllocator
+	if ids := newIDs(&a, 5); ids[0] != 1 || ids[4] != 5 {
+		t.Fatalf("got %v, want 1 to 5", ids)
+	}
+	a.Release(4)
+	a.Release(2)
+	a.Release(5)
+	a.Release(2)  // twice
+	a.Release(42) // never handed out
+	if ids := newIDs(&a, 4); ids[0] != 2 || ids[1] != 4 || ids[2] != 5 || ids[3] != 6 {
+		t.Errorf("got %v, want 2, 4, 5, 6", ids)
+	}
+	if !a.InUse(2) || a.InUse(7) || a.InUse(42) {
+		t.Error("InUse does not match the IDs handed out")
+	}
+}
+
+func TestIDAllocatorReserve(t *testing.T) {
+	var a simconnect.IDAllocator
+	newIDs(&a, 3)
+
+	// Above the IDs handed out, New skips the reserved ones.
+	if !a.Reserve(5) || !a.Reserve(6) || a.Reserve(5) {
+		t.Fatal("Reserve(5), Reserve(6) and Reserve(5) again: want true, true, false")
+	}
+	if ids := newIDs(&a, 2); ids[0] != 4 || ids[1] != 7 {
+		t.Errorf("got %v, want 4 and 7", ids)
+	}
+	// Released, a reserved ID above the IDs handed out so far is handed out in turn.
+	if !a.Reserve(10) {
+		t.Fatal("Reserve(10) failed")
+	}
+	a.Release(10)
+	if ids := newIDs(&a, 3); ids[0] != 8 || ids[1] != 9 || ids[2] != 10 {
+		t.Errorf("got %v, want 8, 9 and 10", ids)
+	}
+
+	// Below them, an ID in use cannot be reserved and a released one is not handed out again.
+	if a.Reserve(2) {
+		t.Error("Reserve(2) succeeded while 2 is in use")
+	}
+	a.Release(2)
+	a.Release(3)
+	if !a.Reserve(2) {
+		t.Error("Reserve(2) failed after releasing 2")
+	}
+	if ids := newIDs(&a, 2); ids[0] != 3 || ids[1] != 11 {
+		t.Errorf("got %v, want 3 and 11", ids)
+	}
+	a.Release(2)
+	if id := a.New(); id != 2 {
+		t.Errorf("got %d, want the reserved 2 once released", id)
+	}
+}
@@ -0,0 +1,152 @@
+package simconnect
+
+import (
+	"sync"
+)
+
+// journal records the calls which set up a connection (data definitions, client event mappings, notification and input
+// groups, system event subscriptions and periodic requests), so that RunSession can replay them after reconnecting.
+// Calls which undo earlier ones remove them from the journal instead of being recorded.
+type journal struct {
+	lock    sync.Mutex
+	entries []journalEntry
+}
+
+type journalEntry struct {
+	procName string
+	args     []interface{}
+}
+
+// is reports whether the entry is a call of procName whose arguments at the given indices equal values.
+func (e journalEntry) is(procName string, indices []int, values ...interface{}) bool {
+	if e.procName != procName {
+		return false
+	}
+	for i, index := range indices {
+		if index >= len(e.args) || e.args[index] != values[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func (j *journal) record(procName string, args []interface{}) {
+	j.lock.Lock()
+	defer j.lock.Unlock()
+
+	arg := func(i int) interface{} {
+		if i < len(args) {
+			return args[i]
+		}
+		return nil
+	}
+	switch procName {
+	case scAddToDataDefinition, scAddToClientDataDefinition, scCreateClientData,
+		scAddClientEventToNotificationGroup, scMapInputEventToClientEvent:
+
+	case scMapClientEventToSimEvent, scSubscribeToSystemEvent:
+		j.remove(procName, []int{0}, arg(0))
+
+	case scMapClientDataNameToID:
+		j.remove(procName, []int{1}, arg(1))
+
+	case scClearDataDefinition:
+		j.remove(scAddToDataDefinition, []int{0}, arg(0))
+		j.remove(scRequestDataOnSimObject, []int{1}, arg(0))
+		return
+
+	case scClearClientDataDefinition:
+		j.remove(scAddToClientDataDefinition, []int{0}, arg(0))
+		j.remove(scRequestClientData, []int{2}, arg(0))
+		return
+
+	case scUnsubscribeFromSystemEvent:
+		j.remove(scSubscribeToSystemEvent, []int{0}, arg(0))
+		j.remove(scSetSystemEventState, []int{0}, arg(0))
+		return
+
+	case scSetSystemEventState, scSetNotificationGroupPriority, scSetInputGroupPriority, scSetInputGroupState:
+		j.remove(procName, []int{0}, arg(0))
+
+	case scRemoveClientEvent:
+		j.remove(scAddClientEventToNotificationGroup, []int{0, 1}, arg(0), arg(1))
+		return
+
+	case scClearNotificationGroup:
+		j.remove(scAddClientEventToNotificationGroup, []int{0}, arg(0))
+		j.remove(scSetNotificationGroupPriority, []int{0}, arg(0))
+		return
+
+	case scRemoveInputEvent:
+		j.remove(scMapInputEventToClientEvent, []int{0, 1}, arg(0), arg(1))
+		return
+
+	case scClearInputGroup:
+		j.remove(scMapInputEventToClientEvent, []int{0}, arg(0))
+		j.remove(scSetInputGroupPriority, []int{0}, arg(0))
+		j.remove(scSetInputGroupState, []int{0}, arg(0))
+		return
+
+	case scRequestDataOnSimObject:
+		// A new request with the same ID replaces the old one. One-off requests are not replayed.
+		j.remove(procName, []int{0}, arg(0))
+		if period := arg(3); period == PeriodNever || period == PeriodOnce {
+			return
+		}
+
+	case scRequestClientData:
+		j.remove(procName, []int{1}, arg(1))
+		if period := arg(3); period == ClientDataPeriodNever || period == ClientDataPeriodOnce {
+			return
+		}
+
+	case scSubscribeToFacilities, scSubscribeToFacilitiesEx1:
+		j.remove(scSubscribeToFacilities, []int{0}, arg(0))
+		j.remove(scSubscribeToFacilitiesEx1, []int{0}, arg(0))
+
+	case scUnsubscribeToFacilities:
+		j.remove(scSubscribeToFacilities, []int{0}, arg(0))
+		j.remove(scSubscribeToFacilitiesEx1, []int{0}, arg(0))
+		return
+
+	default:
+		return
+	}
+	j.entries = append(j.entries, journalEntry{procName, append([]interface{}(nil), args...)})
+}
+
+func (j *journal) remove(procName string, indices []int, values ...interface{}) {
+	entries := j.entries[:0]
+	for _, e := range j.entries {
+		if !e.is(procName, indices, values...) {
+			entries = append(entries, e)
+		}
+	}
+	j.entries = entries
+}
+
+// replay makes the recorded calls again with call, in the order in which they were made.
+func (j *journal) replay(call func(procName string, args []interface{}) error) error {
+	j.lock.Lock()
+	entries := append([]journalEntry(nil), j.entries...)
+	j.lock.Unlock()
+
+	for _, e := range entries {
+		if err := call(e.procName, e.args); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (j *journal) len() int {
+	j.lock.Lock()
+	defer j.lock.Unlock()
+	return len(j.entries)
+}
+
+func (j *journal) reset() {
+	j.lock.Lock()
+	defer j.lock.Unlock()
+	j.entries = nil
+}
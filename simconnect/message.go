@@ -0,0 +1,339 @@
+package simconnect
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"unsafe"
+)
+
+var (
+	ErrShortMessage   = errors.New("simconnect: message too short")
+	ErrUnknownMessage = errors.New("simconnect: unknown message")
+)
+
+// Message is a message received from SimConnect, decoded into a Go value.
+// Its dynamic type depends on the receive ID: a pointer to one of the Recv structs in defs.go for
+// messages of fixed size, or a pointer to one of the Message structs below for messages which carry
+// variable-length data. Messages are copies and stay valid after the next call to GetNextDispatch.
+type Message interface {
+	Header() *Recv
+}
+
+func (recv *Recv) Header() *Recv {
+	return recv
+}
+
+// SimObjectDataMessage is returned for SIMCONNECT_RECV_ID_SIMOBJECT_DATA.
+type SimObjectDataMessage struct {
+	RecvSimObjectData
+	Data []byte // dwDefineCount data items, laid out as given by the data definition
+}
+
+// SimObjectDataByTypeMessage is returned for SIMCONNECT_RECV_ID_SIMOBJECT_DATA_BYTYPE.
+type SimObjectDataByTypeMessage struct {
+	RecvSimObjectDataByType
+	Data []byte // dwDefineCount data items, laid out as given by the data definition
+}
+
+// ClientDataMessage is returned for SIMCONNECT_RECV_ID_CLIENT_DATA.
+type ClientDataMessage struct {
+	RecvClientData
+	Data []byte // laid out as given by the client data definition
+}
+
+// WeatherObservationMessage is returned for SIMCONNECT_RECV_ID_WEATHER_OBSERVATION.
+type WeatherObservationMessage struct {
+	RecvWeatherObservation
+	Metar string
+}
+
+// CloudStateMessage is returned for SIMCONNECT_RECV_ID_CLOUD_STATE.
+type CloudStateMessage struct {
+	RecvCloudState
+	Data []byte // dwArraySize bytes
+}
+
+// CustomActionMessage is returned for SIMCONNECT_RECV_ID_CUSTOM_ACTION.
+type CustomActionMessage struct {
+	RecvCustomAction
+	Payload string
+}
+
+// AirportListMessage is returned for SIMCONNECT_RECV_ID_AIRPORT_LIST.
+type AirportListMessage struct {
+	RecvAirportList
+	Airports []DataFacilityAirport
+}
+
+// WaypointListMessage is returned for SIMCONNECT_RECV_ID_WAYPOINT_LIST.
+type WaypointListMessage struct {
+	RecvWaypointList
+	Waypoints []DataFacilityWaypoint
+}
+
+// NDBListMessage is returned for SIMCONNECT_RECV_ID_NDB_LIST.
+type NDBListMessage struct {
+	RecvNDBList
+	NDBs []DataFacilityNDB
+}
+
+// VORListMessage is returned for SIMCONNECT_RECV_ID_VOR_LIST.
+type VORListMessage struct {
+	RecvVORList
+	VORs []DataFacilityVOR
+}
+
+// PickMessage is returned for SIMCONNECT_RECV_ID_PICK. The SDK does not document its layout,
+// so the data following the header is passed on as is.
+type PickMessage struct {
+	Recv
+	Data []byte
+}
+
+// Decode decodes the message returned by GetNextDispatch. size is the pcbData reported along with ppData;
+// no byte beyond it is read, and a message which is shorter than its receive ID requires fails with ErrShortMessage.
+func Decode(ppData unsafe.Pointer, size DWord) (Message, error) {
+	if ppData == nil {
+		return nil, fmt.Errorf("%w: no data", ErrShortMessage)
+	}
+	return DecodeBytes(unsafe.Slice((*byte)(ppData), size))
+}
+
+// DecodeBytes decodes a message which has already been copied out of the SimConnect buffer.
+func DecodeBytes(data []byte) (Message, error) {
+	recv := &Recv{}
+	if _, err := decodeStruct(data, recv); err != nil {
+		return nil, err
+	}
+	if recv.Size < DWord(binary.Size(recv)) || int(recv.Size) > len(data) {
+		return nil, fmt.Errorf("%w: message %d reports %d bytes, got %d", ErrShortMessage, recv.ID, recv.Size, len(data))
+	}
+	data = data[:recv.Size]
+
+	switch recv.ID {
+	case RecvIDNull:
+		return recv, nil
+
+	case RecvIDException:
+		return decodeFixed(data, &RecvException{})
+
+	case RecvIDOpen:
+		return decodeFixed(data, &RecvOpen{})
+
+	case RecvIDQuit:
+		return decodeFixed(data, &RecvQuit{})
+
+	case RecvIDEvent:
+		return decodeFixed(data, &RecvEvent{})
+
+	case RecvIDEventObjectAddRemove:
+		return decodeFixed(data, &RecvEventObjectAddRemove{})
+
+	case RecvIDEventFilename:
+		return decodeFixed(data, &RecvEventFilename{})
+
+	case RecvIDEventFrame:
+		return decodeFixed(data, &RecvEventFrame{})
+
+	case RecvIDSimobjectData:
+		msg := &SimObjectDataMessage{}
+		rest, err := decodeStruct(data, &msg.RecvSimObjectData)
+		if err != nil {
+			return nil, err
+		}
+		msg.Data = rest
+		return msg, nil
+
+	case RecvIDSimObjectDataByType:
+		msg := &SimObjectDataByTypeMessage{}
+		rest, err := decodeStruct(data, &msg.RecvSimObjectDataByType)
+		if err != nil {
+			return nil, err
+		}
+		msg.Data = rest
+		return msg, nil
+
+	case RecvIDWeatherObservation:
+		msg := &WeatherObservationMessage{}
+		rest, err := decodeStruct(data, &msg.RecvWeatherObservation)
+		if err != nil {
+			return nil, err
+		}
+		msg.Metar = stringFromBytes(rest)
+		return msg, nil
+
+	case RecvIDCloudState:
+		msg := &CloudStateMessage{}
+		rest, err := decodeStruct(data, &msg.RecvCloudState)
+		if err != nil {
+			return nil, err
+		}
+		if int(msg.ArraySize) > len(rest) {
+			return nil, fmt.Errorf("%w: cloud state of %d bytes, got %d", ErrShortMessage, msg.ArraySize, len(rest))
+		}
+		msg.Data = rest[:msg.ArraySize]
+		return msg, nil
+
+	case RecvIDAssignedObjectID:
+		return decodeFixed(data, &RecvAssignedObjectID{})
+
+	case RecvIDReservedKey:
+		return decodeFixed(data, &RecvReservedKey{})
+
+	case RecvIDCustomAction:
+		msg := &CustomActionMessage{}
+		rest, err := decodeStruct(data, &msg.RecvCustomAction)
+		if err != nil {
+			return nil, err
+		}
+		msg.Payload = stringFromBytes(rest)
+		return msg, nil
+
+	case RecvIDSystemState:
+		return decodeFixed(data, &RecvSystemState{})
+
+	case RecvIDClientData:
+		msg := &ClientDataMessage{}
+		rest, err := decodeStruct(data, &msg.RecvClientData)
+		if err != nil {
+			return nil, err
+		}
+		msg.Data = rest
+		return msg, nil
+
+	case RecvIDEventWeatherMode:
+		return decodeFixed(data, &RecvEventWeatherMode{})
+
+	case RecvIDAirportList:
+		msg := &AirportListMessage{}
+		rest, err := decodeStruct(data, &msg.RecvAirportList)
+		if err != nil {
+			return nil, err
+		}
+		if err := checkArraySize(rest, msg.ArraySize, DataFacilityAirport{}); err != nil {
+			return nil, err
+		}
+		msg.Airports = make([]DataFacilityAirport, msg.ArraySize)
+		return msg, decodeArray(rest, msg.Airports)
+
+	case RecvIDVORList:
+		msg := &VORListMessage{}
+		rest, err := decodeStruct(data, &msg.RecvVORList)
+		if err != nil {
+			return nil, err
+		}
+		if err := checkArraySize(rest, msg.ArraySize, DataFacilityVOR{}); err != nil {
+			return nil, err
+		}
+		msg.VORs = make([]DataFacilityVOR, msg.ArraySize)
+		return msg, decodeArray(rest, msg.VORs)
+
+	case RecvIDNDBList:
+		msg := &NDBListMessage{}
+		rest, err := decodeStruct(data, &msg.RecvNDBList)
+		if err != nil {
+			return nil, err
+		}
+		if err := checkArraySize(rest, msg.ArraySize, DataFacilityNDB{}); err != nil {
+			return nil, err
+		}
+		msg.NDBs = make([]DataFacilityNDB, msg.ArraySize)
+		return msg, decodeArray(rest, msg.NDBs)
+
+	case RecvIDWaypointList:
+		msg := &WaypointListMessage{}
+		rest, err := decodeStruct(data, &msg.RecvWaypointList)
+		if err != nil {
+			return nil, err
+		}
+		if err := checkArraySize(rest, msg.ArraySize, DataFacilityWaypoint{}); err != nil {
+			return nil, err
+		}
+		msg.Waypoints = make([]DataFacilityWaypoint, msg.ArraySize)
+		return msg, decodeArray(rest, msg.Waypoints)
+
+	case RecvIDEventMultiplayerServerStarted:
+		return decodeFixed(data, &RecvEventMultiplayerServerStarted{})
+
+	case RecvIDEventMultiplayerClientStarted:
+		return decodeFixed(data, &RecvEventMultiplayerClientStarted{})
+
+	case RecvIDEventMultiplayerSessionEnded:
+		return decodeFixed(data, &RecvEventMultiplayerSessionEnded{})
+
+	case RecvIDEventRaceEnd:
+		return decodeFixed(data, &RecvEventRaceEnd{})
+
+	case RecvIDEventRaceLap:
+		return decodeFixed(data, &RecvEventRaceLap{})
+
+	case RecvIDPick:
+		msg := &PickMessage{}
+		rest, err := decodeStruct(data, &msg.Recv)
+		if err != nil {
+			return nil, err
+		}
+		msg.Data = rest
+		return msg, nil
+	}
+	return nil, fmt.Errorf("%w: receive ID %d", ErrUnknownMessage, recv.ID)
+}
+
+// GetNextMessage returns the next pending message, decoded. It returns nil and no error if no message is pending.
+func (simco *SimConnect) GetNextMessage() (Message, error) {
+	if simco.transport == nil {
+		return nil, ErrNoTransport
+	}
+	ppData, size, err := simco.transport.GetNextDispatch()
+	if err != nil || ppData == nil {
+		return nil, err
+	}
+	return Decode(ppData, size)
+}
+
+func decodeFixed(data []byte, msg Message) (Message, error) {
+	if _, err := decodeStruct(data, msg); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// decodeStruct reads the packed struct v from the start of data and returns a copy of the bytes which follow it.
+func decodeStruct(data []byte, v interface{}) ([]byte, error) {
+	size := binary.Size(v)
+	if size > len(data) {
+		return nil, fmt.Errorf("%w: %T needs %d bytes, got %d", ErrShortMessage, v, size, len(data))
+	}
+	if err := binary.Read(bytes.NewReader(data[:size]), binary.LittleEndian, v); err != nil {
+		return nil, err
+	}
+	return append([]byte(nil), data[size:]...), nil
+}
+
+// checkArraySize verifies that data holds count packed items before the array is allocated.
+func checkArraySize(data []byte, count DWord, item interface{}) error {
+	size := binary.Size(item)
+	if uint64(count)*uint64(size) > uint64(len(data)) {
+		return fmt.Errorf("%w: %d x %T needs %d bytes, got %d", ErrShortMessage, count, item, uint64(count)*uint64(size), len(data))
+	}
+	return nil
+}
+
+// decodeArray reads len(items) packed structs from data.
+func decodeArray(data []byte, items interface{}) error {
+	size := binary.Size(items)
+	if size > len(data) {
+		return fmt.Errorf("%w: %T needs %d bytes, got %d", ErrShortMessage, items, size, len(data))
+	}
+	return binary.Read(bytes.NewReader(data[:size]), binary.LittleEndian, items)
+}
+
+// stringFromBytes returns the null-terminated string at the start of data.
+func stringFromBytes(data []byte) string {
+	if i := bytes.IndexByte(data, 0); i >= 0 {
+		data = data[:i]
+	}
+	return string(data)
+}
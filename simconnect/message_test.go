@@ -0,0 +1,201 @@
+package simconnect_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"reflect"
+	"testing"
+	"unsafe"
+
+	"github.com/grumpypixel/msfs2020-simconnect-go/simconnect"
+)
+
+// packet lays out the given structs and bytes back to back and patches the header with the size and receive ID.
+func packet(id simconnect.DWord, parts ...interface{}) []byte {
+	var buf bytes.Buffer
+	for _, part := range parts {
+		if b, ok := part.([]byte); ok {
+			buf.Write(b)
+			continue
+		}
+		binary.Write(&buf, binary.LittleEndian, part)
+	}
+	data := buf.Bytes()
+	binary.LittleEndian.PutUint32(data[0:], uint32(len(data)))
+	binary.LittleEndian.PutUint32(data[4:], 4)
+	binary.LittleEndian.PutUint32(data[8:], uint32(id))
+	return data
+}
+
+// resize cuts data to size bytes and patches the header to match, so only the payload is short.
+func resize(data []byte, size int) []byte {
+	data = append([]byte(nil), data[:size]...)
+	if size >= 4 {
+		binary.LittleEndian.PutUint32(data[0:], uint32(size))
+	}
+	return data
+}
+
+func decode(data []byte) (simconnect.Message, error) {
+	return simconnect.Decode(unsafe.Pointer(&data[0]), simconnect.DWord(len(data)))
+}
+
+func TestDecode(t *testing.T) {
+	airport := simconnect.DataFacilityAirport{Latitude: 50.03, Longitude: 8.57, Altitude: 111}
+	copy(airport.Icao[:], "EDDF")
+	waypoint := simconnect.DataFacilityWaypoint{DataFacilityAirport: airport, MagVar: 2}
+	ndb := simconnect.DataFacilityNDB{DataFacilityWaypoint: waypoint, Frequency: 344000}
+	vor := simconnect.DataFacilityVOR{DataFacilityNDB: ndb, Flags: simconnect.RecvIDVORListHasDME}
+	list := simconnect.RecvFacilitiesList{RequestID: 7, ArraySize: 1, OutOf: 1}
+
+	// size is the size of the SIMCONNECT_RECV struct as packed in SimConnect.h, payload not included.
+	tests := []struct {
+		name  string
+		id    simconnect.DWord
+		size  int
+		parts []interface{}
+		want  simconnect.Message
+	}{
+		{"null", simconnect.RecvIDNull, 12, []interface{}{simconnect.Recv{}}, &simconnect.Recv{}},
+		{"exception", simconnect.RecvIDException, 24,
+			[]interface{}{simconnect.RecvException{Exception: simconnect.ExceptionNameUnrecognized, SendID: 3, Index: 1}},
+			&simconnect.RecvException{Exception: simconnect.ExceptionNameUnrecognized, SendID: 3, Index: 1}},
+		{"open", simconnect.RecvIDOpen, 308,
+			[]interface{}{simconnect.RecvOpen{ApplicationVersionMajor: 11, Reserved2: 9}},
+			&simconnect.RecvOpen{ApplicationVersionMajor: 11, Reserved2: 9}},
+		{"quit", simconnect.RecvIDQuit, 12, []interface{}{simconnect.RecvQuit{}}, &simconnect.RecvQuit{}},
+		{"event", simconnect.RecvIDEvent, 24,
+			[]interface{}{simconnect.RecvEvent{GroupID: 1, EventID: 2, Data: 3}},
+			&simconnect.RecvEvent{GroupID: 1, EventID: 2, Data: 3}},
+		{"object add/remove", simconnect.RecvIDEventObjectAddRemove, 28,
+			[]interface{}{simconnect.RecvEventObjectAddRemove{ObjType: simconnect.SimObjectTypeHelicopter}},
+			&simconnect.RecvEventObjectAddRemove{ObjType: simconnect.SimObjectTypeHelicopter}},
+		{"filename", simconnect.RecvIDEventFilename, 288,
+			[]interface{}{simconnect.RecvEventFilename{Flags: 5}},
+			&simconnect.RecvEventFilename{Flags: 5}},
+		{"frame", simconnect.RecvIDEventFrame, 32,
+			[]interface{}{simconnect.RecvEventFrame{FrameRate: 30, SimSpeed: 1}},
+			&simconnect.RecvEventFrame{FrameRate: 30, SimSpeed: 1}},
+		{"simobject data", simconnect.RecvIDSimobjectData, 40,
+			[]interface{}{simconnect.RecvSimObjectData{RequestID: 4, DefineCount: 1}, []byte{1, 2, 3, 4}},
+			&simconnect.SimObjectDataMessage{RecvSimObjectData: simconnect.RecvSimObjectData{RequestID: 4, DefineCount: 1}, Data: []byte{1, 2, 3, 4}}},
+		{"simobject data by type", simconnect.RecvIDSimObjectDataByType, 40,
+			[]interface{}{simconnect.RecvSimObjectData{RequestID: 4, OutOf: 2}, []byte{5, 6}},
+			&simconnect.SimObjectDataByTypeMessage{RecvSimObjectDataByType: simconnect.RecvSimObjectDataByType{RecvSimObjectData: simconnect.RecvSimObjectData{RequestID: 4, OutOf: 2}}, Data: []byte{5, 6}}},
+		{"weather observation", simconnect.RecvIDWeatherObservation, 16,
+			[]interface{}{simconnect.RecvWeatherObservation{RequestID: 5}, []byte("EDDF 121250Z 24008KT CAVOK\x00")},
+			&simconnect.WeatherObservationMessage{RecvWeatherObservation: simconnect.RecvWeatherObservation{RequestID: 5}, Metar: "EDDF 121250Z 24008KT CAVOK"}},
+		{"cloud state", simconnect.RecvIDCloudState, 20,
+			[]interface{}{simconnect.RecvCloudState{RequestID: 6, ArraySize: 3}, []byte{7, 8, 9}},
+			&simconnect.CloudStateMessage{RecvCloudState: simconnect.RecvCloudState{RequestID: 6, ArraySize: 3}, Data: []byte{7, 8, 9}}},
+		{"assigned object ID", simconnect.RecvIDAssignedObjectID, 20,
+			[]interface{}{simconnect.RecvAssignedObjectID{RequestID: 1, ObjectID: 1000}},
+			&simconnect.RecvAssignedObjectID{RequestID: 1, ObjectID: 1000}},
+		{"reserved key", simconnect.RecvIDReservedKey, 92,
+			[]interface{}{simconnect.RecvReservedKey{ReservedKey: [50]byte{'Y'}}},
+			&simconnect.RecvReservedKey{ReservedKey: [50]byte{'Y'}}},
+		{"custom action", simconnect.RecvIDCustomAction, 44,
+			[]interface{}{simconnect.RecvCustomAction{InstanceID: simconnect.GUID{Data1: 1, Data4: [8]byte{8}}, WaitForCompletion: 1}, []byte("go\x00")},
+			&simconnect.CustomActionMessage{RecvCustomAction: simconnect.RecvCustomAction{InstanceID: simconnect.GUID{Data1: 1, Data4: [8]byte{8}}, WaitForCompletion: 1}, Payload: "go"}},
+		{"system state", simconnect.RecvIDSystemState, 284,
+			[]interface{}{simconnect.RecvSystemState{RequestID: 2, Integer: 1, Float: 0.5}},
+			&simconnect.RecvSystemState{RequestID: 2, Integer: 1, Float: 0.5}},
+		{"client data", simconnect.RecvIDClientData, 40,
+			[]interface{}{simconnect.RecvSimObjectData{RequestID: 3}, []byte{1}},
+			&simconnect.ClientDataMessage{RecvClientData: simconnect.RecvClientData{RecvSimObjectData: simconnect.RecvSimObjectData{RequestID: 3}}, Data: []byte{1}}},
+		{"weather mode", simconnect.RecvIDEventWeatherMode, 24,
+			[]interface{}{simconnect.RecvEventWeatherMode{RecvEvent: simconnect.RecvEvent{Data: 2}}},
+			&simconnect.RecvEventWeatherMode{RecvEvent: simconnect.RecvEvent{Data: 2}}},
+		{"airport list", simconnect.RecvIDAirportList, 28,
+			[]interface{}{list, airport},
+			&simconnect.AirportListMessage{RecvAirportList: simconnect.RecvAirportList{RecvFacilitiesList: list}, Airports: []simconnect.DataFacilityAirport{airport}}},
+		{"VOR list", simconnect.RecvIDVORList, 28,
+			[]interface{}{list, vor},
+			&simconnect.VORListMessage{RecvVORList: simconnect.RecvVORList{RecvFacilitiesList: list}, VORs: []simconnect.DataFacilityVOR{vor}}},
+		{"NDB list", simconnect.RecvIDNDBList, 28,
+			[]interface{}{list, ndb},
+			&simconnect.NDBListMessage{RecvNDBList: simconnect.RecvNDBList{RecvFacilitiesList: list}, NDBs: []simconnect.DataFacilityNDB{ndb}}},
+		{"waypoint list", simconnect.RecvIDWaypointList, 28,
+			[]interface{}{list, waypoint},
+			&simconnect.WaypointListMessage{RecvWaypointList: simconnect.RecvWaypointList{RecvFacilitiesList: list}, Waypoints: []simconnect.DataFacilityWaypoint{waypoint}}},
+		{"multiplayer server started", simconnect.RecvIDEventMultiplayerServerStarted, 24,
+			[]interface{}{simconnect.RecvEventMultiplayerServerStarted{}},
+			&simconnect.RecvEventMultiplayerServerStarted{}},
+		{"multiplayer client started", simconnect.RecvIDEventMultiplayerClientStarted, 24,
+			[]interface{}{simconnect.RecvEventMultiplayerClientStarted{}},
+			&simconnect.RecvEventMultiplayerClientStarted{}},
+		{"multiplayer session ended", simconnect.RecvIDEventMultiplayerSessionEnded, 24,
+			[]interface{}{simconnect.RecvEventMultiplayerSessionEnded{}},
+			&simconnect.RecvEventMultiplayerSessionEnded{}},
+		{"race end", simconnect.RecvIDEventRaceEnd, 1108,
+			[]interface{}{simconnect.RecvEventRaceEnd{RacerNumber: 2, RacerData: simconnect.DataRaceResult{NumberOfRacers: 3, TotalTime: 95.5, IsDisqualified: 1}}},
+			&simconnect.RecvEventRaceEnd{RacerNumber: 2, RacerData: simconnect.DataRaceResult{NumberOfRacers: 3, TotalTime: 95.5, IsDisqualified: 1}}},
+		{"race lap", simconnect.RecvIDEventRaceLap, 1108,
+			[]interface{}{simconnect.RecvEventRaceLap{LapIndex: 1, RacerData: simconnect.DataRaceResult{PenaltyTime: 5}}},
+			&simconnect.RecvEventRaceLap{LapIndex: 1, RacerData: simconnect.DataRaceResult{PenaltyTime: 5}}},
+		{"pick", simconnect.RecvIDPick, 12,
+			[]interface{}{simconnect.Recv{}, []byte{1, 2}},
+			&simconnect.PickMessage{Data: []byte{1, 2}}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if size := binary.Size(test.parts[0]); size != test.size {
+				t.Fatalf("size: got %d, want %d", size, test.size)
+			}
+			data := packet(test.id, test.parts...)
+			msg, err := decode(data)
+			if err != nil {
+				t.Fatal(err)
+			}
+			// The header is filled in by packet, so it is copied over before comparing.
+			*test.want.Header() = simconnect.Recv{Size: simconnect.DWord(len(data)), Version: 4, ID: test.id}
+			if !reflect.DeepEqual(msg, test.want) {
+				t.Errorf("got %+v, want %+v", msg, test.want)
+			}
+
+			if _, err := decode(resize(data, test.size-1)); !errors.Is(err, simconnect.ErrShortMessage) {
+				t.Errorf("truncated: got %v, want ErrShortMessage", err)
+			}
+		})
+	}
+}
+
+func TestDecodeShortArray(t *testing.T) {
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{"cloud state", packet(simconnect.RecvIDCloudState, simconnect.RecvCloudState{ArraySize: 4}, []byte{1, 2, 3})},
+		{"airport list", packet(simconnect.RecvIDAirportList, simconnect.RecvFacilitiesList{ArraySize: 2}, simconnect.DataFacilityAirport{})},
+		{"VOR list", packet(simconnect.RecvIDVORList, simconnect.RecvFacilitiesList{ArraySize: 1 << 30}, simconnect.DataFacilityVOR{})},
+	}
+	for _, test := range tests {
+		if _, err := simconnect.DecodeBytes(test.data); !errors.Is(err, simconnect.ErrShortMessage) {
+			t.Errorf("%s: got %v, want ErrShortMessage", test.name, err)
+		}
+	}
+}
+
+func TestDecodeBytes(t *testing.T) {
+	data := packet(simconnect.RecvIDEvent, simconnect.RecvEvent{EventID: 9})
+
+	// A header which reports more bytes than there are.
+	if _, err := simconnect.DecodeBytes(data[:len(data)-1]); !errors.Is(err, simconnect.ErrShortMessage) {
+		t.Errorf("short buffer: got %v, want ErrShortMessage", err)
+	}
+	// Bytes beyond the reported size are not part of the message.
+	msg, err := simconnect.DecodeBytes(append(data, 0xff, 0xff))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if event := msg.(*simconnect.RecvEvent); event.EventID != 9 || event.Size != 24 {
+		t.Errorf("got %+v", event)
+	}
+	if _, err := simconnect.DecodeBytes(packet(simconnect.RecvIDPick+1, simconnect.Recv{})); !errors.Is(err, simconnect.ErrUnknownMessage) {
+		t.Errorf("unknown ID: got %v, want ErrUnknownMessage", err)
+	}
+	if _, err := simconnect.Decode(nil, 0); !errors.Is(err, simconnect.ErrShortMessage) {
+		t.Errorf("nil: got %v, want ErrShortMessage", err)
+	}
+}
@@ -0,0 +1,381 @@
+package simconnect
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync/atomic"
+	"time"
+)
+
+// QueryTimeout limits Query, SystemState and RequestFacilities when their context has no deadline.
+var QueryTimeout = 5 * time.Second
+
+// The system states which can be requested with SystemState.
+const (
+	SystemStateAircraftLoaded = "AircraftLoaded" // String: the path of the loaded aircraft file
+	SystemStateDialogMode     = "DialogMode"     // Integer: 1 while a dialog is open
+	SystemStateFlightLoaded   = "FlightLoaded"   // String: the path of the loaded flight file
+	SystemStateFlightPlan     = "FlightPlan"     // String: the path of the active flight plan, empty if there is none
+	SystemStateSim            = "Sim"            // Integer: 1 while the user is in control of the aircraft
+)
+
+// SystemStateValue is the answer to SystemState. Which field is set depends on the state.
+type SystemStateValue struct {
+	Integer DWord
+	Float   float32
+	String  string
+}
+
+// Facilities is the answer to RequestFacilities. Only the list of the requested type is set.
+type Facilities struct {
+	Airports  []DataFacilityAirport
+	Waypoints []DataFacilityWaypoint
+	NDBs      []DataFacilityNDB
+	VORs      []DataFacilityVOR
+}
+
+// pendingRequest collects the answers to a request until all of them have arrived.
+type pendingRequest struct {
+	messages []Message
+	done     chan struct{}
+	err      error // set before done is closed if the connection ends first
+}
+
+// Query reads a simulation variable of the user aircraft once. Variables without a unit are read as a string,
+// all others as a float64. See QueryObject.
+func (simco *SimConnect) Query(ctx context.Context, name, unit string) (interface{}, error) {
+	var dataType DWord = DataTypeFloat64
+	if unit == "" {
+		dataType = DataTypeStringV
+	}
+	return simco.QueryObject(ctx, ObjectIDUser, name, unit, dataType)
+}
+
+// QueryObject reads a simulation variable of an object once, as dataType. Strings are returned as string.
+//
+// Like SystemState and RequestFacilities, it blocks until the simulator has answered and returns the
+// *SimConnectError the simulator reported for the request, or ctx.Err() if ctx is done first.
+// While Run, RunSession or SimMate.Run dispatch messages, the answer is taken out of the stream of messages.
+// Otherwise the caller dispatches messages itself, and those which are no answer are dropped.
+// Do not wait for an answer in a Handler: the loop which would deliver it is busy calling the Handler.
+func (simco *SimConnect) QueryObject(ctx context.Context, objectID DWord, name, unit string, dataType DWord) (interface{}, error) {
+	if datumSize(dataType) == 0 && dataType != DataTypeStringV {
+		return nil, fmt.Errorf("Query %s: unsupported data type %s", name, DataTypeToString(dataType))
+	}
+	def, err := simco.simVarDefinition(name, unit, dataType)
+	if err != nil {
+		return nil, fmt.Errorf("Query %s: %w", name, err)
+	}
+	requestID := simco.ids.Request.New()
+	messages, err := simco.request(ctx, requestID, scRequestDataOnSimObject,
+		requestID, def.DefineID, objectID, PeriodOnce, DataRequestFlagDefault, DWordZero, DWordZero, DWordZero)
+	if err != nil {
+		return nil, fmt.Errorf("Query %s: %w", name, err)
+	}
+	msg, ok := messages[0].(*SimObjectDataMessage)
+	if !ok {
+		return nil, fmt.Errorf("Query %s: %w: %T", name, ErrUnknownMessage, messages[0])
+	}
+	if size := datumSize(dataType); IsStringDataType(dataType) && dataType != DataTypeStringV {
+		if len(msg.Data) < size {
+			return nil, fmt.Errorf("Query %s: %w: %d bytes, got %d", name, ErrShortMessage, size, len(msg.Data))
+		}
+		return stringFromBytes(msg.Data[:size]), nil
+	}
+	value, err := simVarValue(msg.Data, dataType)
+	if err != nil {
+		return nil, fmt.Errorf("Query %s: %w", name, err)
+	}
+	return value, nil
+}
+
+// QueryAs reads a simulation variable of the user aircraft once and converts it to T, see Convert.
+// Strings are read as strings, everything else as float64.
+func QueryAs[T Value](ctx context.Context, simco *SimConnect, name, unit string) (T, error) {
+	var zero T
+	var dataType DWord = DataTypeFloat64
+	if reflect.TypeOf(zero).Kind() == reflect.String {
+		dataType = DataTypeStringV
+	}
+	value, err := simco.QueryObject(ctx, ObjectIDUser, name, unit, dataType)
+	if err != nil {
+		return zero, err
+	}
+	return Convert[T](value)
+}
+
+// SystemState requests a system state such as SystemStateAircraftLoaded once, see QueryObject.
+func (simco *SimConnect) SystemState(ctx context.Context, state string) (SystemStateValue, error) {
+	requestID := simco.ids.Request.New()
+	messages, err := simco.request(ctx, requestID, scRequestSystemState, requestID, state)
+	if err != nil {
+		return SystemStateValue{}, fmt.Errorf("SystemState %s: %w", state, err)
+	}
+	msg, ok := messages[0].(*RecvSystemState)
+	if !ok {
+		return SystemStateValue{}, fmt.Errorf("SystemState %s: %w: %T", state, ErrUnknownMessage, messages[0])
+	}
+	return SystemStateValue{
+		Integer: msg.Integer,
+		Float:   msg.Float,
+		String:  stringFromBytes(msg.String[:]),
+	}, nil
+}
+
+// RequestFacilities requests the facilities of listType (FacilityListTypeAirport, ...) in the facilities cache
+// of the user aircraft, and waits until all parts of the list have arrived, see QueryObject.
+func (simco *SimConnect) RequestFacilities(ctx context.Context, listType DWord) (*Facilities, error) {
+	requestID := simco.ids.Request.New()
+	messages, err := simco.request(ctx, requestID, scRequestFacilitiesList, listType, requestID)
+	if err != nil {
+		return nil, fmt.Errorf("RequestFacilities %d: %w", listType, err)
+	}
+	facilities := &Facilities{}
+	for _, msg := range messages {
+		switch msg := msg.(type) {
+		case *AirportListMessage:
+			facilities.Airports = append(facilities.Airports, msg.Airports...)
+		case *WaypointListMessage:
+			facilities.Waypoints = append(facilities.Waypoints, msg.Waypoints...)
+		case *NDBListMessage:
+			facilities.NDBs = append(facilities.NDBs, msg.NDBs...)
+		case *VORListMessage:
+			facilities.VORs = append(facilities.VORs, msg.VORs...)
+		}
+	}
+	return facilities, nil
+}
+
+// CreateAIObject creates an AI object and waits until the simulator has assigned its object ID, which it returns.
+// create is called with a new request ID and must pass it on to AICreateEnrouteATCAircraft, AICreateNonATCAircraft,
+// AICreateParkedATCAircraft or AICreateSimulatedObject. Like Query, it fails with the exception the call caused.
+func (simco *SimConnect) CreateAIObject(ctx context.Context, create func(requestID DWord) error) (DWord, error) {
+	requestID := simco.ids.Request.New()
+	messages, err := simco.requestWith(ctx, requestID, func() (DWord, error) {
+		if err := create(requestID); err != nil {
+			return 0, err
+		}
+		return simco.sent.findRequest(requestID, aiCreateProcs...), nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	assigned, ok := messages[0].(*RecvAssignedObjectID)
+	if !ok {
+		return 0, fmt.Errorf("simconnect: unexpected answer %T to an AI object creation", messages[0])
+	}
+	return assigned.ObjectID, nil
+}
+
+// aiCreateProcs are the functions which are answered with RecvAssignedObjectID.
+var aiCreateProcs = []string{scAICreateEnrouteATCAircraft, scAICreateNonATCAircraft, scAICreateParkedATCAircraft, scAICreateSimulatedObject}
+
+// request makes a call which is answered with messages carrying requestID, and waits for all of them.
+// requestID is released once the request is over; if it is given up before the answer arrived, the ID is kept,
+// so that a late answer is not mistaken for the answer to a later request.
+func (simco *SimConnect) request(ctx context.Context, requestID DWord, procName string, args ...interface{}) ([]Message, error) {
+	return simco.requestWith(ctx, requestID, func() (DWord, error) {
+		return simco.callID(procName, args...)
+	})
+}
+
+// requestWith is request for a call made by send, which returns the packet ID of the call.
+func (simco *SimConnect) requestWith(ctx context.Context, requestID DWord, send func() (DWord, error)) ([]Message, error) {
+	if _, ok := ctx.Deadline(); !ok && QueryTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, QueryTimeout)
+		defer cancel()
+	}
+
+	pending := &pendingRequest{done: make(chan struct{})}
+	simco.pendingLock.Lock()
+	if simco.pending == nil {
+		simco.pending = make(map[DWord]*pendingRequest)
+	}
+	simco.pending[requestID] = pending
+	simco.pendingLock.Unlock()
+
+	sendID, err := send()
+	if err == nil {
+		err = simco.wait(ctx, pending.done, simco.ErrorOf(sendID))
+	}
+
+	simco.pendingLock.Lock()
+	delete(simco.pending, requestID)
+	simco.pendingLock.Unlock()
+	if err == nil || ctx.Err() == nil {
+		simco.ids.Request.Release(requestID)
+	}
+	if err == nil {
+		err = pending.err
+	}
+	if err != nil {
+		return nil, err
+	}
+	return pending.messages, nil
+}
+
+// Wait blocks until done is closed or ctx is done, and returns ctx.Err() in the latter case.
+// Unless Run, RunSession or SimMate.Run dispatch messages, Wait dispatches them itself, so that answers and
+// client data subscriptions are delivered; messages which are neither are dropped.
+// Like Query, it must not be called from a Handler.
+func (simco *SimConnect) Wait(ctx context.Context, done <-chan struct{}) error {
+	return simco.wait(ctx, done, nil)
+}
+
+// wait waits until done is closed or the call of failed has failed.
+func (simco *SimConnect) wait(ctx context.Context, done <-chan struct{}, failed *ErrorFuture) error {
+	var failedDone <-chan struct{}
+	if failed != nil {
+		failedDone = failed.Done()
+	}
+	notifier, _ := simco.transport.(Notifier)
+	ticker := time.NewTicker(PollInterval)
+	defer ticker.Stop()
+
+	for {
+		var notify <-chan struct{}
+		if atomic.LoadInt32(&simco.running) == 0 {
+			if err := simco.pump(ctx); err != nil {
+				return err
+			}
+			if notifier != nil {
+				notify = notifier.Notify()
+			}
+		}
+		select {
+		case <-done:
+			return nil
+		case <-failedDone:
+			return failed.Err()
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-notify:
+		case <-ticker.C:
+		}
+	}
+}
+
+// pump dispatches the pending messages unless a Run loop or another caller does.
+// There is no Handler, so messages which are no answer are dropped.
+// Under RunSession it leaves them to the session even while it reconnects, so that a RecvQuit is not lost.
+func (simco *SimConnect) pump(ctx context.Context) error {
+	if atomic.LoadInt32(&simco.supervised) != 0 || !simco.dispatchLock.TryLock() {
+		return nil
+	}
+	defer simco.dispatchLock.Unlock()
+	if atomic.LoadInt32(&simco.running) != 0 || atomic.LoadInt32(&simco.supervised) != 0 {
+		return nil
+	}
+	return simco.dispatch(ctx, nil)
+}
+
+// failPending ends all requests waiting for their answers with err.
+func (simco *SimConnect) failPending(err error) {
+	simco.pendingLock.Lock()
+	defer simco.pendingLock.Unlock()
+	for requestID, pending := range simco.pending {
+		pending.err = err
+		close(pending.done)
+		delete(simco.pending, requestID)
+	}
+}
+
+// SubscribeClientData requests the client data of defineID as often as period says, and calls onData with every
+// ClientDataMessage on the goroutine of Run, RunSession or SimMate.Run (or Wait). It returns the request ID,
+// which is passed to UnsubscribeClientData. ClientDataArea.Subscribe is the typed version of this.
+func (simco *SimConnect) SubscribeClientData(clientDataID, defineID, period, flags DWord, onData func(msg *ClientDataMessage)) (DWord, error) {
+	requestID := simco.ids.Request.New()
+	simco.subscribe(requestID, func(msg Message) {
+		if clientData, ok := msg.(*ClientDataMessage); ok {
+			onData(clientData)
+		}
+	})
+	if err := simco.RequestClientData(clientDataID, requestID, defineID, period, flags); err != nil {
+		simco.unsubscribe(requestID)
+		simco.ids.Request.Release(requestID)
+		return 0, err
+	}
+	return requestID, nil
+}
+
+// UnsubscribeClientData stops a subscription of SubscribeClientData.
+func (simco *SimConnect) UnsubscribeClientData(clientDataID, requestID, defineID DWord) error {
+	err := simco.RequestClientData(clientDataID, requestID, defineID, ClientDataPeriodNever, ClientDataRequestFlagDefault)
+	simco.unsubscribe(requestID)
+	simco.ids.Request.Release(requestID)
+	return err
+}
+
+// HandleRequest hands the messages answering requestID (data, client data, system states and facility lists) to
+// receive instead of the Handler, on the goroutine of Run, RunSession or SimMate.Run (or Wait).
+// A nil receive hands them to the Handler again.
+func (simco *SimConnect) HandleRequest(requestID DWord, receive func(msg Message)) {
+	if receive == nil {
+		simco.unsubscribe(requestID)
+		return
+	}
+	simco.subscribe(requestID, receive)
+}
+
+// subscribe makes deliver hand the messages with requestID to receive, see ClientDataArea.Subscribe.
+func (simco *SimConnect) subscribe(requestID DWord, receive func(msg Message)) {
+	simco.pendingLock.Lock()
+	defer simco.pendingLock.Unlock()
+	if simco.subscribers == nil {
+		simco.subscribers = make(map[DWord]func(Message))
+	}
+	simco.subscribers[requestID] = receive
+}
+
+func (simco *SimConnect) unsubscribe(requestID DWord) {
+	simco.pendingLock.Lock()
+	defer simco.pendingLock.Unlock()
+	delete(simco.subscribers, requestID)
+}
+
+// deliver hands msg to the request or the subscriber waiting for it and reports whether there is one.
+func (simco *SimConnect) deliver(msg Message) bool {
+	var requestID DWord
+	outOf := DWord(1)
+	switch msg := msg.(type) {
+	case *SimObjectDataMessage:
+		requestID = msg.RequestID
+	case *ClientDataMessage:
+		requestID = msg.RequestID
+	case *RecvSystemState:
+		requestID = msg.RequestID
+	case *RecvAssignedObjectID:
+		requestID = msg.RequestID
+	case *AirportListMessage:
+		requestID, outOf = msg.RequestID, msg.OutOf
+	case *WaypointListMessage:
+		requestID, outOf = msg.RequestID, msg.OutOf
+	case *NDBListMessage:
+		requestID, outOf = msg.RequestID, msg.OutOf
+	case *VORListMessage:
+		requestID, outOf = msg.RequestID, msg.OutOf
+	default:
+		return false
+	}
+
+	simco.pendingLock.Lock()
+	pending, ok := simco.pending[requestID]
+	if !ok {
+		receive := simco.subscribers[requestID]
+		simco.pendingLock.Unlock()
+		if receive == nil {
+			return false
+		}
+		receive(msg)
+		return true
+	}
+	defer simco.pendingLock.Unlock()
+	pending.messages = append(pending.messages, msg)
+	if DWord(len(pending.messages)) >= outOf {
+		delete(simco.pending, requestID)
+		close(pending.done)
+	}
+	return true
+}
@@ -0,0 +1,68 @@
+package simconnect
+
+import (
+	"errors"
+
+	"github.com/grumpypixel/msfs2020-simconnect-go/simconnect/events"
+)
+
+// Send transmits a key event to the user aircraft, see SendTo.
+func (simco *SimConnect) Send(event events.Event, values ...float64) error {
+	return simco.SendTo(ObjectIDUser, event, values...)
+}
+
+// SendTo encodes values as the parameters of event (see events.Param) and transmits the event to objectID
+// with the highest priority. The event is mapped to a client event on first use.
+// Events with more than one parameter, or calls with more than one value, go through TransmitClientEventEx1.
+// Where the transport does not support it, calls with at most one value go through TransmitClientEvent.
+func (simco *SimConnect) SendTo(objectID DWord, event events.Event, values ...float64) error {
+	data, err := event.EncodeAll(values...)
+	if err != nil {
+		return err
+	}
+	eventID, err := simco.clientEventID(event.Name)
+	if err != nil {
+		return err
+	}
+	if event.Ex1() || len(data) > 1 {
+		params := make([]DWord, len(data))
+		for i, value := range data {
+			params[i] = DWord(value)
+		}
+		err := simco.TransmitClientEventEx1(objectID, eventID, GroupPriorityHighest, EventFlagGroupIDIsPriority, params...)
+		if !errors.Is(err, ErrUnsupported) || len(data) > 1 {
+			return err
+		}
+		// The network transport has no SimConnect_TransmitClientEvent_EX1, a single value fits the old call.
+	}
+	var value DWord
+	if len(data) > 0 {
+		value = DWord(data[0])
+	}
+	return simco.TransmitClientEvent(uint32(objectID), uint32(eventID), value, GroupPriorityHighest, EventFlagGroupIDIsPriority)
+}
+
+// clientEventID returns the client event mapped to a key event, mapping it if necessary.
+func (simco *SimConnect) clientEventID(name string) (DWord, error) {
+	simco.eventLock.Lock()
+	defer simco.eventLock.Unlock()
+	if eventID, ok := simco.clientEvents[name]; ok {
+		return eventID, nil
+	}
+	eventID := simco.ids.Event.New()
+	if err := simco.MapClientEventToSimEvent(eventID, name); err != nil {
+		simco.ids.Event.Release(eventID)
+		return 0, err
+	}
+	if simco.clientEvents == nil {
+		simco.clientEvents = make(map[string]DWord)
+	}
+	simco.clientEvents[name] = eventID
+	return eventID, nil
+}
+
+func (simco *SimConnect) resetClientEvents() {
+	simco.eventLock.Lock()
+	defer simco.eventLock.Unlock()
+	simco.clientEvents = nil
+}
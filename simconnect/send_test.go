@@ -0,0 +1,236 @@
+package simconnect_test
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/grumpypixel/msfs2020-simconnect-go/simconnect"
+	"github.com/grumpypixel/msfs2020-simconnect-go/simconnect/events"
+	"github.com/grumpypixel/msfs2020-simconnect-go/simconnect/simconnecttest"
+)
+
+func TestSendMapsOnce(t *testing.T) {
+	simco, sim := openSim(t)
+	for _, event := range []events.Event{events.GearSet, events.ComRadioSwap, events.GearSet, events.GearSet} {
+		if err := simco.Send(event, 1); err != nil {
+			t.Fatal(err)
+		}
+	}
+	maps := sim.CallsTo("SimConnect_MapClientEventToSimEvent")
+	if len(maps) != 2 || maps[0].Args[1] != "GEAR_SET" || maps[1].Args[1] != "COM_RADIO_SWAP" {
+		t.Fatalf("got %+v, want GEAR_SET and COM_RADIO_SWAP mapped once each", maps)
+	}
+	transmitted := sim.Transmitted()
+	if len(transmitted) != 4 {
+		t.Fatalf("got %d transmitted events, want 4", len(transmitted))
+	}
+	for i, name := range []string{"GEAR_SET", "COM_RADIO_SWAP", "GEAR_SET", "GEAR_SET"} {
+		event := transmitted[i]
+		if event.Name != name || event.ObjectID != simconnect.ObjectIDUser || event.GroupID != simconnect.GroupPriorityHighest || event.Flags != simconnect.EventFlagGroupIDIsPriority {
+			t.Errorf("event %d: got %+v, want %s", i, event, name)
+		}
+	}
+
+	// The client events are forgotten with the connection.
+	simco.Close()
+	if err := simco.Open("Dispatch Test"); err != nil {
+		t.Fatal(err)
+	}
+	if err := simco.Send(events.GearSet, 0); err != nil {
+		t.Fatal(err)
+	}
+	if maps := sim.CallsTo("SimConnect_MapClientEventToSimEvent"); len(maps) != 3 {
+		t.Errorf("got %d maps, want GEAR_SET mapped again after reopening", len(maps))
+	}
+}
+
+func TestSendMapFails(t *testing.T) {
+	simco, sim := openSim(t)
+	failure := errors.New("map failed")
+	sim.FailNextCall(failure)
+	if err := simco.Send(events.GearSet, 1); !errors.Is(err, failure) {
+		t.Fatalf("got %v, want the failure of MapClientEventToSimEvent", err)
+	}
+	if err := simco.Send(events.GearSet, 1); err != nil {
+		t.Fatal(err)
+	}
+	maps := sim.CallsTo("SimConnect_MapClientEventToSimEvent")
+	if len(maps) != 2 || maps[1].Args[0] != simconnect.DWord(1) {
+		t.Errorf("got %+v, want the failed map retried with the same event ID", maps)
+	}
+}
+
+func TestSendParams(t *testing.T) {
+	simco, sim := openSim(t)
+	tests := []struct {
+		event events.Event
+		value float64
+		want  simconnect.DWord
+	}{
+		{events.ComRadioSwap, 5, 0},
+		{events.ElevatorTrimSet, 1000, 1000},
+		{events.ElevatorTrimSet, -100, 0xffffff9c},
+		{events.GearSet, 0, 0},
+		{events.GearSet, 0.5, 1},
+		{events.ComRadioSetHz, 123450000, 123450000},
+		{events.ComRadioSet, 123.45, 0x2345},
+		{events.ComRadioSet, 118.0, 0x1800},
+		{events.ADFCompleteSet, 344, 0x03440000},
+		{events.XpndrSet, 7700, 0x7700},
+		{events.XpndrSet, 1200, 0x1200},
+		{events.VOR1Set, 90, 90},
+		{events.VOR1Set, -90, 270},
+		{events.VOR1Set, 360, 0},
+		{events.APAltVarSetEnglish, 5000.4, 5000},
+		{events.APVSVarSetEnglish, -700, 0xfffffd44},
+		{events.APSpdVarSet, 120, 120},
+		{events.ThrottleSet, 0, 0},
+		{events.ThrottleSet, 50, 8192},
+		{events.ThrottleSet, 100, 16383},
+		{events.AxisThrottleSet, 100, 16383},
+		{events.AxisThrottleSet, -100, 0xffffc001},
+		{events.KohlsmanSet, 1013.25, 16212},
+	}
+	for i, test := range tests {
+		if err := simco.Send(test.event, test.value); err != nil {
+			t.Errorf("%s(%v): %v", test.event, test.value, err)
+			continue
+		}
+		transmitted := sim.Transmitted()
+		if len(transmitted) != i+1 {
+			t.Fatalf("%s(%v): got %d transmitted events, want %d", test.event, test.value, len(transmitted), i+1)
+		}
+		if got := transmitted[i]; got.Name != test.event.Name || got.Data != test.want {
+			t.Errorf("%s(%v): got %s(%#x), want %#x", test.event, test.value, got.Name, got.Data, test.want)
+		}
+	}
+}
+
+func TestSendRange(t *testing.T) {
+	simco, sim := openSim(t)
+	tests := []struct {
+		event events.Event
+		value float64
+	}{
+		{events.ComRadioSet, 99.99},
+		{events.ComRadioSet, 200},
+		{events.ADFCompleteSet, 10000},
+		{events.XpndrSet, 7800},
+		{events.XpndrSet, 12.5},
+		{events.XpndrSet, 10000},
+		{events.ThrottleSet, 101},
+		{events.AxisThrottleSet, -101},
+		{events.APAltVarSetEnglish, -1},
+		{events.GearSet, math.NaN()},
+		{events.VOR1Set, math.Inf(1)},
+	}
+	for _, test := range tests {
+		if err := simco.Send(test.event, test.value); !errors.Is(err, events.ErrRange) {
+			t.Errorf("%s(%v): got %v, want ErrRange", test.event, test.value, err)
+		}
+	}
+	if err := simco.Send(events.GearSet, 1, 2, 3, 4, 5, 6); !errors.Is(err, events.ErrRange) {
+		t.Errorf("six values: got %v, want ErrRange", err)
+	}
+	if calls := sim.Calls(); len(calls) != 0 {
+		t.Errorf("values out of range are sent: %+v", calls)
+	}
+}
+
+func TestSendEx1(t *testing.T) {
+	simco, sim := openSim(t)
+	throttle := events.New("THROTTLE_SET_EX1", events.Percent, events.Number)
+	if err := simco.Send(events.LightPotentiometerSet, 3, 80); err != nil {
+		t.Fatal(err)
+	}
+	if err := simco.Send(throttle, 50, 2); err != nil {
+		t.Fatal(err)
+	}
+	// Values beyond the declared parameters are sent as numbers.
+	if err := simco.Send(throttle, 100, 1, 7, 8, 9); err != nil {
+		t.Fatal(err)
+	}
+	// Events with a single parameter take the old call unless more values are given.
+	if err := simco.Send(events.GearSet, 1); err != nil {
+		t.Fatal(err)
+	}
+	want := []simconnecttest.TransmittedEvent{
+		{Name: "LIGHT_POTENTIOMETER_SET", Data: 3, Params: []simconnect.DWord{3, 80, 0, 0, 0}},
+		{Name: "THROTTLE_SET_EX1", Data: 8192, Params: []simconnect.DWord{8192, 2, 0, 0, 0}},
+		{Name: "THROTTLE_SET_EX1", Data: 16383, Params: []simconnect.DWord{16383, 1, 7, 8, 9}},
+		{Name: "GEAR_SET", Data: 1},
+	}
+	transmitted := sim.Transmitted()
+	if len(transmitted) != len(want) {
+		t.Fatalf("got %d transmitted events, want %d", len(transmitted), len(want))
+	}
+	for i, event := range transmitted {
+		event.EventID, event.GroupID, event.Flags = 0, 0, 0
+		if !reflect.DeepEqual(event, want[i]) {
+			t.Errorf("event %d: got %+v, want %+v", i, event, want[i])
+		}
+	}
+	if calls := sim.CallsTo("SimConnect_TransmitClientEvent_EX1"); len(calls) != 3 {
+		t.Errorf("got %d calls to SimConnect_TransmitClientEvent_EX1, want 3", len(calls))
+	}
+}
+
+func TestSendEx1Unsupported(t *testing.T) {
+	simco, sim := openSim(t)
+	sim.Handle("SimConnect_TransmitClientEvent_EX1", func(sim *simconnecttest.Sim, call simconnecttest.Call) error {
+		return simconnect.ErrUnsupported
+	})
+
+	// A single value fits SimConnect_TransmitClientEvent.
+	if err := simco.Send(events.LightPotentiometerSet, 3); err != nil {
+		t.Fatal(err)
+	}
+	transmitted := sim.Transmitted()
+	if len(transmitted) != 1 || transmitted[0].Name != "LIGHT_POTENTIOMETER_SET" || transmitted[0].Data != 3 || transmitted[0].Params != nil {
+		t.Fatalf("got %+v, want LIGHT_POTENTIOMETER_SET(3) through SimConnect_TransmitClientEvent", transmitted)
+	}
+	if calls := sim.CallsTo("SimConnect_TransmitClientEvent"); len(calls) != 1 {
+		t.Errorf("got %d calls to SimConnect_TransmitClientEvent, want 1", len(calls))
+	}
+
+	// More values do not.
+	if err := simco.Send(events.LightPotentiometerSet, 3, 80); !errors.Is(err, simconnect.ErrUnsupported) {
+		t.Errorf("two values: got %v, want ErrUnsupported", err)
+	}
+	if len(sim.Transmitted()) != 1 {
+		t.Error("two values are sent with SimConnect_TransmitClientEvent")
+	}
+
+	// Other failures are not taken for a missing function.
+	failure := errors.New("transmit failed")
+	sim.Handle("SimConnect_TransmitClientEvent_EX1", func(sim *simconnecttest.Sim, call simconnecttest.Call) error {
+		return failure
+	})
+	if err := simco.Send(events.LightPotentiometerSet, 3); !errors.Is(err, failure) {
+		t.Errorf("got %v, want the failure of SimConnect_TransmitClientEvent_EX1", err)
+	}
+	if len(sim.Transmitted()) != 1 {
+		t.Error("a failed call falls back to SimConnect_TransmitClientEvent")
+	}
+}
+
+func TestTransmitClientEventEx1(t *testing.T) {
+	simco, sim := openSim(t)
+	if err := simco.MapClientEventToSimEvent(1, "THROTTLE_SET_EX1"); err != nil {
+		t.Fatal(err)
+	}
+	if err := simco.TransmitClientEventEx1(simconnect.ObjectIDUser, 1, simconnect.GroupPriorityStandard, simconnect.EventFlagGroupIDIsPriority, 1, 2, 3, 4, 5); err != nil {
+		t.Fatal(err)
+	}
+	calls := sim.CallsTo("SimConnect_TransmitClientEvent_EX1")
+	want := []interface{}{simconnect.ObjectIDUser, simconnect.DWord(1), simconnect.GroupPriorityStandard, simconnect.EventFlagGroupIDIsPriority,
+		simconnect.DWord(1), simconnect.DWord(2), simconnect.DWord(3), simconnect.DWord(4), simconnect.DWord(5)}
+	if len(calls) != 1 || !reflect.DeepEqual(calls[0].Args, want) {
+		t.Errorf("got %+v, want the five parameters", calls)
+	}
+	if err := simco.TransmitClientEventEx1(simconnect.ObjectIDUser, 1, simconnect.GroupPriorityStandard, 0, 1, 2, 3, 4, 5, 6); err == nil {
+		t.Error("six parameters are accepted")
+	}
+}
@@ -0,0 +1,144 @@
+package simconnect
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+var (
+	// ErrQuit is passed to Session.OnDisconnect when the simulator has sent a RecvQuit.
+	ErrQuit = errors.New("simconnect: the simulator quit")
+	// ErrDisconnected is returned by Query and the other calls waiting for an answer when the connection ends first.
+	ErrDisconnected = errors.New("simconnect: disconnected")
+)
+
+const (
+	defaultMinBackoff = time.Second
+	defaultMaxBackoff = 30 * time.Second
+)
+
+// Session describes how RunSession keeps a connection alive.
+//
+// Everything which sets up the connection is recorded when it is called: data definitions, client event mappings,
+// notification and input groups, system event subscriptions, client data areas and periodic requests.
+// After a reconnect the recorded calls are replayed in their original order, so IDs stay valid.
+// Calls made while the session is disconnected fail and are not replayed.
+type Session struct {
+	Name         string          // the client name passed to Open
+	MinBackoff   time.Duration   // the delay before the first attempt to reconnect, one second if zero
+	MaxBackoff   time.Duration   // the delay is doubled after every failed attempt up to MaxBackoff, 30 seconds if zero
+	OnDisconnect func(err error) // called when the connection is lost, with ErrQuit if the simulator quit
+	OnReconnect  func()          // called after reconnecting, once everything has been replayed
+}
+
+// RunSession is Run with automatic reconnection. If the connection is not open yet, it is opened first.
+// When the simulator quits or the connection fails, RunSession retries to open it with backoff, replays the
+// recorded setup and continues to hand messages to handler. It only returns once ctx is done.
+// Requests waiting for an answer when the connection is lost fail with ErrDisconnected; see also OnDisconnect.
+func (simco *SimConnect) RunSession(ctx context.Context, session *Session, handler Handler) error {
+	return simco.supervise(ctx, session, func(ctx context.Context) error {
+		return simco.run(ctx, PollInterval, nil, nil, handler)
+	}, nil)
+}
+
+// supervise calls run until ctx is done and reconnects whenever run returns. reconnected is called after a reconnect.
+func (simco *SimConnect) supervise(ctx context.Context, session *Session, run func(ctx context.Context) error, reconnected func()) error {
+	if simco.transport == nil {
+		return ErrNoTransport
+	}
+	if !simco.IsConnected() {
+		if err := session.retry(ctx, func() error { return simco.Open(session.Name) }); err != nil {
+			return err
+		}
+	}
+
+	atomic.StoreInt32(&simco.supervised, 1)
+	defer atomic.StoreInt32(&simco.supervised, 0)
+	for {
+		err := run(ctx)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		log.Tracef("Disconnected: %v", err)
+		simco.transport.Close()
+		simco.setConnected(false)
+		simco.failPending(ErrDisconnected)
+		simco.runDisconnectHooks(err)
+		if session.OnDisconnect != nil {
+			session.OnDisconnect(err)
+		}
+
+		if err := session.retry(ctx, func() error { return simco.reopen(session.Name) }); err != nil {
+			return err
+		}
+		log.Tracef("Reconnected, replayed %d calls", simco.journal.len())
+		if reconnected != nil {
+			reconnected()
+		}
+		if session.OnReconnect != nil {
+			session.OnReconnect()
+		}
+	}
+}
+
+// reopen opens the connection again and replays the journal. Unlike Open, it keeps the IDs which have been handed out.
+// Other calls wait until the replay is over, so that none of them slips in before the setup it depends on.
+func (simco *SimConnect) reopen(name string) error {
+	const configIndex DWord = 0
+
+	simco.callLock.Lock()
+	defer simco.callLock.Unlock()
+	if err := simco.transport.Open(name, configIndex); err != nil {
+		return err
+	}
+	simco.sent.reset()
+	err := simco.journal.replay(func(procName string, args []interface{}) error {
+		if err := simco.transport.Call(procName, args...); err != nil {
+			return err
+		}
+		// With their packet IDs, exceptions to replayed calls are explained like any others.
+		simco.callSent(procName, args, true)
+		return nil
+	})
+	if err != nil {
+		simco.transport.Close()
+		return err
+	}
+	simco.setConnected(true)
+	return nil
+}
+
+// retry calls open until it succeeds or ctx is done, waiting longer after every failure.
+func (session *Session) retry(ctx context.Context, open func() error) error {
+	backoff := session.MinBackoff
+	if backoff <= 0 {
+		backoff = defaultMinBackoff
+	}
+	maxBackoff := session.MaxBackoff
+	if maxBackoff <= 0 {
+		maxBackoff = defaultMaxBackoff
+	}
+	for {
+		err := open()
+		if err == nil {
+			return nil
+		}
+		log.Tracef("Open failed, retrying in %s: %s", backoff, err.Error())
+
+		timer := time.NewTimer(backoff)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+		backoff *= 2
+		if backoff > maxBackoff {
+			backoff = maxBackoff
+		}
+	}
+}
@@ -0,0 +1,157 @@
+package simconnect_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/grumpypixel/msfs2020-simconnect-go/simconnect"
+	"github.com/grumpypixel/msfs2020-simconnect-go/simconnect/simconnecttest"
+)
+
+// openRecorder is a Sim which records the time of every attempt to open it.
+type openRecorder struct {
+	*simconnecttest.Sim
+	mutex sync.Mutex
+	opens []time.Time
+}
+
+func (rec *openRecorder) Open(name string, configIndex simconnect.DWord) error {
+	rec.mutex.Lock()
+	rec.opens = append(rec.opens, time.Now())
+	rec.mutex.Unlock()
+	return rec.Sim.Open(name, configIndex)
+}
+
+func (rec *openRecorder) attempts() []time.Time {
+	rec.mutex.Lock()
+	defer rec.mutex.Unlock()
+	return append([]time.Time(nil), rec.opens...)
+}
+
+// runSession runs simco.RunSession on its own goroutine until the test ends.
+func runSession(t *testing.T, simco *simconnect.SimConnect, session *simconnect.Session, handler simconnect.Handler) {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	errc := make(chan error, 1)
+	go func() {
+		errc <- simco.RunSession(ctx, session, handler)
+	}()
+	t.Cleanup(func() {
+		cancel()
+		select {
+		case err := <-errc:
+			if !errors.Is(err, context.Canceled) {
+				t.Errorf("RunSession: got %v, want context.Canceled", err)
+			}
+		case <-time.After(testTimeout):
+			t.Error("RunSession did not return")
+		}
+	})
+}
+
+func TestRunSessionReconnects(t *testing.T) {
+	rec := &openRecorder{Sim: simconnecttest.NewSim()}
+	simco := simconnect.NewSimConnectWithTransport(rec)
+	if err := simco.Open("Session Test"); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { simco.Close() })
+	rec.SetSimVar("PLANE ALTITUDE", 1500.0)
+
+	// Definition 101 with a periodic and a one-off request, definition 102 cleared again.
+	for _, err := range []error{
+		simco.AddToDataDefinition(101, "PLANE ALTITUDE", "feet", simconnect.DataTypeFloat64),
+		simco.RequestDataOnSimObject(101, 101, simconnect.ObjectIDUser, simconnect.PeriodSimFrame, simconnect.DataRequestFlagDefault),
+		simco.RequestDataOnSimObject(102, 101, simconnect.ObjectIDUser, simconnect.PeriodOnce, simconnect.DataRequestFlagDefault),
+		simco.AddToDataDefinition(102, "PLANE ALTITUDE", "meters", simconnect.DataTypeFloat64),
+		simco.RequestDataOnSimObject(103, 102, simconnect.ObjectIDUser, simconnect.PeriodSimFrame, simconnect.DataRequestFlagDefault),
+		simco.ClearDataDefinition(102),
+	} {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	disconnects := make(chan error, 4)
+	hooked := make(chan error, 4)
+	reconnects := make(chan struct{}, 4)
+	opened := make(chan struct{}, 4)
+	data := make(chan simconnect.DWord, 64)
+	simco.OnDisconnect(func(err error) { hooked <- err })
+	session := &simconnect.Session{
+		Name:         "Session Test",
+		MinBackoff:   time.Millisecond,
+		MaxBackoff:   4 * time.Millisecond,
+		OnDisconnect: func(err error) { disconnects <- err },
+		OnReconnect:  func() { reconnects <- struct{}{} },
+	}
+	runSession(t, simco, session, simconnect.HandlerFunc(func(ctx context.Context, msg simconnect.Message) {
+		switch msg := msg.(type) {
+		case *simconnect.RecvOpen:
+			opened <- struct{}{}
+		case *simconnect.SimObjectDataMessage:
+			data <- msg.RequestID
+		}
+	}))
+	receive(t, opened)
+
+	// A request which the simulator does not answer fails once the connection is lost.
+	rec.Handle("SimConnect_RequestSystemState", func(sim *simconnecttest.Sim, call simconnecttest.Call) error {
+		return nil
+	})
+	queried := make(chan error, 1)
+	go func() {
+		_, err := simco.SystemState(context.Background(), simconnect.SystemStateAircraftLoaded)
+		queried <- err
+	}()
+	eventually(t, "the request", func() bool {
+		return len(rec.CallsTo("SimConnect_RequestSystemState")) == 1
+	})
+	calls := len(rec.Calls())
+
+	rec.SetOffline(true)
+	if err := receive(t, disconnects); !errors.Is(err, simconnect.ErrQuit) {
+		t.Errorf("OnDisconnect: got %v, want ErrQuit", err)
+	}
+	if err := receive(t, hooked); !errors.Is(err, simconnect.ErrQuit) {
+		t.Errorf("disconnect hook: got %v, want ErrQuit", err)
+	}
+	if err := receive(t, queried); !errors.Is(err, simconnect.ErrDisconnected) {
+		t.Errorf("SystemState: got %v, want ErrDisconnected", err)
+	}
+
+	// While the simulator is offline, the delay between attempts grows up to MaxBackoff and no further.
+	eventually(t, "twelve attempts to reconnect", func() bool { return len(rec.attempts()) >= 13 })
+	rec.SetOffline(false)
+	receive(t, reconnects)
+	attempts := rec.attempts()[1:13]
+	for i := 1; i < len(attempts); i++ {
+		if gap := attempts[i].Sub(attempts[i-1]); gap > 500*time.Millisecond {
+			t.Errorf("attempt %d: %s after the last one, want the backoff capped at %s", i+1, gap, session.MaxBackoff)
+		}
+	}
+	if gap := attempts[len(attempts)-1].Sub(attempts[len(attempts)-2]); gap < session.MaxBackoff {
+		t.Errorf("last attempts %s apart, want at least %s", gap, session.MaxBackoff)
+	}
+	if !simco.IsConnected() {
+		t.Error("not connected after OnReconnect")
+	}
+
+	// Definition 101 and its periodic request come back, the rest does not.
+	replayed := rec.Calls()[calls:]
+	if len(replayed) != 2 ||
+		replayed[0].ProcName != "SimConnect_AddToDataDefinition" || replayed[0].Args[0] != simconnect.DWord(101) ||
+		replayed[1].ProcName != "SimConnect_RequestDataOnSimObject" || replayed[1].Args[0] != simconnect.DWord(101) {
+		t.Fatalf("replayed %+v, want definition 101 and request 101", replayed)
+	}
+	for len(data) > 0 {
+		<-data // the answer to the one-off request
+	}
+	rec.Tick()
+	if requestID := receive(t, data); requestID != 101 {
+		t.Errorf("got data for request %d, want request 101", requestID)
+	}
+}
@@ -5,8 +5,7 @@ import (
 	"os"
 	"path/filepath"
 	"sync"
-	"syscall"
-	"unsafe"
+	"sync/atomic"
 
 	log "github.com/sirupsen/logrus"
 )
@@ -16,8 +15,6 @@ const (
 )
 
 var (
-	library     *syscall.LazyDLL
-	procs       map[string]*syscall.LazyProc
 	lockID      sync.Mutex
 	defineID    DWord
 	eventID     DWord
@@ -32,15 +29,39 @@ func init() {
 }
 
 type SimConnect struct {
-	handle    unsafe.Pointer
-	connected bool
+	transport Transport
+	ids       IDs
+	connected int32 // accessed atomically, IsConnected may be called while RunSession reconnects
+
+	definitionLock sync.Mutex
+	definitions    map[interface{}]*DataDefinition // cached by SetData and SetSimVarValue
+
+	eventLock    sync.Mutex
+	clientEvents map[string]DWord            // key events mapped by Send
+	systemEvents map[string]DWord            // system events subscribed for the callbacks of EventListener and OnSystemEvent
+	eventHooks   map[string][]*func(Message) // the receivers of OnSystemEvent by system event, by pointer like closeHooks
+
+	callLock   sync.Mutex // serializes calls, so that each gets its own packet ID
+	sent       sentCalls  // the last calls by packet ID, to explain exceptions
+	journal    journal    // replayed by RunSession after reconnecting
+	supervised int32      // set while RunSession runs, accessed atomically
+
+	dispatchLock sync.Mutex // serializes GetNextMessage between Run and the callers of Query
+	running      int32      // the number of Run loops, accessed atomically
+	pendingLock  sync.Mutex
+	pending      map[DWord]*pendingRequest // Query, CreateAIObject and the like waiting for their answers
+	subscribers  map[DWord]func(Message)   // client data subscriptions and HandleRequest by request ID
+
+	closeLock       sync.Mutex
+	closeHooks      []*func()          // by pointer, so that OnClose can remove them again
+	disconnectHooks []*func(err error) // by pointer like closeHooks
 }
 
 func NewSimConnect() *SimConnect {
 	if !initialized {
 		panic("SimConnect not initialized.")
 	}
-	return &SimConnect{}
+	return NewSimConnectWithTransport(NewDLLTransport())
 }
 
 func LocateLibrary(additionalSearchPath string) (string, error) {
@@ -86,9 +107,81 @@ func IsInitialized() bool {
 }
 
 func (simco *SimConnect) IsConnected() bool {
-	return simco.connected
+	return atomic.LoadInt32(&simco.connected) != 0
+}
+
+// OnClose registers hook to be called by Close before the connection ends, so that it can still make calls,
+// e.g. to remove the AI objects it has created. Hooks run in reverse order of registration.
+// The returned function unregisters the hook.
+func (simco *SimConnect) OnClose(hook func()) (remove func()) {
+	entry := &hook
+	simco.closeLock.Lock()
+	simco.closeHooks = append(simco.closeHooks, entry)
+	simco.closeLock.Unlock()
+	return func() {
+		simco.closeLock.Lock()
+		defer simco.closeLock.Unlock()
+		for i, other := range simco.closeHooks {
+			if other == entry {
+				simco.closeHooks = append(simco.closeHooks[:i], simco.closeHooks[i+1:]...)
+				return
+			}
+		}
+	}
+}
+
+// runCloseHooks calls the hooks registered with OnClose.
+func (simco *SimConnect) runCloseHooks() {
+	simco.closeLock.Lock()
+	hooks := append([]*func(){}, simco.closeHooks...)
+	simco.closeLock.Unlock()
+	for i := len(hooks) - 1; i >= 0; i-- {
+		(*hooks[i])()
+	}
+}
+
+// OnDisconnect registers hook to be called when RunSession or SimMate.RunSession loses the connection, with the
+// error which ended it (ErrQuit if the simulator quit). The connection is already gone, so unlike the hooks of
+// OnClose, hook cannot make calls. Hooks run in reverse order of registration.
+// The returned function unregisters the hook.
+func (simco *SimConnect) OnDisconnect(hook func(err error)) (remove func()) {
+	entry := &hook
+	simco.closeLock.Lock()
+	simco.disconnectHooks = append(simco.disconnectHooks, entry)
+	simco.closeLock.Unlock()
+	return func() {
+		simco.closeLock.Lock()
+		defer simco.closeLock.Unlock()
+		for i, other := range simco.disconnectHooks {
+			if other == entry {
+				simco.disconnectHooks = append(simco.disconnectHooks[:i], simco.disconnectHooks[i+1:]...)
+				return
+			}
+		}
+	}
+}
+
+// runDisconnectHooks calls the hooks registered with OnDisconnect.
+func (simco *SimConnect) runDisconnectHooks(err error) {
+	simco.closeLock.Lock()
+	hooks := append([]*func(error){}, simco.disconnectHooks...)
+	simco.closeLock.Unlock()
+	for i := len(hooks) - 1; i >= 0; i-- {
+		(*hooks[i])(err)
+	}
 }
 
+func (simco *SimConnect) setConnected(connected bool) {
+	var value int32
+	if connected {
+		value = 1
+	}
+	atomic.StoreInt32(&simco.connected, value)
+}
+
+// NewDefineID returns a data definition ID from a counter shared by all connections.
+//
+// Deprecated: use the ID spaces of the connection, simco.IDs().Define.New(). Do not mix the two in one connection.
 func NewDefineID() DWord {
 	lockID.Lock()
 	defer lockID.Unlock()
@@ -96,6 +189,9 @@ func NewDefineID() DWord {
 	return defineID
 }
 
+// NewRequestID returns a request ID from a counter shared by all connections.
+//
+// Deprecated: use the ID spaces of the connection, simco.IDs().Request.New(). Do not mix the two in one connection.
 func NewRequestID() DWord {
 	lockID.Lock()
 	defer lockID.Unlock()
@@ -103,6 +199,9 @@ func NewRequestID() DWord {
 	return requestID
 }
 
+// NewEventID returns a client event ID from a counter shared by all connections.
+//
+// Deprecated: use the ID spaces of the connection, simco.IDs().Event.New(). Do not mix the two in one connection.
 func NewEventID() DWord {
 	lockID.Lock()
 	defer lockID.Unlock()
@@ -140,106 +239,6 @@ func findLibrary(searchPaths []string) (string, error) {
 	return "", fmt.Errorf("could not locate %s in search paths", SimConnectDLL)
 }
 
-func loadLibrary(path string) error {
-	library = syscall.NewLazyDLL(path)
-	if err := library.Load(); err != nil {
-		return err
-	}
-	return nil
-}
-
-func loadProcs() {
-	procs = make(map[string]*syscall.LazyProc)
-	procNames := []string{
-		scOpen,
-		scClose,
-		// scCallDispatch,
-		scGetNextDispatch,
-		scRequestSystemState,
-		scMapClientEventToSimEvent,
-		scSubscribeToSystemEvent,
-		scSetSystemEventState,
-		scUnsubscribeFromSystemEvent,
-		scSetNotificationGroupPriority,
-		scText,
-		scRequestDataOnSimObject,
-		scRequestDataOnSimObjectType,
-		scAddClientEventToNotificationGroup,
-		scRemoveClientEvent,
-		scTransmitClientEvent,
-		scMapClientDataNameToID,
-		scRequestClientData,
-		scCreateClientData,
-		scAddToClientDataDefinition,
-		scAddToDataDefinition,
-		scSetClientData,
-		scSetDataOnSimObject,
-		scClearClientDataDefinition,
-		scClearDataDefinition,
-		scMapInputEventToClientEvent,
-		scRequestNotificationGroup,
-		scClearInputGroup,
-		scClearNotificationGroup,
-		// scRequestReservedKey,
-		scSetInputGroupPriority,
-		scSetInputGroupState,
-		scRemoveInputEvent,
-		scAICreateEnrouteATCAircraft,
-		scAICreateNonATCAircraft,
-		scAICreateParkedATCAircraft,
-		scAICreateSimulatedObject,
-		scAIReleaseControl,
-		scAIRemoveObject,
-		scAISetAircraftFlightPlan,
-		scFlightLoad,
-		scFlightSave,
-		scFlightPlanLoad,
-		scGetLastSentPacketID,
-		// scRequestResponseTimes,
-		// scInsertString,
-		// scRetrieveString,
-		scRequestFacilitiesList,
-		scSubscribeToFacilities,
-		scUnsubscribeToFacilities,
-		// scCompleteCustomMissionAction,
-		// scExecuteMissionAction,
-		scMenuAddItem,
-		scMenuAddSubItem,
-		scMenuDeleteItem,
-		scMenuDeleteSubItem,
-		scCameraSetRelative6DOF,
-		scSetSystemState,
-	}
-	for _, procName := range procNames {
-		procs[procName] = library.NewProc(procName)
-	}
-}
-
-func callProc(procName string, args ...uintptr) error {
-	proc, ok := procs[procName]
-	if !ok {
-		return fmt.Errorf("proc %s not defined", procName)
-	}
-	r1, _, err := proc.Call(args...)
-	if int32(r1) < 0 {
-		return fmt.Errorf("%s error: %d %s", procName, r1, err)
-	}
-	return nil
-}
-
 func toNullTerminatedBytes(str string) []byte {
 	return []byte(str + "\x00")
 }
-
-func toCharPtr(str string) uintptr {
-	bytes := toNullTerminatedBytes(str)
-	return uintptr(unsafe.Pointer(&bytes[0]))
-}
-
-func toBoolPtr(value bool) uintptr {
-	v := 0
-	if value {
-		v = 1
-	}
-	return uintptr(v)
-}
@@ -0,0 +1,121 @@
+package simconnecttest
+
+import (
+	"sort"
+
+	"github.com/grumpypixel/msfs2020-simconnect-go/simconnect"
+)
+
+// FirstAIObjectID is the object ID assigned to the first AI object; later objects count up from it.
+const FirstAIObjectID DWord = 1000
+
+// AIObject is an object created with one of the SimConnect_AICreate functions.
+type AIObject struct {
+	ObjectID   DWord
+	ProcName   string // the function which created it, e.g. "SimConnect_AICreateNonATCAircraft"
+	ObjectType DWord  // simconnect.SimObjectTypeAircraft, or simconnect.SimObjectTypeGround for simulated objects
+	Title      string
+	TailNumber string // aircraft only
+	AirportID  string // parked aircraft only
+	FlightPlan string // the path set at creation or with SimConnect_AISetAircraftFlightPlan
+	Released   bool   // set by SimConnect_AIReleaseControl
+}
+
+// AIObjects returns the AI objects which exist, ordered by object ID. Objects stay when the client disconnects.
+func (sim *Sim) AIObjects() []AIObject {
+	sim.mutex.Lock()
+	defer sim.mutex.Unlock()
+	objects := make([]AIObject, 0, len(sim.aiObjects))
+	for _, object := range sim.aiObjects {
+		objects = append(objects, *object)
+	}
+	sort.Slice(objects, func(i, j int) bool {
+		return objects[i].ObjectID < objects[j].ObjectID
+	})
+	return objects
+}
+
+// RemoveAIObject removes an AI object as the simulator would, e.g. after a crash, and sends the
+// "ObjectRemoved" system event if the client has subscribed to it. It reports whether the object existed.
+func (sim *Sim) RemoveAIObject(objectID DWord) bool {
+	sim.mutex.Lock()
+	defer sim.mutex.Unlock()
+	return sim.removeAIObject(objectID)
+}
+
+func (sim *Sim) handleAI(call Call) {
+	args := call.Args
+	requestID := dword(args[len(args)-1])
+	switch call.ProcName {
+	case "SimConnect_AICreateEnrouteATCAircraft", "SimConnect_AICreateNonATCAircraft",
+		"SimConnect_AICreateParkedATCAircraft", "SimConnect_AICreateSimulatedObject":
+		object := &AIObject{ProcName: call.ProcName, ObjectType: simconnect.SimObjectTypeAircraft}
+		object.Title, _ = args[0].(string)
+		switch call.ProcName {
+		case "SimConnect_AICreateEnrouteATCAircraft":
+			object.TailNumber, _ = args[1].(string)
+			object.FlightPlan, _ = args[3].(string)
+		case "SimConnect_AICreateNonATCAircraft":
+			object.TailNumber, _ = args[1].(string)
+		case "SimConnect_AICreateParkedATCAircraft":
+			object.TailNumber, _ = args[1].(string)
+			object.AirportID, _ = args[2].(string)
+		case "SimConnect_AICreateSimulatedObject":
+			object.ObjectType = simconnect.SimObjectTypeGround
+		}
+		if object.Title == "" {
+			sim.exception(simconnect.ExceptionCreateObjectFailed, call.SendID, 1)
+			return
+		}
+		if sim.nextObjectID < FirstAIObjectID {
+			sim.nextObjectID = FirstAIObjectID
+		}
+		object.ObjectID = sim.nextObjectID
+		sim.nextObjectID++
+		sim.aiObjects[object.ObjectID] = object
+		recvAssigned := simconnect.RecvAssignedObjectID{RequestID: requestID, ObjectID: object.ObjectID}
+		sim.push(simconnect.RecvIDAssignedObjectID, &recvAssigned, nil)
+		sim.objectEvent(simconnect.SystemEventObjectAdded, object)
+
+	case "SimConnect_AIRemoveObject":
+		if !sim.removeAIObject(dword(args[0])) {
+			sim.exception(simconnect.ExceptionUnrecognizedID, call.SendID, 1)
+		}
+
+	case "SimConnect_AIReleaseControl":
+		object, ok := sim.aiObjects[dword(args[0])]
+		if !ok {
+			sim.exception(simconnect.ExceptionUnrecognizedID, call.SendID, 1)
+			return
+		}
+		object.Released = true
+
+	case "SimConnect_AISetAircraftFlightPlan":
+		object, ok := sim.aiObjects[dword(args[0])]
+		if !ok {
+			sim.exception(simconnect.ExceptionUnrecognizedID, call.SendID, 1)
+			return
+		}
+		object.FlightPlan, _ = args[1].(string)
+	}
+}
+
+func (sim *Sim) removeAIObject(objectID DWord) bool {
+	object, ok := sim.aiObjects[objectID]
+	if !ok {
+		return false
+	}
+	delete(sim.aiObjects, objectID)
+	sim.objectEvent(simconnect.SystemEventObjectRemoved, object)
+	return true
+}
+
+// objectEvent sends the "ObjectAdded" or "ObjectRemoved" system event for an AI object, if it is subscribed.
+func (sim *Sim) objectEvent(name string, object *AIObject) {
+	recvEvent, ok := sim.systemEvent(name, object.ObjectID)
+	if !ok {
+		return
+	}
+	recvObject := simconnect.RecvEventObjectAddRemove{RecvEvent: recvEvent, ObjType: object.ObjectType}
+	sim.push(simconnect.RecvIDEventObjectAddRemove, &recvObject, nil)
+}
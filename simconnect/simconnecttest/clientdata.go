@@ -0,0 +1,231 @@
+package simconnecttest
+
+import (
+	"fmt"
+
+	"github.com/grumpypixel/msfs2020-simconnect-go/simconnect"
+)
+
+// clientArea is a client data area. Areas created by the client are owned by it and vanish when it disconnects;
+// areas created with CreateClientData belong to another client and stay.
+type clientArea struct {
+	data  []byte
+	owned bool
+}
+
+type clientDatum struct {
+	offset  int
+	size    int
+	datumID DWord
+}
+
+// CreateClientData creates a client data area as another client would, e.g. a WASM module.
+// An existing area of that name is replaced.
+func (sim *Sim) CreateClientData(name string, size int) {
+	sim.mutex.Lock()
+	defer sim.mutex.Unlock()
+	sim.clientAreas[name] = &clientArea{data: make([]byte, size)}
+}
+
+// WriteClientData writes data into the named area at offset as another client would,
+// and sends the area to the requests with simconnect.ClientDataPeriodOnSet.
+func (sim *Sim) WriteClientData(name string, offset int, data []byte) error {
+	sim.mutex.Lock()
+	defer sim.mutex.Unlock()
+	area, ok := sim.clientAreas[name]
+	if !ok {
+		return fmt.Errorf("simconnecttest: no client data area %q", name)
+	}
+	if offset < 0 || offset+len(data) > len(area.data) {
+		return fmt.Errorf("simconnecttest: %d bytes at offset %d exceed client data area %q of %d bytes", len(data), offset, name, len(area.data))
+	}
+	copy(area.data[offset:], data)
+	sim.clientDataSet(name)
+	return nil
+}
+
+// OnClientData calls hook with a copy of the named area whenever the client writes to it with SimConnect_SetClientData,
+// so that tests can play the other client, e.g. a WASM module which reacts to commands. A nil hook removes it.
+// Hooks run after the call has been handled, on the goroutine of the client, and may script the Sim.
+func (sim *Sim) OnClientData(name string, hook func(data []byte)) {
+	sim.mutex.Lock()
+	defer sim.mutex.Unlock()
+	if hook == nil {
+		delete(sim.clientHooks, name)
+		return
+	}
+	sim.clientHooks[name] = hook
+}
+
+// ClientData returns a copy of the named client data area.
+func (sim *Sim) ClientData(name string) ([]byte, bool) {
+	sim.mutex.Lock()
+	defer sim.mutex.Unlock()
+	area, ok := sim.clientAreas[name]
+	if !ok {
+		return nil, false
+	}
+	return append([]byte(nil), area.data...), true
+}
+
+func (sim *Sim) handleClientData(call Call) {
+	args := call.Args
+	switch call.ProcName {
+	case "SimConnect_MapClientDataNameToID":
+		name, _ := args[0].(string)
+		sim.clientNames[dword(args[1])] = name
+
+	case "SimConnect_CreateClientData":
+		name, ok := sim.clientNames[dword(args[0])]
+		if !ok {
+			sim.exception(simconnect.ExceptionUnrecognizedID, call.SendID, 1)
+			return
+		}
+		size := dword(args[1])
+		if size == 0 || size > simconnect.ClientDataMaxSize {
+			sim.exception(simconnect.ExceptionOutOfBounds, call.SendID, 2)
+			return
+		}
+		if _, ok := sim.clientAreas[name]; ok {
+			sim.exception(simconnect.ExceptionAlreadyCreated, call.SendID, 1)
+			return
+		}
+		sim.clientAreas[name] = &clientArea{data: make([]byte, size), owned: true}
+
+	case "SimConnect_AddToClientDataDefinition":
+		defineID := dword(args[0])
+		size := clientDatumSize(dword(args[2]))
+		if size == 0 {
+			sim.exception(simconnect.ExceptionInvalidDataSize, call.SendID, 3)
+			return
+		}
+		definition := sim.clientDefs[defineID]
+		offset := int(dword(args[1]))
+		if dword(args[1]) == simconnect.ClientDataOffsetAuto {
+			offset = 0
+			if n := len(definition); n > 0 {
+				offset = definition[n-1].offset + definition[n-1].size
+			}
+		}
+		sim.clientDefs[defineID] = append(definition, clientDatum{offset: offset, size: size, datumID: dword(args[4])})
+
+	case "SimConnect_ClearClientDataDefinition":
+		delete(sim.clientDefs, dword(args[0]))
+
+	case "SimConnect_SetClientData":
+		name := sim.clientNames[dword(args[0])]
+		area, ok := sim.clientAreas[name]
+		if !ok {
+			sim.exception(simconnect.ExceptionUnrecognizedID, call.SendID, 1)
+			return
+		}
+		definition, ok := sim.clientDefs[dword(args[1])]
+		if !ok {
+			sim.exception(simconnect.ExceptionUnrecognizedID, call.SendID, 2)
+			return
+		}
+		data := args[5].([]byte)
+		for _, datum := range definition {
+			if len(data) < datum.size || datum.offset+datum.size > len(area.data) {
+				sim.exception(simconnect.ExceptionOutOfBounds, call.SendID, 6)
+				return
+			}
+			copy(area.data[datum.offset:], data[:datum.size])
+			data = data[datum.size:]
+		}
+		sim.clientDataSet(name)
+		if hook, ok := sim.clientHooks[name]; ok {
+			content := append([]byte(nil), area.data...)
+			sim.hooks = append(sim.hooks, func() { hook(content) })
+		}
+
+	case "SimConnect_RequestClientData":
+		request := &dataRequest{
+			clientDataID: dword(args[0]),
+			requestID:    dword(args[1]),
+			defineID:     dword(args[2]),
+			period:       dword(args[3]),
+			flags:        dword(args[4]),
+			origin:       dword(args[5]),
+			interval:     dword(args[6]),
+			limit:        dword(args[7]),
+		}
+		if _, ok := sim.clientAreas[sim.clientNames[request.clientDataID]]; !ok {
+			sim.exception(simconnect.ExceptionUnrecognizedID, call.SendID, 1)
+			return
+		}
+		if _, ok := sim.clientDefs[request.defineID]; !ok {
+			sim.exception(simconnect.ExceptionUnrecognizedID, call.SendID, 3)
+			return
+		}
+		switch request.period {
+		case simconnect.ClientDataPeriodNever:
+			delete(sim.clientReqs, request.requestID)
+		case simconnect.ClientDataPeriodOnce:
+			delete(sim.clientReqs, request.requestID)
+			sim.sendClientData(request)
+		default:
+			sim.clientReqs[request.requestID] = request
+		}
+	}
+}
+
+// clientDataSet sends the named area to the requests which want every change of it.
+func (sim *Sim) clientDataSet(name string) {
+	for _, requestID := range sortedKeys(sim.clientReqs) {
+		request := sim.clientReqs[requestID]
+		if request.period == simconnect.ClientDataPeriodOnSet && sim.clientNames[request.clientDataID] == name {
+			sim.sendClientData(request)
+		}
+	}
+}
+
+func (sim *Sim) sendClientData(request *dataRequest) bool {
+	area, ok := sim.clientAreas[sim.clientNames[request.clientDataID]]
+	if !ok {
+		return false
+	}
+	definition := sim.clientDefs[request.defineID]
+	values := make([][]byte, len(definition))
+	datumIDs := make([]DWord, len(definition))
+	for i, datum := range definition {
+		if datum.offset+datum.size > len(area.data) {
+			sim.exception(simconnect.ExceptionOutOfBounds, simconnect.DWordZero, DWord(i))
+			return false
+		}
+		values[i] = append([]byte(nil), area.data[datum.offset:datum.offset+datum.size]...)
+		datumIDs[i] = datum.datumID
+	}
+	payload, count, ok := request.payload(values, datumIDs)
+	if !ok {
+		return false
+	}
+	recvData := simconnect.RecvClientData{RecvSimObjectData: simconnect.RecvSimObjectData{
+		RequestID:   request.requestID,
+		DefineID:    request.defineID,
+		Flags:       request.flags,
+		EntryNumber: 1,
+		OutOf:       1,
+		DefineCount: count,
+	}}
+	sim.push(simconnect.RecvIDClientData, &recvData, payload)
+	return true
+}
+
+// clientDatumSize returns the size of a datum added with SimConnect_AddToClientDataDefinition, 0 if it is invalid.
+func clientDatumSize(sizeOrType DWord) int {
+	switch sizeOrType {
+	case simconnect.ClientDataTypeInt8:
+		return 1
+	case simconnect.ClientDataTypeInt16:
+		return 2
+	case simconnect.ClientDataTypeInt32, simconnect.ClientDataTypeFloat32:
+		return 4
+	case simconnect.ClientDataTypeInt64, simconnect.ClientDataTypeFloat64:
+		return 8
+	}
+	if sizeOrType > simconnect.ClientDataMaxSize {
+		return 0
+	}
+	return int(sizeOrType)
+}
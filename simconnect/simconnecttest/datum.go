@@ -0,0 +1,168 @@
+package simconnecttest
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"github.com/grumpypixel/msfs2020-simconnect-go/simconnect"
+)
+
+var stringSizes = map[DWord]int{
+	simconnect.DataTypeString8:   8,
+	simconnect.DataTypeString32:  32,
+	simconnect.DataTypeString64:  64,
+	simconnect.DataTypeString128: 128,
+	simconnect.DataTypeString256: 256,
+	simconnect.DataTypeString260: 260,
+}
+
+// encodeDatum writes value in the wire format of dataType. A nil value is sent as zero.
+func encodeDatum(w *bytes.Buffer, dataType DWord, value interface{}) error {
+	if size, ok := stringSizes[dataType]; ok {
+		str, _ := value.(string)
+		b := make([]byte, size)
+		copy(b[:size-1], str)
+		w.Write(b)
+		return nil
+	}
+	switch dataType {
+	case simconnect.DataTypeStringV:
+		str, _ := value.(string)
+		w.WriteString(str)
+		w.WriteByte(0)
+		return nil
+
+	case simconnect.DataTypeInt32:
+		number, err := toFloat64(value)
+		if err != nil {
+			return err
+		}
+		return binary.Write(w, binary.LittleEndian, int32(number))
+
+	case simconnect.DataTypeInt64:
+		if v, ok := value.(int64); ok {
+			return binary.Write(w, binary.LittleEndian, v)
+		}
+		number, err := toFloat64(value)
+		if err != nil {
+			return err
+		}
+		return binary.Write(w, binary.LittleEndian, int64(number))
+
+	case simconnect.DataTypeFloat32:
+		number, err := toFloat64(value)
+		if err != nil {
+			return err
+		}
+		return binary.Write(w, binary.LittleEndian, float32(number))
+
+	case simconnect.DataTypeFloat64:
+		number, err := toFloat64(value)
+		if err != nil {
+			return err
+		}
+		return binary.Write(w, binary.LittleEndian, number)
+
+	case simconnect.DataTypeInitPosition:
+		v, _ := value.(simconnect.InitPosition)
+		return binary.Write(w, binary.LittleEndian, v)
+
+	case simconnect.DataTypeLatLonAlt:
+		v, _ := value.(simconnect.LatLonAlt)
+		return binary.Write(w, binary.LittleEndian, v)
+
+	case simconnect.DataTypeXYZ:
+		v, _ := value.(simconnect.XYZ)
+		return binary.Write(w, binary.LittleEndian, v)
+	}
+	return fmt.Errorf("unsupported data type %s", simconnect.DataTypeToString(dataType))
+}
+
+// decodeDatum reads a single value of dataType as written by a client with SetDataOnSimObject.
+func decodeDatum(r *bytes.Reader, dataType DWord) (interface{}, error) {
+	if size, ok := stringSizes[dataType]; ok {
+		b := make([]byte, size)
+		if _, err := io.ReadFull(r, b); err != nil {
+			return nil, err
+		}
+		return string(bytes.TrimRight(b, "\x00")), nil
+	}
+	var value interface{}
+	switch dataType {
+	case simconnect.DataTypeStringV:
+		var b []byte
+		for {
+			c, err := r.ReadByte()
+			if err != nil {
+				return nil, err
+			}
+			if c == 0 {
+				return string(b), nil
+			}
+			b = append(b, c)
+		}
+	case simconnect.DataTypeInt32:
+		value = new(int32)
+	case simconnect.DataTypeInt64:
+		value = new(int64)
+	case simconnect.DataTypeFloat32:
+		value = new(float32)
+	case simconnect.DataTypeFloat64:
+		value = new(float64)
+	case simconnect.DataTypeInitPosition:
+		value = new(simconnect.InitPosition)
+	case simconnect.DataTypeLatLonAlt:
+		value = new(simconnect.LatLonAlt)
+	case simconnect.DataTypeXYZ:
+		value = new(simconnect.XYZ)
+	default:
+		return nil, fmt.Errorf("unsupported data type %s", simconnect.DataTypeToString(dataType))
+	}
+	if err := binary.Read(r, binary.LittleEndian, value); err != nil {
+		return nil, err
+	}
+	switch v := value.(type) {
+	case *int32:
+		return *v, nil
+	case *int64:
+		return *v, nil
+	case *float32:
+		return *v, nil
+	case *float64:
+		return *v, nil
+	case *simconnect.InitPosition:
+		return *v, nil
+	case *simconnect.LatLonAlt:
+		return *v, nil
+	case *simconnect.XYZ:
+		return *v, nil
+	}
+	return nil, nil
+}
+
+func toFloat64(value interface{}) (float64, error) {
+	switch v := value.(type) {
+	case nil:
+		return 0, nil
+	case float64:
+		return v, nil
+	case float32:
+		return float64(v), nil
+	case int32:
+		return float64(v), nil
+	case int64:
+		return float64(v), nil
+	case int:
+		return float64(v), nil
+	case DWord:
+		return float64(v), nil
+	case bool:
+		if v {
+			return 1, nil
+		}
+		return 0, nil
+	}
+	return 0, fmt.Errorf("cannot send %T as a number", value)
+}
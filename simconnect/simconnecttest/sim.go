@@ -0,0 +1,1000 @@
+// Package simconnecttest provides a scripted, in-process fake of the Flight Simulator's SimConnect server.
+//
+// A Sim implements simconnect.Transport, so it can be plugged into a SimConnect or a SimMate.
+// Tests set simulation variables, fire system events and inject exceptions, and advance the
+// simulation frame by frame with Tick. Values are handed out exactly as they were set; units are
+// not converted.
+package simconnecttest
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"unsafe"
+
+	"github.com/grumpypixel/msfs2020-simconnect-go/simconnect"
+)
+
+type DWord = simconnect.DWord
+
+const (
+	// UserObjectID is the object ID of the user aircraft as reported in data messages.
+	UserObjectID DWord = 1
+	// ApplicationName is the application name reported in RecvOpen.
+	ApplicationName = "KittyHawk"
+)
+
+var (
+	ErrNotOpen     = errors.New("simconnecttest: not open")
+	ErrAlreadyOpen = errors.New("simconnecttest: already open")
+	ErrOffline     = errors.New("simconnecttest: simulator is not running")
+)
+
+// Call records a single SimConnect function call received by the Sim.
+type Call struct {
+	ProcName string
+	Args     []interface{}
+	SendID   DWord
+}
+
+// TransmittedEvent records a client event sent with SimConnect_TransmitClientEvent or SimConnect_TransmitClientEvent_EX1.
+type TransmittedEvent struct {
+	ObjectID DWord
+	EventID  DWord
+	Name     string
+	Data     DWord   // the first parameter
+	Params   []DWord // all five parameters, for SimConnect_TransmitClientEvent_EX1 only
+	GroupID  DWord
+	Flags    DWord
+}
+
+// HandlerFunc overrides the Sim's handling of a SimConnect function.
+// Returning an error makes the call fail as if the DLL had returned a failure HRESULT.
+type HandlerFunc func(sim *Sim, call Call) error
+
+type datum struct {
+	name     string
+	unit     string
+	dataType DWord
+	epsilon  float32
+	datumID  DWord
+}
+
+type dataRequest struct {
+	requestID    DWord
+	defineID     DWord
+	objectID     DWord
+	clientDataID DWord // for SimConnect_RequestClientData
+	period       DWord
+	flags        DWord
+	origin       DWord
+	interval     DWord
+	limit        DWord
+	frames       DWord
+	sent         DWord
+	last         [][]byte
+}
+
+type systemEvent struct {
+	eventID DWord
+	enabled bool
+}
+
+// facilitySubscription is a subscription to the changes of the facilities cache of a list type.
+// Subscriptions made with SimConnect_SubscribeToFacilities have no outOfRange request.
+type facilitySubscription struct {
+	inRange    DWord
+	outOfRange DWord
+	ex1        bool
+}
+
+// Sim is a fake SimConnect server. The zero value is not usable, create one with NewSim.
+type Sim struct {
+	mutex         sync.Mutex
+	open          bool
+	offline       bool
+	clientName    string
+	sendID        DWord
+	vars          map[string]interface{}
+	systemStates  map[string]interface{}
+	definitions   map[DWord][]datum
+	requests      map[DWord]*dataRequest
+	clientEvents  map[DWord]string
+	groupEvents   map[DWord]DWord // event ID -> notification group ID
+	systemEvents  map[string]*systemEvent
+	facilities    [simconnect.FacilityListTypeCount]interface{} // slices of the simconnect.DataFacility structs by list type
+	listSize      int                                           // the number of facilities per list message, 0 for all
+	facilitySubs  [simconnect.FacilityListTypeCount]*facilitySubscription
+	clientAreas   map[string]*clientArea
+	clientNames   map[DWord]string // client data ID -> area name
+	clientDefs    map[DWord][]clientDatum
+	clientReqs    map[DWord]*dataRequest
+	clientHooks   map[string]func(data []byte)
+	aiObjects     map[DWord]*AIObject
+	nextObjectID  DWord
+	hooks         []func() // run once the call which triggered them has been handled
+	handlers      map[string]HandlerFunc
+	calls         []Call
+	transmitted   []TransmittedEvent
+	queue         [][]byte
+	current       []byte
+	pendingErrors []error
+	notify        chan struct{}
+}
+
+func NewSim() *Sim {
+	return &Sim{
+		vars:         make(map[string]interface{}),
+		systemStates: make(map[string]interface{}),
+		definitions:  make(map[DWord][]datum),
+		requests:     make(map[DWord]*dataRequest),
+		clientEvents: make(map[DWord]string),
+		groupEvents:  make(map[DWord]DWord),
+		systemEvents: make(map[string]*systemEvent),
+		clientAreas:  make(map[string]*clientArea),
+		clientNames:  make(map[DWord]string),
+		clientDefs:   make(map[DWord][]clientDatum),
+		clientReqs:   make(map[DWord]*dataRequest),
+		clientHooks:  make(map[string]func(data []byte)),
+		aiObjects:    make(map[DWord]*AIObject),
+		handlers:     make(map[string]HandlerFunc),
+		notify:       make(chan struct{}, 1),
+	}
+}
+
+// Transport implementation
+
+func (sim *Sim) Open(name string, configIndex DWord) error {
+	sim.mutex.Lock()
+	defer sim.mutex.Unlock()
+	if sim.open {
+		return ErrAlreadyOpen
+	}
+	if sim.offline {
+		return ErrOffline
+	}
+	sim.open = true
+	sim.clientName = name
+	sim.sendID = 0
+	sim.queue = nil
+
+	var recvOpen simconnect.RecvOpen
+	copy(recvOpen.ApplicationName[:], ApplicationName)
+	recvOpen.ApplicationVersionMajor = 11
+	recvOpen.ApplicationVersionMinor = 0
+	recvOpen.ApplicationBuildMajor = 282174
+	recvOpen.ApplicationBuildMinor = 999
+	recvOpen.SimConnectVersionMajor = 11
+	recvOpen.SimConnectVersionMinor = 0
+	recvOpen.SimConnectBuildMajor = 62651
+	recvOpen.SimConnectBuildMinor = 3
+	sim.push(simconnect.RecvIDOpen, &recvOpen, nil)
+	return nil
+}
+
+func (sim *Sim) Close() error {
+	sim.mutex.Lock()
+	defer sim.mutex.Unlock()
+	if !sim.open {
+		return ErrNotOpen
+	}
+	sim.open = false
+	// The server forgets everything the client has set up.
+	sim.definitions = make(map[DWord][]datum)
+	sim.requests = make(map[DWord]*dataRequest)
+	sim.clientEvents = make(map[DWord]string)
+	sim.groupEvents = make(map[DWord]DWord)
+	sim.systemEvents = make(map[string]*systemEvent)
+	sim.clientNames = make(map[DWord]string)
+	sim.clientDefs = make(map[DWord][]clientDatum)
+	sim.clientReqs = make(map[DWord]*dataRequest)
+	sim.facilitySubs = [simconnect.FacilityListTypeCount]*facilitySubscription{}
+	for name, area := range sim.clientAreas {
+		if area.owned {
+			delete(sim.clientAreas, name)
+		}
+	}
+	sim.queue = nil
+	sim.current = nil
+	return nil
+}
+
+func (sim *Sim) Call(procName string, args ...interface{}) error {
+	sim.mutex.Lock()
+	if !sim.open {
+		sim.mutex.Unlock()
+		return ErrNotOpen
+	}
+	if procName == "SimConnect_GetLastSentPacketID" {
+		defer sim.mutex.Unlock()
+		if len(args) == 1 {
+			if pdwError, ok := args[0].(*DWord); ok && pdwError != nil {
+				*pdwError = sim.sendID
+				return nil
+			}
+		}
+		return fmt.Errorf("%s: invalid arguments", procName)
+	}
+
+	sim.sendID++
+	call := Call{ProcName: procName, Args: copyArgs(args), SendID: sim.sendID}
+	sim.calls = append(sim.calls, call)
+	if len(sim.pendingErrors) > 0 {
+		err := sim.pendingErrors[0]
+		sim.pendingErrors = sim.pendingErrors[1:]
+		sim.mutex.Unlock()
+		return err
+	}
+	if handler, ok := sim.handlers[procName]; ok {
+		// Handlers may script the Sim, so they run unlocked.
+		sim.mutex.Unlock()
+		return handler(sim, call)
+	}
+	return sim.handleAndUnlock(call)
+}
+
+// Notify implements simconnect.Notifier, so SimConnect.Run wakes up as soon as a message is queued.
+func (sim *Sim) Notify() <-chan struct{} {
+	return sim.notify
+}
+
+// LastSendID implements simconnect.SendIDReporter. It is the packet ID which GetLastSentPacketID returns.
+func (sim *Sim) LastSendID() DWord {
+	sim.mutex.Lock()
+	defer sim.mutex.Unlock()
+	return sim.sendID
+}
+
+func (sim *Sim) GetNextDispatch() (unsafe.Pointer, DWord, error) {
+	sim.mutex.Lock()
+	defer sim.mutex.Unlock()
+	if !sim.open {
+		return nil, 0, ErrNotOpen
+	}
+	if len(sim.queue) == 0 {
+		sim.current = nil
+		return nil, 0, nil
+	}
+	sim.current = sim.queue[0]
+	sim.queue[0] = nil
+	sim.queue = sim.queue[1:]
+	return unsafe.Pointer(&sim.current[0]), DWord(len(sim.current)), nil
+}
+
+// Scripting
+
+// SetSimVar sets the value of a simulation variable. Names are case-insensitive and include the
+// index, e.g. "COM ACTIVE FREQUENCY:1". Supported values are int32, int64, float32, float64,
+// string, simconnect.InitPosition, simconnect.LatLonAlt and simconnect.XYZ.
+func (sim *Sim) SetSimVar(name string, value interface{}) {
+	sim.mutex.Lock()
+	defer sim.mutex.Unlock()
+	sim.vars[normalizeName(name)] = value
+}
+
+// SimVar returns the current value of a simulation variable.
+func (sim *Sim) SimVar(name string) (interface{}, bool) {
+	sim.mutex.Lock()
+	defer sim.mutex.Unlock()
+	value, ok := sim.vars[normalizeName(name)]
+	return value, ok
+}
+
+// SetSystemState sets the value returned by SimConnect_RequestSystemState for the given state.
+// The value can be a DWord, a float32 or a string.
+func (sim *Sim) SetSystemState(state string, value interface{}) {
+	sim.mutex.Lock()
+	defer sim.mutex.Unlock()
+	sim.systemStates[strings.ToLower(state)] = value
+}
+
+// SetAirports sets the airports in the facilities cache, returned by SimConnect_RequestFacilitiesList.
+// Facility subscriptions are sent the airports which have come into range, and with
+// SimConnect_SubscribeToFacilities_EX1 also those which have gone out of range, as identified by their ICAO.
+func (sim *Sim) SetAirports(airports ...simconnect.DataFacilityAirport) {
+	sim.setFacilities(simconnect.FacilityListTypeAirport, airports)
+}
+
+// SetWaypoints sets the waypoints in the facilities cache.
+func (sim *Sim) SetWaypoints(waypoints ...simconnect.DataFacilityWaypoint) {
+	sim.setFacilities(simconnect.FacilityListTypeWaypoint, waypoints)
+}
+
+// SetNDBs sets the NDBs in the facilities cache.
+func (sim *Sim) SetNDBs(ndbs ...simconnect.DataFacilityNDB) {
+	sim.setFacilities(simconnect.FacilityListTypeNDB, ndbs)
+}
+
+// SetVORs sets the VORs in the facilities cache.
+func (sim *Sim) SetVORs(vors ...simconnect.DataFacilityVOR) {
+	sim.setFacilities(simconnect.FacilityListTypeVOR, vors)
+}
+
+// SetListSize chops facility lists into messages of at most size entries, as the simulator does with long lists.
+// Zero, the default, sends every list in one message.
+func (sim *Sim) SetListSize(size int) {
+	sim.mutex.Lock()
+	defer sim.mutex.Unlock()
+	sim.listSize = size
+}
+
+// Handle overrides the handling of the named SimConnect function, e.g. "SimConnect_RequestSystemState".
+func (sim *Sim) Handle(procName string, handler HandlerFunc) {
+	sim.mutex.Lock()
+	defer sim.mutex.Unlock()
+	if handler == nil {
+		delete(sim.handlers, procName)
+		return
+	}
+	sim.handlers[procName] = handler
+}
+
+// Default runs the Sim's built-in handling of a call. Handlers can use it to fall back to the default behaviour.
+func (sim *Sim) Default(call Call) error {
+	sim.mutex.Lock()
+	return sim.handleAndUnlock(call)
+}
+
+// FailNextCall makes the next SimConnect function call return err.
+func (sim *Sim) FailNextCall(err error) {
+	sim.mutex.Lock()
+	defer sim.mutex.Unlock()
+	sim.pendingErrors = append(sim.pendingErrors, err)
+}
+
+// FireSystemEvent sends a RecvEvent for the named system event (e.g. "Pause") if the client
+// has subscribed to it. It returns false if there is no active subscription.
+func (sim *Sim) FireSystemEvent(name string, data DWord) bool {
+	sim.mutex.Lock()
+	defer sim.mutex.Unlock()
+	recvEvent, ok := sim.systemEvent(name, data)
+	if !ok {
+		return false
+	}
+	sim.push(simconnect.RecvIDEvent, &recvEvent, nil)
+	return true
+}
+
+// FireFilenameEvent sends a RecvEventFilename for a system event such as "FlightLoaded" or "AircraftLoaded".
+func (sim *Sim) FireFilenameEvent(name string, fileName string) bool {
+	sim.mutex.Lock()
+	defer sim.mutex.Unlock()
+	recvEvent, ok := sim.systemEvent(name, 0)
+	if !ok {
+		return false
+	}
+	recvFilename := simconnect.RecvEventFilename{RecvEvent: recvEvent}
+	copy(recvFilename.FileName[:len(recvFilename.FileName)-1], fileName)
+	sim.push(simconnect.RecvIDEventFilename, &recvFilename, nil)
+	return true
+}
+
+// FireFrameEvent sends a RecvEventFrame for the "Frame" system event.
+func (sim *Sim) FireFrameEvent(frameRate, simSpeed float32) bool {
+	sim.mutex.Lock()
+	defer sim.mutex.Unlock()
+	recvEvent, ok := sim.systemEvent("Frame", 0)
+	if !ok {
+		return false
+	}
+	recvFrame := simconnect.RecvEventFrame{RecvEvent: recvEvent, FrameRate: frameRate, SimSpeed: simSpeed}
+	sim.push(simconnect.RecvIDEventFrame, &recvFrame, nil)
+	return true
+}
+
+// FireObjectEvent sends a RecvEventObjectAddRemove for the "ObjectAdded" or "ObjectRemoved" system event.
+func (sim *Sim) FireObjectEvent(name string, objectID, objectType DWord) bool {
+	sim.mutex.Lock()
+	defer sim.mutex.Unlock()
+	recvEvent, ok := sim.systemEvent(name, objectID)
+	if !ok {
+		return false
+	}
+	recvObject := simconnect.RecvEventObjectAddRemove{RecvEvent: recvEvent, ObjType: objectType}
+	sim.push(simconnect.RecvIDEventObjectAddRemove, &recvObject, nil)
+	return true
+}
+
+// InjectException sends a RecvException for the last call the Sim received.
+func (sim *Sim) InjectException(exception DWord, index DWord) {
+	sim.mutex.Lock()
+	defer sim.mutex.Unlock()
+	sim.exception(exception, sim.sendID, index)
+}
+
+// InjectExceptionFor sends a RecvException for the call with the given send ID.
+func (sim *Sim) InjectExceptionFor(sendID DWord, exception DWord, index DWord) {
+	sim.mutex.Lock()
+	defer sim.mutex.Unlock()
+	sim.exception(exception, sendID, index)
+}
+
+// Quit sends a RecvQuit as if the simulator was shutting down.
+func (sim *Sim) Quit() {
+	sim.mutex.Lock()
+	defer sim.mutex.Unlock()
+	var recvQuit simconnect.RecvQuit
+	sim.push(simconnect.RecvIDQuit, &recvQuit, nil)
+}
+
+// SetOffline simulates a simulator which is not running: Open fails with ErrOffline until SetOffline(false).
+// Going offline sends a RecvQuit to an open connection first.
+func (sim *Sim) SetOffline(offline bool) {
+	sim.mutex.Lock()
+	defer sim.mutex.Unlock()
+	if offline && !sim.offline {
+		var recvQuit simconnect.RecvQuit
+		sim.push(simconnect.RecvIDQuit, &recvQuit, nil)
+	}
+	sim.offline = offline
+}
+
+// Push queues a raw message. The Size, Version and ID header fields are sent as given.
+func (sim *Sim) Push(message []byte) {
+	sim.mutex.Lock()
+	defer sim.mutex.Unlock()
+	sim.queue = append(sim.queue, append([]byte(nil), message...))
+	sim.signal()
+}
+
+// Tick advances the simulation by one frame and sends the data of all periodic requests which are due.
+// Every period (visual frame, sim frame and second) counts as one frame.
+func (sim *Sim) Tick() {
+	sim.mutex.Lock()
+	defer sim.mutex.Unlock()
+	for _, requestID := range sortedKeys(sim.requests) {
+		request := sim.requests[requestID]
+		switch request.period {
+		case simconnect.PeriodVisualFrame, simconnect.PeriodSimFrame, simconnect.PeriodSecond:
+		default:
+			continue
+		}
+		request.frames++
+		if request.frames <= request.origin {
+			continue
+		}
+		if (request.frames-request.origin-1)%(request.interval+1) != 0 {
+			continue
+		}
+		if sim.sendObjectData(simconnect.RecvIDSimobjectData, request, 1, 1) {
+			request.sent++
+			if request.limit > 0 && request.sent >= request.limit {
+				delete(sim.requests, requestID)
+			}
+		}
+	}
+	for _, requestID := range sortedKeys(sim.clientReqs) {
+		request := sim.clientReqs[requestID]
+		switch request.period {
+		case simconnect.ClientDataPeriodVisualFrame, simconnect.ClientDataPeriodSecond:
+		default:
+			continue
+		}
+		request.frames++
+		if request.frames <= request.origin {
+			continue
+		}
+		if (request.frames-request.origin-1)%(request.interval+1) != 0 {
+			continue
+		}
+		if sim.sendClientData(request) {
+			request.sent++
+			if request.limit > 0 && request.sent >= request.limit {
+				delete(sim.clientReqs, requestID)
+			}
+		}
+	}
+}
+
+// ClientName returns the name the client passed to SimConnect_Open.
+func (sim *Sim) ClientName() string {
+	sim.mutex.Lock()
+	defer sim.mutex.Unlock()
+	return sim.clientName
+}
+
+// Pending returns the number of messages waiting to be dispatched.
+func (sim *Sim) Pending() int {
+	sim.mutex.Lock()
+	defer sim.mutex.Unlock()
+	return len(sim.queue)
+}
+
+// Calls returns all calls received since the last Reset.
+func (sim *Sim) Calls() []Call {
+	sim.mutex.Lock()
+	defer sim.mutex.Unlock()
+	return append([]Call(nil), sim.calls...)
+}
+
+// CallsTo returns all calls to the named SimConnect function.
+func (sim *Sim) CallsTo(procName string) []Call {
+	sim.mutex.Lock()
+	defer sim.mutex.Unlock()
+	calls := make([]Call, 0)
+	for _, call := range sim.calls {
+		if call.ProcName == procName {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// Transmitted returns all client events transmitted since the last Reset.
+func (sim *Sim) Transmitted() []TransmittedEvent {
+	sim.mutex.Lock()
+	defer sim.mutex.Unlock()
+	return append([]TransmittedEvent(nil), sim.transmitted...)
+}
+
+// Reset forgets all recorded calls and transmitted events.
+func (sim *Sim) Reset() {
+	sim.mutex.Lock()
+	defer sim.mutex.Unlock()
+	sim.calls = nil
+	sim.transmitted = nil
+}
+
+// handleAndUnlock handles a call, unlocks the Sim and then runs the hooks the call has triggered,
+// since hooks may script the Sim.
+func (sim *Sim) handleAndUnlock(call Call) error {
+	err := sim.handle(call)
+	hooks := sim.hooks
+	sim.hooks = nil
+	sim.mutex.Unlock()
+	for _, hook := range hooks {
+		hook()
+	}
+	return err
+}
+
+func (sim *Sim) handle(call Call) error {
+	args := call.Args
+	switch call.ProcName {
+	case "SimConnect_AddToDataDefinition":
+		unit, _ := args[2].(string)
+		sim.definitions[dword(args[0])] = append(sim.definitions[dword(args[0])], datum{
+			name:     normalizeName(args[1].(string)),
+			unit:     unit,
+			dataType: dword(args[3]),
+			epsilon:  args[4].(float32),
+			datumID:  dword(args[5]),
+		})
+
+	case "SimConnect_ClearDataDefinition":
+		delete(sim.definitions, dword(args[0]))
+
+	case "SimConnect_RequestDataOnSimObject":
+		request := &dataRequest{
+			requestID: dword(args[0]),
+			defineID:  dword(args[1]),
+			objectID:  dword(args[2]),
+			period:    dword(args[3]),
+			flags:     dword(args[4]),
+			origin:    dword(args[5]),
+			interval:  dword(args[6]),
+			limit:     dword(args[7]),
+		}
+		if _, ok := sim.definitions[request.defineID]; !ok {
+			sim.exception(simconnect.ExceptionUnrecognizedID, call.SendID, 2)
+			return nil
+		}
+		switch request.period {
+		case simconnect.PeriodNever:
+			delete(sim.requests, request.requestID)
+		case simconnect.PeriodOnce:
+			delete(sim.requests, request.requestID)
+			sim.sendObjectData(simconnect.RecvIDSimobjectData, request, 1, 1)
+		default:
+			sim.requests[request.requestID] = request
+		}
+
+	case "SimConnect_RequestDataOnSimObjectType":
+		request := &dataRequest{
+			requestID: dword(args[0]),
+			defineID:  dword(args[1]),
+		}
+		if _, ok := sim.definitions[request.defineID]; !ok {
+			sim.exception(simconnect.ExceptionUnrecognizedID, call.SendID, 2)
+			return nil
+		}
+		sim.sendObjectData(simconnect.RecvIDSimObjectDataByType, request, 1, 1)
+
+	case "SimConnect_SetDataOnSimObject":
+		defineID := dword(args[0])
+		definition, ok := sim.definitions[defineID]
+		if !ok {
+			sim.exception(simconnect.ExceptionUnrecognizedID, call.SendID, 1)
+			return nil
+		}
+		if err := sim.setData(definition, args[5].([]byte)); err != nil {
+			sim.exception(simconnect.ExceptionDataError, call.SendID, 6)
+		}
+
+	case "SimConnect_MapClientEventToSimEvent":
+		name, _ := args[1].(string)
+		sim.clientEvents[dword(args[0])] = strings.ToUpper(name)
+
+	case "SimConnect_AddClientEventToNotificationGroup":
+		sim.groupEvents[dword(args[1])] = dword(args[0])
+
+	case "SimConnect_RemoveClientEvent":
+		delete(sim.groupEvents, dword(args[1]))
+
+	case "SimConnect_ClearNotificationGroup":
+		groupID := dword(args[0])
+		for eventID, id := range sim.groupEvents {
+			if id == groupID {
+				delete(sim.groupEvents, eventID)
+			}
+		}
+
+	case "SimConnect_TransmitClientEvent", "SimConnect_TransmitClientEvent_EX1":
+		eventID := dword(args[1])
+		name, ok := sim.clientEvents[eventID]
+		if !ok {
+			sim.exception(simconnect.ExceptionUnrecognizedID, call.SendID, 2)
+			return nil
+		}
+		event := TransmittedEvent{
+			ObjectID: dword(args[0]),
+			EventID:  eventID,
+			Name:     name,
+		}
+		if call.ProcName == "SimConnect_TransmitClientEvent" {
+			event.Data, event.GroupID, event.Flags = dword(args[2]), dword(args[3]), dword(args[4])
+		} else {
+			event.GroupID, event.Flags = dword(args[2]), dword(args[3])
+			for _, arg := range args[4:] {
+				event.Params = append(event.Params, dword(arg))
+			}
+			event.Data = event.Params[0]
+		}
+		sim.transmitted = append(sim.transmitted, event)
+		// SIMCONNECT_RECV_EVENT only carries the first parameter.
+		if groupID, ok := sim.groupEvents[eventID]; ok {
+			recvEvent := simconnect.RecvEvent{
+				GroupID: groupID,
+				EventID: eventID,
+				Data:    event.Data,
+			}
+			sim.push(simconnect.RecvIDEvent, &recvEvent, nil)
+		}
+
+	case "SimConnect_SubscribeToSystemEvent":
+		name := strings.ToLower(args[1].(string))
+		sim.systemEvents[name] = &systemEvent{eventID: dword(args[0]), enabled: true}
+
+	case "SimConnect_UnsubscribeFromSystemEvent":
+		eventID := dword(args[0])
+		for name, subscription := range sim.systemEvents {
+			if subscription.eventID == eventID {
+				delete(sim.systemEvents, name)
+			}
+		}
+
+	case "SimConnect_SetSystemEventState":
+		eventID := dword(args[0])
+		for _, subscription := range sim.systemEvents {
+			if subscription.eventID == eventID {
+				subscription.enabled = dword(args[1]) == simconnect.StateOn
+			}
+		}
+
+	case "SimConnect_RequestSystemState":
+		value, ok := sim.systemStates[strings.ToLower(args[1].(string))]
+		if !ok {
+			sim.exception(simconnect.ExceptionNameUnrecognized, call.SendID, 2)
+			return nil
+		}
+		recvState := simconnect.RecvSystemState{RequestID: dword(args[0])}
+		switch v := value.(type) {
+		case DWord:
+			recvState.Integer = v
+		case bool:
+			if v {
+				recvState.Integer = 1
+			}
+		case float32:
+			recvState.Float = v
+		case string:
+			copy(recvState.String[:len(recvState.String)-1], v)
+		}
+		sim.push(simconnect.RecvIDSystemState, &recvState, nil)
+
+	case "SimConnect_MapClientDataNameToID", "SimConnect_CreateClientData", "SimConnect_AddToClientDataDefinition",
+		"SimConnect_ClearClientDataDefinition", "SimConnect_SetClientData", "SimConnect_RequestClientData":
+		sim.handleClientData(call)
+
+	case "SimConnect_AICreateEnrouteATCAircraft", "SimConnect_AICreateNonATCAircraft", "SimConnect_AICreateParkedATCAircraft",
+		"SimConnect_AICreateSimulatedObject", "SimConnect_AIRemoveObject", "SimConnect_AIReleaseControl", "SimConnect_AISetAircraftFlightPlan":
+		sim.handleAI(call)
+
+	case "SimConnect_RequestFacilitiesList":
+		listType := dword(args[0])
+		if listType >= simconnect.FacilityListTypeCount {
+			sim.exception(simconnect.ExceptionInvalidEnum, call.SendID, 1)
+			return nil
+		}
+		sim.sendFacilities(listType, dword(args[1]), reflect.ValueOf(sim.facilities[listType]))
+
+	case "SimConnect_SubscribeToFacilities", "SimConnect_SubscribeToFacilities_EX1":
+		listType := dword(args[0])
+		if listType >= simconnect.FacilityListTypeCount {
+			sim.exception(simconnect.ExceptionInvalidEnum, call.SendID, 1)
+			return nil
+		}
+		subscription := &facilitySubscription{inRange: dword(args[1])}
+		if call.ProcName == "SimConnect_SubscribeToFacilities_EX1" {
+			subscription.outOfRange, subscription.ex1 = dword(args[2]), true
+		}
+		sim.facilitySubs[listType] = subscription
+		sim.sendFacilities(listType, subscription.inRange, reflect.ValueOf(sim.facilities[listType]))
+
+	case "SimConnect_UnsubscribeToFacilities":
+		listType := dword(args[0])
+		if listType >= simconnect.FacilityListTypeCount {
+			sim.exception(simconnect.ExceptionInvalidEnum, call.SendID, 1)
+			return nil
+		}
+		sim.facilitySubs[listType] = nil
+	}
+	return nil
+}
+
+// sendFacilities sends items, a slice of facilities of a list type, chopped into messages of at most listSize entries.
+// An empty list is sent as a single message without entries.
+func (sim *Sim) sendFacilities(listType, requestID DWord, items reflect.Value) {
+	recvIDs := [...]DWord{
+		simconnect.FacilityListTypeAirport:  simconnect.RecvIDAirportList,
+		simconnect.FacilityListTypeWaypoint: simconnect.RecvIDWaypointList,
+		simconnect.FacilityListTypeNDB:      simconnect.RecvIDNDBList,
+		simconnect.FacilityListTypeVOR:      simconnect.RecvIDVORList,
+	}
+	count := 0
+	if items.IsValid() {
+		count = items.Len()
+	}
+	size := sim.listSize
+	if size <= 0 || size > count {
+		size = count
+	}
+	outOf := 1
+	if size > 0 {
+		outOf = (count + size - 1) / size
+	}
+	for i := 0; i < outOf; i++ {
+		recvList := simconnect.RecvFacilitiesList{
+			RequestID:   requestID,
+			EntryNumber: DWord(i),
+			OutOf:       DWord(outOf),
+		}
+		var payload bytes.Buffer
+		if count > 0 {
+			end := (i + 1) * size
+			if end > count {
+				end = count
+			}
+			recvList.ArraySize = DWord(end - i*size)
+			binary.Write(&payload, binary.LittleEndian, items.Slice(i*size, end).Interface())
+		}
+		sim.push(recvIDs[listType], &recvList, payload.Bytes())
+	}
+}
+
+// setFacilities replaces the facilities cache of a list type. A subscription is sent the facilities which
+// have been added, and with SimConnect_SubscribeToFacilities_EX1 also those which have been removed.
+func (sim *Sim) setFacilities(listType DWord, facilities interface{}) {
+	sim.mutex.Lock()
+	defer sim.mutex.Unlock()
+	old := reflect.ValueOf(sim.facilities[listType])
+	sim.facilities[listType] = facilities
+	subscription := sim.facilitySubs[listType]
+	if subscription == nil {
+		return
+	}
+	items := reflect.ValueOf(facilities)
+	if added := facilitiesMissing(items, old); added.Len() > 0 {
+		sim.sendFacilities(listType, subscription.inRange, added)
+	}
+	if !subscription.ex1 || !old.IsValid() {
+		return
+	}
+	if removed := facilitiesMissing(old, items); removed.Len() > 0 {
+		sim.sendFacilities(listType, subscription.outOfRange, removed)
+	}
+}
+
+// facilitiesMissing returns the facilities of items whose ICAO is not in others.
+func facilitiesMissing(items, others reflect.Value) reflect.Value {
+	known := make(map[[9]byte]bool)
+	if others.IsValid() {
+		for i := 0; i < others.Len(); i++ {
+			known[others.Index(i).FieldByName("Icao").Interface().([9]byte)] = true
+		}
+	}
+	missing := reflect.MakeSlice(items.Type(), 0, 0)
+	for i := 0; i < items.Len(); i++ {
+		if !known[items.Index(i).FieldByName("Icao").Interface().([9]byte)] {
+			missing = reflect.Append(missing, items.Index(i))
+		}
+	}
+	return missing
+}
+
+func (sim *Sim) sendObjectData(recvID DWord, request *dataRequest, entryNumber, outOf DWord) bool {
+	definition := sim.definitions[request.defineID]
+	values := make([][]byte, len(definition))
+	datumIDs := make([]DWord, len(definition))
+	for i, datum := range definition {
+		var buf bytes.Buffer
+		if err := encodeDatum(&buf, datum.dataType, sim.vars[datum.name]); err != nil {
+			sim.exception(simconnect.ExceptionDataError, simconnect.DWordZero, DWord(i))
+			return false
+		}
+		values[i] = buf.Bytes()
+		datumIDs[i] = datum.datumID
+	}
+	payload, count, ok := request.payload(values, datumIDs)
+	if !ok {
+		return false
+	}
+
+	objectID := request.objectID
+	if objectID == simconnect.ObjectIDUser {
+		objectID = UserObjectID
+	}
+	recvData := simconnect.RecvSimObjectData{
+		RequestID:   request.requestID,
+		ObjectID:    objectID,
+		DefineID:    request.defineID,
+		Flags:       request.flags,
+		EntryNumber: entryNumber,
+		OutOf:       outOf,
+		DefineCount: count,
+	}
+	sim.push(recvID, &recvData, payload)
+	return true
+}
+
+// payload lays out the values of a data set as requested by the flags of request. It returns false if nothing
+// is to be sent because the request only wants changes and nothing has changed.
+// The flags of SimConnect_RequestDataOnSimObject and SimConnect_RequestClientData have the same values.
+func (request *dataRequest) payload(values [][]byte, datumIDs []DWord) ([]byte, DWord, bool) {
+	changed := request.flags&simconnect.DataRequestFlagChanged != 0
+	tagged := request.flags&simconnect.DataRequestFlagTagged != 0
+	if changed && request.last != nil && equalValues(request.last, values) {
+		return nil, 0, false
+	}
+	var payload bytes.Buffer
+	count := DWord(0)
+	for i, value := range values {
+		if tagged {
+			// Tagged data only contains the datums which have changed, each prefixed with its datum ID.
+			if changed && request.last != nil && bytes.Equal(request.last[i], value) {
+				continue
+			}
+			datumID := datumIDs[i]
+			if datumID == simconnect.Unused {
+				datumID = DWord(i)
+			}
+			binary.Write(&payload, binary.LittleEndian, datumID)
+		}
+		payload.Write(value)
+		count++
+	}
+	request.last = values
+	return payload.Bytes(), count, true
+}
+
+func (sim *Sim) setData(definition []datum, data []byte) error {
+	r := bytes.NewReader(data)
+	for _, datum := range definition {
+		value, err := decodeDatum(r, datum.dataType)
+		if err != nil {
+			return err
+		}
+		sim.vars[datum.name] = value
+	}
+	return nil
+}
+
+// systemEvent returns the RecvEvent for a system event if the client has an active subscription to it.
+func (sim *Sim) systemEvent(name string, data DWord) (simconnect.RecvEvent, bool) {
+	subscription, ok := sim.systemEvents[strings.ToLower(name)]
+	if !ok || !subscription.enabled {
+		return simconnect.RecvEvent{}, false
+	}
+	return simconnect.RecvEvent{
+		GroupID: simconnect.DWordMax,
+		EventID: subscription.eventID,
+		Data:    data,
+	}, true
+}
+
+func (sim *Sim) exception(exception, sendID, index DWord) {
+	recvException := simconnect.RecvException{
+		Exception: exception,
+		SendID:    sendID,
+		Index:     index,
+	}
+	sim.push(simconnect.RecvIDException, &recvException, nil)
+}
+
+// push queues a message. recv must be a pointer to one of the simconnect.Recv* structs.
+func (sim *Sim) push(recvID DWord, recv interface{}, payload []byte) {
+	if !sim.open {
+		return
+	}
+	var buf bytes.Buffer
+	binary.Write(&buf, binary.LittleEndian, recv)
+	buf.Write(payload)
+	message := buf.Bytes()
+	binary.LittleEndian.PutUint32(message[0:], uint32(len(message)))
+	binary.LittleEndian.PutUint32(message[4:], uint32(simconnect.ProtocolVersion))
+	binary.LittleEndian.PutUint32(message[8:], uint32(recvID))
+	sim.queue = append(sim.queue, message)
+	sim.signal()
+}
+
+func (sim *Sim) signal() {
+	select {
+	case sim.notify <- struct{}{}:
+	default:
+	}
+}
+
+func normalizeName(name string) string {
+	return strings.ToUpper(strings.TrimSpace(name))
+}
+
+func dword(arg interface{}) DWord {
+	switch v := arg.(type) {
+	case DWord:
+		return v
+	case int32:
+		return DWord(v)
+	case bool:
+		if v {
+			return 1
+		}
+	}
+	return 0
+}
+
+func copyArgs(args []interface{}) []interface{} {
+	copied := make([]interface{}, len(args))
+	for i, arg := range args {
+		if b, ok := arg.([]byte); ok {
+			arg = append([]byte(nil), b...)
+		}
+		copied[i] = arg
+	}
+	return copied
+}
+
+func equalValues(a, b [][]byte) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !bytes.Equal(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func sortedKeys(requests map[DWord]*dataRequest) []DWord {
+	keys := make([]DWord, 0, len(requests))
+	for key := range requests {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+	return keys
+}
@@ -1,17 +1,20 @@
 package simconnect
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"reflect"
 	"strings"
 	"sync"
 	"time"
-	"unsafe"
 
 	log "github.com/sirupsen/logrus"
 )
 
 const (
 	simVarRequestTimeout int64 = 10000
+	simVarDatumID        DWord = 0
 )
 
 type OnOpenFunc func(applName, applVersion, applBuild, simConnectVersion, simConnectBuild string)
@@ -21,6 +24,13 @@ type OnSimObjectDataByTypeFunc func(data *RecvSimObjectDataByType)
 type OnDataReadyFunc func()
 type OnEventIDFunc func(eventID DWord)
 type OnExceptionFunc func(exceptionCode DWord)
+type OnErrorFunc func(err *SimConnectError)
+type OnMessageFunc func(msg Message)
+type OnSystemEventFunc func()
+type OnPauseFunc func(paused bool)
+type OnFileLoadedFunc func(path string)
+type OnFrameFunc func(frameRate, simSpeed float32)
+type OnObjectFunc func(objectID, objectType DWord)
 
 type EventListener struct {
 	OnOpen                OnOpenFunc
@@ -30,6 +40,47 @@ type EventListener struct {
 	OnDataReady           OnDataReadyFunc
 	OnEventID             OnEventIDFunc
 	OnException           OnExceptionFunc
+	OnError               OnErrorFunc   // called for every exception, resolved to the call which caused it
+	OnMessage             OnMessageFunc // called for every message before it is handled
+
+	// The system event callbacks are subscribed to when Run or HandleEvents starts.
+	// Their events are not passed on to OnEventID.
+	OnSimStart       OnSystemEventFunc // SimStart
+	OnSimStop        OnSystemEventFunc // SimStop
+	OnPause          OnPauseFunc       // Pause
+	OnCrashed        OnSystemEventFunc // Crashed
+	OnFlightLoaded   OnFileLoadedFunc  // FlightLoaded
+	OnAircraftLoaded OnFileLoadedFunc  // AircraftLoaded
+	OnFrame          OnFrameFunc       // Frame, once per visual frame
+	OnObjectAdded    OnObjectFunc      // ObjectAdded
+	OnObjectRemoved  OnObjectFunc      // ObjectRemoved
+}
+
+// systemEvents returns the names of the system events listener has a callback for.
+func (listener *EventListener) systemEvents() []string {
+	if listener == nil {
+		return nil
+	}
+	var names []string
+	for _, callback := range []struct {
+		name string
+		set  bool
+	}{
+		{SystemEventSimStart, listener.OnSimStart != nil},
+		{SystemEventSimStop, listener.OnSimStop != nil},
+		{SystemEventPause, listener.OnPause != nil},
+		{SystemEventCrashed, listener.OnCrashed != nil},
+		{SystemEventFlightLoaded, listener.OnFlightLoaded != nil},
+		{SystemEventAircraftLoaded, listener.OnAircraftLoaded != nil},
+		{SystemEventFrame, listener.OnFrame != nil},
+		{SystemEventObjectAdded, listener.OnObjectAdded != nil},
+		{SystemEventObjectRemoved, listener.OnObjectRemoved != nil},
+	} {
+		if callback.set {
+			names = append(names, callback.name)
+		}
+	}
+	return names
 }
 
 type SimMate struct {
@@ -37,31 +88,105 @@ type SimMate struct {
 	simVarManager *SimVarManager
 	mutex         sync.Mutex
 	dirty         bool
+	subscription  *Subscription
+	watchLock     sync.Mutex
+	watchers      map[DWord][]*watcher
+}
+
+// Subscription lets SimMate subscribe to its SimVars with RequestDataOnSimObjectEx, so the simulator
+// pushes updates instead of being asked for every SimVar on every request tick.
+type Subscription struct {
+	Period   DWord // PeriodVisualFrame, PeriodSimFrame or PeriodSecond
+	Flags    DWord // DataRequestFlagChanged, DataRequestFlagTagged
+	Origin   DWord // number of periods before the first update
+	Interval DWord // number of periods between two updates
+	Limit    DWord // number of updates after which the subscription ends, 0 for no limit
 }
 
 func NewSimMate() *SimMate {
 	if !initialized {
 		Initialize("")
 	}
+	return NewSimMateWithTransport(NewDLLTransport())
+}
+
+func NewSimMateWithTransport(transport Transport) *SimMate {
 	mate := &SimMate{
+		SimConnect:    SimConnect{transport: transport},
 		simVarManager: NewSimVarManager(),
 	}
+	mate.simVarManager.defineIDs = &mate.ids.Define
 	return mate
 }
 
+// AddSimVar adds a SimVar, which is registered and requested with the next request tick of Run or HandleEvents.
+// It may be called while they run.
 func (mate *SimMate) AddSimVar(name, unit string, dataType DWord) DWord {
+	mate.mutex.Lock()
+	defer mate.mutex.Unlock()
 	defineID := mate.simVarManager.Add(name, unit, dataType)
 	mate.dirty = true
 	return defineID
 }
 
 func (mate *SimMate) RemoveSimVar(defineID DWord) bool {
+	mate.mutex.Lock()
+	defer mate.mutex.Unlock()
+
+	simVar, ok := mate.simVarManager.GetSimVar(defineID)
+	if !ok {
+		return false
+	}
 	if ok := mate.simVarManager.Remove(defineID); !ok {
 		return false
 	}
+	if simVar.Subscribed {
+		mate.RequestDataOnSimObject(simVar.RequestID, simVar.DefineID, ObjectIDUser, PeriodNever, DataRequestFlagDefault)
+	}
+	if simVar.Registered {
+		mate.ClearDataDefinition(simVar.DefineID)
+	}
+	if simVar.RequestID != 0 {
+		mate.ids.Request.Release(simVar.RequestID)
+	}
+	// The define ID may be handed out again, so its watchers must not linger.
+	mate.Unwatch(defineID)
 	return true
 }
 
+// Subscribe switches SimMate from polling to push-based updates. All SimVars, including the ones added
+// later, are subscribed with sub on the next request tick of Run or HandleEvents. Calling Subscribe again
+// replaces the subscriptions with the new parameters.
+func (mate *SimMate) Subscribe(sub Subscription) {
+	mate.mutex.Lock()
+	defer mate.mutex.Unlock()
+
+	mate.subscription = &sub
+	for _, simVar := range mate.simVarManager.Vars {
+		simVar.Subscribed = false
+	}
+}
+
+// Unsubscribe ends all subscriptions and switches SimMate back to polling.
+func (mate *SimMate) Unsubscribe() error {
+	mate.mutex.Lock()
+	defer mate.mutex.Unlock()
+
+	mate.subscription = nil
+	var firstErr error
+	for _, simVar := range mate.simVarManager.Vars {
+		if !simVar.Subscribed {
+			continue
+		}
+		err := mate.RequestDataOnSimObject(simVar.RequestID, simVar.DefineID, ObjectIDUser, PeriodNever, DataRequestFlagDefault)
+		if err != nil && firstErr == nil {
+			firstErr = err
+		}
+		simVar.Subscribed = false
+	}
+	return firstErr
+}
+
 func (mate *SimMate) SimVarValueAndDataType(defineID DWord) (interface{}, DWord, bool) {
 	mate.mutex.Lock()
 	defer mate.mutex.Unlock()
@@ -88,189 +213,212 @@ func (mate *SimMate) SimVarDump(indent string) []string {
 	return mate.simVarManager.SimVarDump(indent)
 }
 
+// SetSimObjectData writes a simulation variable of the user aircraft. See SetSimVarValue for the supported values.
 func (mate *SimMate) SetSimObjectData(name, unit string, value interface{}, dataType DWord) error {
-	defineID := NewDefineID()
-	if err := mate.AddToDataDefinition(defineID, name, unit, DataTypeFloat64); err != nil {
-		return err
-	}
-	switch dataType {
-	case DataTypeFloat64:
-		buffer := [1]float64{
-			ValueToFloat64(value),
-		}
-		size := DWord(unsafe.Sizeof(buffer))
-		mate.SetDataOnSimObject(defineID, ObjectIDUser, 0, 0, size, unsafe.Pointer(&buffer[0]))
-
-	default:
-		log.Tracef("SimConnect.SetSimObjectData: datatype not implemented: %v", dataType)
-	}
-	return nil
+	return mate.SetSimVarValue(ObjectIDUser, name, unit, dataType, value)
 }
 
+// HandleEvents requests the SimVars every requestDataInterval and dispatches the received messages to listener until stop is closed.
+// It is kept for existing callers; receiveDataInterval is only used to poll transports which are no Notifier. New code should use Run.
 func (mate *SimMate) HandleEvents(requestDataInterval time.Duration, receiveDataInterval time.Duration, stop chan interface{}, listener *EventListener) {
-	reqDataTicker := time.NewTicker(requestDataInterval)
-	defer reqDataTicker.Stop()
-
-	recvDataTicker := time.NewTicker(receiveDataInterval)
-	defer recvDataTicker.Stop()
-
-	requestCount := 0
-	updateCount := 0
-
-	for {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
 		select {
 		case <-stop:
-			return
-
-		case <-reqDataTicker.C:
-			if updateCount > 0 {
-				if listener != nil && listener.OnDataReady != nil {
-					listener.OnDataReady()
-				}
-			}
-			mate.requestSimObjectData()
-			requestCount++
-
-		case <-recvDataTicker.C:
-			ppData, r1, err := mate.GetNextDispatch()
-			if r1 < 0 {
-				if uint32(r1) != EFail {
-					log.Tracef("GetNextDispatch error: %s (%d)", err.Error(), r1)
-					return
-				}
-				if ppData == nil {
-					break
-				}
-			}
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+	if err := mate.run(ctx, requestDataInterval, receiveDataInterval, listener); err != nil && !errors.Is(err, context.Canceled) {
+		log.Tracef("GetNextDispatch error: %s", err.Error())
+	}
+}
 
-			recv := *(*Recv)(ppData)
-			switch recv.ID {
-			case RecvIDException:
-				recvException := *(*RecvException)(ppData)
-				if listener != nil && listener.OnException != nil {
-					listener.OnException(recvException.Exception)
-				}
+// Run requests the SimVars every requestDataInterval and dispatches the received messages to listener.
+// Instead of polling for messages it waits until the transport signals them.
+// Run returns ctx.Err() once ctx is done, or the error which ended the connection.
+func (mate *SimMate) Run(ctx context.Context, requestDataInterval time.Duration, listener *EventListener) error {
+	return mate.run(ctx, requestDataInterval, PollInterval, listener)
+}
 
-			case RecvIDOpen:
-				recvOpen := *(*RecvOpen)(ppData)
-				applName := strings.Trim(string(recvOpen.ApplicationName[:256]), "\x00")
-				applVersion := fmt.Sprintf("%d.%d", recvOpen.ApplicationVersionMajor, recvOpen.ApplicationVersionMinor)
-				applBuild := fmt.Sprintf("%d.%d", recvOpen.ApplicationBuildMajor, recvOpen.ApplicationBuildMinor)
-				simConnectVersion := fmt.Sprintf("%d.%d", recvOpen.SimConnectVersionMajor, recvOpen.SimConnectVersionMinor)
-				simConnectBuild := fmt.Sprintf("%d.%d", recvOpen.SimConnectBuildMajor, recvOpen.SimConnectBuildMinor)
-				if listener != nil && listener.OnOpen != nil {
-					listener.OnOpen(applName, applVersion, applBuild, simConnectVersion, simConnectBuild)
-				}
+// RunSession is Run with automatic reconnection, see SimConnect.RunSession.
+// SimVars which were waiting for data when the connection was lost are requested again after reconnecting.
+func (mate *SimMate) RunSession(ctx context.Context, session *Session, requestDataInterval time.Duration, listener *EventListener) error {
+	return mate.supervise(ctx, session, func(ctx context.Context) error {
+		return mate.run(ctx, requestDataInterval, PollInterval, listener)
+	}, mate.resetPending)
+}
 
-			case RecvIDQuit:
-				if listener != nil && listener.OnQuit != nil {
-					listener.OnQuit()
-				}
+func (mate *SimMate) resetPending() {
+	mate.mutex.Lock()
+	defer mate.mutex.Unlock()
+	for _, simVar := range mate.simVarManager.Vars {
+		simVar.Pending = false
+	}
+}
 
-			case RecvIDEvent:
-				recvEvent := *(*RecvEvent)(ppData)
-				if listener != nil && listener.OnEventID != nil {
-					listener.OnEventID(recvEvent.EventID)
-				}
+func (mate *SimMate) run(ctx context.Context, requestDataInterval, pollInterval time.Duration, listener *EventListener) error {
+	reqDataTicker := time.NewTicker(requestDataInterval)
+	defer reqDataTicker.Stop()
 
-			// case RecvIDEventObjectAddRemove:
-			// case RecvIDEventFilename:
-			// case RecvIDEventFrame:
+	handler := &mateHandler{mate: mate, listener: listener}
+	onTick := func() {
+		if handler.updates > 0 {
+			if listener != nil && listener.OnDataReady != nil {
+				listener.OnDataReady()
+			}
+		}
+		mate.requestSimObjectData()
+	}
+	for _, name := range listener.systemEvents() {
+		if _, err := mate.systemEventID(name); err != nil {
+			return err
+		}
+	}
+	return mate.SimConnect.run(ctx, pollInterval, reqDataTicker.C, onTick, handler)
+}
 
-			case RecvIDSimobjectData:
-				recvData := *(*RecvSimObjectData)(ppData)
-				if listener != nil && listener.OnSimObjectData != nil {
-					listener.OnSimObjectData(&recvData)
-				}
+// mateHandler is the Handler of run. It counts the messages which updated a SimVar.
+type mateHandler struct {
+	mate     *SimMate
+	listener *EventListener
+	updates  int
+}
 
-			case RecvIDSimObjectDataByType:
-				recvData := *(*RecvSimObjectDataByType)(ppData)
-				simVar, exists := mate.simVarManager.GetSimVar(recvData.DefineID)
-				if !exists {
-					continue
-				}
+func (h *mateHandler) HandleMessage(ctx context.Context, msg Message) {
+	if h.mate.handleMessage(msg, nil, h.listener) {
+		h.updates++
+	}
+}
 
-				var value interface{}
-				switch simVar.DataType {
-				case DataTypeInt32:
-					value = (*SimObjectData_int32)(ppData).Value
+// handleException takes the exceptions dispatch has resolved already.
+func (h *mateHandler) handleException(ctx context.Context, recv *RecvException, err *SimConnectError) {
+	h.mate.handleMessage(recv, err, h.listener)
+}
 
-				case DataTypeInt64:
-					value = (*SimObjectData_int64)(ppData).Value
+// handleMessage passes msg on to listener and reports whether it updated a SimVar.
+// resolved is the resolution of msg if it is a RecvException.
+func (mate *SimMate) handleMessage(msg Message, resolved *SimConnectError, listener *EventListener) (updated bool) {
+	if listener != nil && listener.OnMessage != nil {
+		listener.OnMessage(msg)
+	}
 
-				case DataTypeFloat32:
-					value = (*SimObjectData_float32)(ppData).Value
+	switch recv := msg.(type) {
+	case *RecvException:
+		if listener != nil && listener.OnException != nil {
+			listener.OnException(recv.Exception)
+		}
+		if listener != nil && listener.OnError != nil {
+			listener.OnError(resolved)
+		}
 
-				case DataTypeFloat64:
-					value = (*SimObjectData_float64)(ppData).Value
+	case *RecvOpen:
+		applName := strings.Trim(string(recv.ApplicationName[:256]), "\x00")
+		applVersion := fmt.Sprintf("%d.%d", recv.ApplicationVersionMajor, recv.ApplicationVersionMinor)
+		applBuild := fmt.Sprintf("%d.%d", recv.ApplicationBuildMajor, recv.ApplicationBuildMinor)
+		simConnectVersion := fmt.Sprintf("%d.%d", recv.SimConnectVersionMajor, recv.SimConnectVersionMinor)
+		simConnectBuild := fmt.Sprintf("%d.%d", recv.SimConnectBuildMajor, recv.SimConnectBuildMinor)
+		if listener != nil && listener.OnOpen != nil {
+			listener.OnOpen(applName, applVersion, applBuild, simConnectVersion, simConnectBuild)
+		}
 
-				case DataTypeString8:
-					value = (*SimObjectData_string8)(ppData).Value
+	case *RecvQuit:
+		if listener != nil && listener.OnQuit != nil {
+			listener.OnQuit()
+		}
 
-				case DataTypeString32:
-					value = (*SimObjectData_string32)(ppData).Value
+	case *RecvEvent, *RecvEventFilename, *RecvEventFrame, *RecvEventObjectAddRemove:
+		event := recvEvent(msg)
+		if !mate.handleSystemEvent(event, msg, listener) && listener != nil && listener.OnEventID != nil {
+			listener.OnEventID(event.EventID)
+		}
 
-				case DataTypeString64:
-					value = (*SimObjectData_string64)(ppData).Value
+	case *SimObjectDataMessage:
+		updated = mate.updateSimVar(&recv.RecvSimObjectData, recv.Data)
+		if listener != nil && listener.OnSimObjectData != nil {
+			listener.OnSimObjectData(&recv.RecvSimObjectData)
+		}
 
-				case DataTypeString128:
-					value = (*SimObjectData_string128)(ppData).Value
+	case *SimObjectDataByTypeMessage:
+		updated = mate.updateSimVar(&recv.RecvSimObjectData, recv.Data)
+		if listener != nil && listener.OnSimObjectDataByType != nil {
+			listener.OnSimObjectDataByType(&recv.RecvSimObjectDataByType)
+		}
 
-				case DataTypeString256:
-					value = (*SimObjectData_string256)(ppData).Value
+	default:
+		log.Tracef("Unhandled message ID: %d", recv.Header().ID)
+	}
+	return updated
+}
 
-				case DataTypeString260:
-					value = (*SimObjectData_string260)(ppData).Value
+// handleSystemEvent calls the callback of a system event subscribed for listener and reports whether there was one.
+// event is the RecvEvent part of msg.
+func (mate *SimMate) handleSystemEvent(event *RecvEvent, msg Message, listener *EventListener) bool {
+	if listener == nil {
+		return false
+	}
+	name, ok := mate.systemEventName(event.EventID)
+	if !ok {
+		return false
+	}
+	switch recv := msg.(type) {
+	case *RecvEvent:
+		switch {
+		case name == SystemEventSimStart && listener.OnSimStart != nil:
+			listener.OnSimStart()
+		case name == SystemEventSimStop && listener.OnSimStop != nil:
+			listener.OnSimStop()
+		case name == SystemEventPause && listener.OnPause != nil:
+			listener.OnPause(recv.Data != 0)
+		case name == SystemEventCrashed && listener.OnCrashed != nil:
+			listener.OnCrashed()
+		}
 
-				case DataTypeStringV:
-					value = (*SimObjectData_stringv)(ppData).Value
+	case *RecvEventFilename:
+		path := stringFromBytes(recv.FileName[:])
+		switch {
+		case name == SystemEventFlightLoaded && listener.OnFlightLoaded != nil:
+			listener.OnFlightLoaded(path)
+		case name == SystemEventAircraftLoaded && listener.OnAircraftLoaded != nil:
+			listener.OnAircraftLoaded(path)
+		}
 
-				// case DataTypeInitPosition:
-				// case DataTypeStringMarkerState:
-				// case DataTypeWaypoint:
-				// case DataTypeStringLatLonAlt:
-				// case DataTypeStringXYZ:
-				default:
-				}
-				if value != nil {
-					mate.updateSimObjectData(recvData.RequestID, recvData.DefineID, value)
-					updateCount++
-				}
-				if listener != nil && listener.OnSimObjectDataByType != nil {
-					listener.OnSimObjectDataByType(&recvData)
-				}
+	case *RecvEventFrame:
+		if name == SystemEventFrame && listener.OnFrame != nil {
+			listener.OnFrame(recv.FrameRate, recv.SimSpeed)
+		}
 
-			// case RecvIDWeatherObservation:
-			// case RecvIDCloudState:
-			// case RecvIDAssignedObjectID:
-			// case RecvIDReservedKey:
-			// case RecvIDCustomAction:
-			// case RecvIDSystemState:
-			// case RecvIDClientData:
-			// case RecvIDEventWeatherMode:
-			// case RecvIDAirportList:
-			// case RecvIDVORList:
-			// case RecvIDNDBList:
-			// case RecvIDWaypointList:
-			// case RecvIDEventMultiplayerServerStarted:
-			// case RecvIDEventMultiplayerClientStarted:
-			// case RecvIDEventMultiplayerSessionEnded:
-			// case RecvIDEventRaceEnd:
-			// case RecvIDEventRaceLap:
-			// case RecvIDPick:
-
-			default:
-				log.Tracef("Unknown recvInfo ID: %d", recv.ID)
-			}
+	case *RecvEventObjectAddRemove:
+		switch {
+		case name == SystemEventObjectAdded && listener.OnObjectAdded != nil:
+			listener.OnObjectAdded(recv.Data, recv.ObjType)
+		case name == SystemEventObjectRemoved && listener.OnObjectRemoved != nil:
+			listener.OnObjectRemoved(recv.Data, recv.ObjType)
 		}
 	}
+	return true
+}
+
+// recvEvent returns the RecvEvent part of an event message.
+func recvEvent(msg Message) *RecvEvent {
+	switch recv := msg.(type) {
+	case *RecvEventFilename:
+		return &recv.RecvEvent
+	case *RecvEventFrame:
+		return &recv.RecvEvent
+	case *RecvEventObjectAddRemove:
+		return &recv.RecvEvent
+	}
+	return msg.(*RecvEvent)
 }
 
 func (mate *SimMate) registerSimVars() (int, error) {
 	count := 0
 	for _, simVar := range mate.simVarManager.Vars {
 		if !simVar.Registered {
-			err := mate.AddToDataDefinition(simVar.DefineID, simVar.Name, simVar.Unit, simVar.DataType)
+			// The datum ID identifies the value in tagged data.
+			err := mate.addToDataDefinition(simVar.DefineID, simVar.Name, simVar.Unit, simVar.DataType, simVar.Epsilon, simVarDatumID)
 			if err != nil {
 				return count, err
 			} else {
@@ -301,8 +449,18 @@ func (mate *SimMate) requestSimObjectData() (bool, error) {
 	const radiusMeters = 0
 	simObjectType := SimObjectTypeUser
 	for _, simVar := range mate.simVarManager.Vars {
+		if mate.subscription != nil {
+			if !simVar.Subscribed {
+				if err := mate.subscribeSimVar(simVar, mate.subscription); err != nil {
+					return false, err
+				}
+			}
+			continue
+		}
 		if !simVar.Pending {
-			simVar.RequestID = NewRequestID()
+			if simVar.RequestID == 0 {
+				simVar.RequestID = mate.ids.Request.New()
+			}
 		} else {
 			if timestamp-simVar.Timestamp < simVarRequestTimeout {
 				continue
@@ -315,13 +473,98 @@ func (mate *SimMate) requestSimObjectData() (bool, error) {
 	return true, nil
 }
 
-func (mate *SimMate) updateSimObjectData(requestID, defineID DWord, value interface{}) {
-	if simVar, updated := mate.simVarManager.Update(requestID, defineID, value); updated {
+func (mate *SimMate) subscribeSimVar(simVar *SimVar, sub *Subscription) error {
+	if simVar.RequestID == 0 {
+		simVar.RequestID = mate.ids.Request.New()
+	}
+	// Reusing the request ID replaces an earlier subscription of the SimVar.
+	err := mate.RequestDataOnSimObjectEx(simVar.RequestID, simVar.DefineID, ObjectIDUser, sub.Period, sub.Flags, sub.Origin, sub.Interval, sub.Limit)
+	if err != nil {
+		return err
+	}
+	simVar.Subscribed = true
+	simVar.Pending = false
+	return nil
+}
+
+// updateSimVar stores the value carried by a data message and reports whether a SimVar was updated.
+func (mate *SimMate) updateSimVar(recv *RecvSimObjectData, data []byte) bool {
+	simVar, exists := mate.simVarManager.GetSimVar(recv.DefineID)
+	if !exists {
+		return false
+	}
+	if recv.Flags&DataRequestFlagTagged != 0 {
+		// Each datum is prefixed with its datum ID. SimVar definitions only hold a single datum.
+		if recv.DefineCount == 0 || len(data) < 4 {
+			return false
+		}
+		data = data[4:]
+	}
+	value, err := simVarValue(data, simVar.DataType)
+	if err != nil {
+		log.Tracef("SimVar %s: %s", simVar.Name, err.Error())
+	}
+	if value == nil {
+		return false
+	}
+	if mate.updateSimObjectData(recv.RequestID, recv.DefineID, value) {
+		mate.notifyWatchers(recv.DefineID)
+	}
+	return true
+}
+
+func (mate *SimMate) updateSimObjectData(requestID, defineID DWord, value interface{}) bool {
+	mate.mutex.Lock()
+	defer mate.mutex.Unlock()
+	simVar, updated := mate.simVarManager.Update(requestID, defineID, value)
+	if updated {
 		simVar.Pending = false
 	}
+	return updated
+}
+
+// simVarValue decodes the single datum of a SimVar data definition.
+// Fixed-size strings are returned as byte arrays, as expected by SimVarManager.ToString.
+func simVarValue(data []byte, dataType DWord) (interface{}, error) {
+	var value interface{}
+	switch dataType {
+	case DataTypeInt32:
+		value = new(int32)
+	case DataTypeInt64:
+		value = new(int64)
+	case DataTypeFloat32:
+		value = new(float32)
+	case DataTypeFloat64:
+		value = new(float64)
+	case DataTypeString8:
+		value = new([8]byte)
+	case DataTypeString32:
+		value = new([32]byte)
+	case DataTypeString64:
+		value = new([64]byte)
+	case DataTypeString128:
+		value = new([128]byte)
+	case DataTypeString256:
+		value = new([256]byte)
+	case DataTypeString260:
+		value = new([260]byte)
+	case DataTypeStringV:
+		str, _, err := RetrieveString(data)
+		if err != nil {
+			return nil, err
+		}
+		return str, nil
+	default:
+		// DataTypeInitPosition, DataTypeMarkerState, DataTypeWaypoint, DataTypeLatLonAlt, DataTypeXYZ
+		return nil, nil
+	}
+	if _, err := decodeStruct(data, value); err != nil {
+		return nil, err
+	}
+	return reflect.ValueOf(value).Elem().Interface(), nil
 }
 
-// Generics. Needed. Badly. Ugh.
+// The SimObjectData overlays predate Decode and the generic accessors Get and Typed; they are kept for compatibility.
 type SimObjectData struct {
 	RecvSimObjectDataByType
 }
@@ -376,7 +619,9 @@ type SimObjectData_string260 struct {
 	Value [260]byte
 }
 
+// Deprecated: a Go string cannot be overlaid on the message buffer, so Value never holds the string.
+// Decode the message and read the string from its data with RetrieveString instead.
 type SimObjectData_stringv struct {
 	SimObjectData
-	Value string // TODO: Not sure if this is correct
+	Value string
 }
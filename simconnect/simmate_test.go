@@ -0,0 +1,232 @@
+package simconnect_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/grumpypixel/msfs2020-simconnect-go/simconnect"
+	"github.com/grumpypixel/msfs2020-simconnect-go/simconnect/simconnecttest"
+)
+
+const requestInterval = 5 * time.Millisecond
+
+func openMate(t *testing.T) (*simconnect.SimMate, *simconnecttest.Sim) {
+	t.Helper()
+	sim := simconnecttest.NewSim()
+	mate := simconnect.NewSimMateWithTransport(sim)
+	if err := mate.Open("SimMate Test"); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { mate.Close() })
+	return mate, sim
+}
+
+// runMate runs mate.Run with listener on its own goroutine. The returned function cancels Run and returns its error.
+func runMate(t *testing.T, mate *simconnect.SimMate, listener *simconnect.EventListener) (stop func() error) {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	errc := make(chan error, 1)
+	go func() {
+		errc <- mate.Run(ctx, requestInterval, listener)
+	}()
+	t.Cleanup(cancel)
+	return func() error {
+		cancel()
+		select {
+		case err := <-errc:
+			return err
+		case <-time.After(testTimeout):
+			t.Fatal("Run did not return")
+			return nil
+		}
+	}
+}
+
+// eventually fails the test unless condition holds within testTimeout.
+func eventually(t *testing.T, what string, condition func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(testTimeout)
+	for !condition() {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for %s", what)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestSimMateRun(t *testing.T) {
+	mate, sim := openMate(t)
+	sim.SetSimVar("PLANE ALTITUDE", 1234.5)
+	sim.SetSimVar("TITLE", "Cessna Skyhawk")
+	altitude := mate.AddSimVar("PLANE ALTITUDE", "feet", simconnect.DataTypeFloat64)
+	title := mate.AddSimVar("TITLE", "", simconnect.DataTypeString256)
+
+	opened := make(chan string, 1)
+	ready := make(chan struct{}, 1)
+	stop := runMate(t, mate, &simconnect.EventListener{
+		OnOpen: func(applName, applVersion, applBuild, simConnectVersion, simConnectBuild string) {
+			opened <- applName + " " + applVersion
+		},
+		OnDataReady: func() {
+			select {
+			case ready <- struct{}{}:
+			default:
+			}
+		},
+	})
+	if got := receive(t, opened); got != simconnecttest.ApplicationName+" 11.0" {
+		t.Errorf("OnOpen: got %q", got)
+	}
+	receive(t, ready)
+	if err := stop(); !errors.Is(err, context.Canceled) {
+		t.Errorf("Run: got %v, want context.Canceled", err)
+	}
+
+	if value, dataType, ok := mate.SimVarValueAndDataType(altitude); !ok || value != 1234.5 || dataType != simconnect.DataTypeFloat64 {
+		t.Errorf("altitude: got %v %d %v", value, dataType, ok)
+	}
+	simVar, ok := mate.SimVar(title)
+	if !ok {
+		t.Fatal("no title")
+	}
+	if got := simVar.ToString(""); got != "Cessna Skyhawk" {
+		t.Errorf("title: got %q", got)
+	}
+	if len(sim.CallsTo("SimConnect_AddToDataDefinition")) != 2 {
+		t.Error("SimVars are registered once")
+	}
+}
+
+func TestSimMateHandleEvents(t *testing.T) {
+	mate, sim := openMate(t)
+	sim.SetSimVar("PLANE ALTITUDE", 1234.5)
+	altitude := mate.AddSimVar("PLANE ALTITUDE", "feet", simconnect.DataTypeFloat64)
+
+	stop := make(chan interface{})
+	done := make(chan struct{})
+	var quit bool
+	go func() {
+		defer close(done)
+		mate.HandleEvents(requestInterval, requestInterval, stop, &simconnect.EventListener{
+			OnDataReady: func() {
+				select {
+				case <-stop:
+				default:
+					close(stop)
+				}
+			},
+			OnQuit: func() { quit = true },
+		})
+	}()
+	select {
+	case <-done:
+	case <-time.After(testTimeout):
+		t.Fatal("HandleEvents did not return")
+	}
+	if value, _, _ := mate.SimVarValueAndDataType(altitude); value != 1234.5 {
+		t.Errorf("altitude: got %v", value)
+	}
+	if quit {
+		t.Error("OnQuit called without a RecvQuit")
+	}
+}
+
+func TestSimMateSubscribe(t *testing.T) {
+	mate, sim := openMate(t)
+	sim.SetSimVar("PLANE ALTITUDE", 1000.0)
+	altitude := mate.AddSimVar("PLANE ALTITUDE", "feet", simconnect.DataTypeFloat64)
+	mate.Subscribe(simconnect.Subscription{Period: simconnect.PeriodSimFrame, Flags: simconnect.DataRequestFlagChanged})
+
+	updates := make(chan float64, 16)
+	stop := runMate(t, mate, &simconnect.EventListener{
+		OnSimObjectData: func(data *simconnect.RecvSimObjectData) {
+			if value, _, _ := mate.SimVarValueAndDataType(altitude); value != nil {
+				updates <- value.(float64)
+			}
+		},
+	})
+	eventually(t, "the subscription", func() bool {
+		return len(sim.CallsTo("SimConnect_RequestDataOnSimObject")) > 0
+	})
+	sim.Tick()
+	if got := receive(t, updates); got != 1000 {
+		t.Errorf("first update: got %v", got)
+	}
+	sim.Tick() // unchanged, nothing is sent
+	sim.SetSimVar("PLANE ALTITUDE", 1500.0)
+	sim.Tick()
+	if got := receive(t, updates); got != 1500 {
+		t.Errorf("second update: got %v", got)
+	}
+	stop()
+	if len(sim.CallsTo("SimConnect_RequestDataOnSimObjectType")) != 0 {
+		t.Error("subscribed SimVars are not polled")
+	}
+}
+
+func TestSimMateSystemEvents(t *testing.T) {
+	mate, sim := openMate(t)
+	paused := make(chan bool, 1)
+	loaded := make(chan string, 1)
+	eventIDs := make(chan simconnect.DWord, 1)
+	stop := runMate(t, mate, &simconnect.EventListener{
+		OnPause:          func(p bool) { paused <- p },
+		OnAircraftLoaded: func(path string) { loaded <- path },
+		OnEventID:        func(eventID simconnect.DWord) { eventIDs <- eventID },
+	})
+	eventually(t, "the system event subscriptions", func() bool {
+		return len(sim.CallsTo("SimConnect_SubscribeToSystemEvent")) == 2
+	})
+	sim.FireSystemEvent(simconnect.SystemEventPause, 1)
+	if !receive(t, paused) {
+		t.Error("OnPause: got false")
+	}
+	sim.FireFilenameEvent(simconnect.SystemEventAircraftLoaded, "aircraft.cfg")
+	if got := receive(t, loaded); got != "aircraft.cfg" {
+		t.Errorf("OnAircraftLoaded: got %q", got)
+	}
+	stop()
+	select {
+	case eventID := <-eventIDs:
+		t.Errorf("system event %d passed on to OnEventID", eventID)
+	default:
+	}
+}
+
+func TestSimMateExceptions(t *testing.T) {
+	mate, _ := openMate(t)
+	exceptions := make(chan simconnect.DWord, 1)
+	errs := make(chan *simconnect.SimConnectError, 1)
+	stop := runMate(t, mate, &simconnect.EventListener{
+		OnException: func(exception simconnect.DWord) { exceptions <- exception },
+		OnError:     func(err *simconnect.SimConnectError) { errs <- err },
+	})
+
+	if err := mate.RequestDataOnSimObject(1, 99, simconnect.ObjectIDUser, simconnect.PeriodOnce, 0); err != nil {
+		t.Fatal(err)
+	}
+	sendID := mate.LastSendID()
+	if got := receive(t, exceptions); got != simconnect.ExceptionUnrecognizedID {
+		t.Errorf("OnException: got %s", simconnect.ExceptionName(got))
+	}
+	err := receive(t, errs)
+	if err.SendID != sendID || err.ProcName != "SimConnect_RequestDataOnSimObject" || err.Index != 2 {
+		t.Errorf("OnError: got %+v", err)
+	}
+	stop()
+}
+
+func TestSimMateQuit(t *testing.T) {
+	mate, sim := openMate(t)
+	quit := make(chan struct{})
+	stop := runMate(t, mate, &simconnect.EventListener{
+		OnQuit: func() { close(quit) },
+	})
+	sim.Quit()
+	receive(t, quit)
+	if err := stop(); !errors.Is(err, context.Canceled) {
+		t.Errorf("Run: got %v, want context.Canceled", err)
+	}
+}
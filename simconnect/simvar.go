@@ -1,16 +1,24 @@
 package simconnect
 
+import (
+	"fmt"
+
+	"github.com/grumpypixel/msfs2020-simconnect-go/simconnect/units"
+)
+
 type SimVar struct {
 	DefineID    DWord
 	RequestID   DWord
 	Name        string
 	Unit        string
 	DataType    DWord
+	Epsilon     float32 // the simulator only reports changes larger than Epsilon, see DataRequestFlagChanged
 	Value       interface{}
 	UpdateCount int64
 	IsString    bool
 	Registered  bool
 	Pending     bool
+	Subscribed  bool
 	Timestamp   int64
 }
 
@@ -25,37 +33,51 @@ func NewSimVar(defineID DWord, name string, unit string, dataType DWord) *SimVar
 	}
 }
 
+// The To functions return defaultValue if the SimVar has no value yet or its value does not convert, see Convert.
+
 func (simVar *SimVar) ToInt32(defaultValue int32) int32 {
-	if simVar.Value != nil {
-		return ValueToInt32(simVar.Value)
+	if value, err := Convert[int32](simVar.Value); err == nil {
+		return value
 	}
 	return defaultValue
 }
 
 func (simVar *SimVar) ToInt64(defaultValue int64) int64 {
-	if simVar.Value != nil {
-		return ValueToInt64(simVar.Value)
+	if value, err := Convert[int64](simVar.Value); err == nil {
+		return value
 	}
 	return defaultValue
 }
 
 func (simVar *SimVar) ToFloat32(defaultValue float32) float32 {
-	if simVar.Value != nil {
-		return ValueToFloat32(simVar.Value)
+	if value, err := Convert[float32](simVar.Value); err == nil {
+		return value
 	}
 	return defaultValue
 }
 
 func (simVar *SimVar) ToFloat64(defaultValue float64) float64 {
-	if simVar.Value != nil {
-		return ValueToFloat64(simVar.Value)
+	if value, err := Convert[float64](simVar.Value); err == nil {
+		return value
 	}
 	return defaultValue
 }
 
 func (simVar *SimVar) ToString(defaultValue string) string {
-	if simVar.Value != nil {
-		return ValueToString(simVar.Value)
+	if value, err := Convert[string](simVar.Value); err == nil {
+		return value
 	}
 	return defaultValue
 }
+
+// ToUnit returns the value converted from the unit it was requested in to unit, e.g. "meters" for a SimVar requested in "feet".
+func (simVar *SimVar) ToUnit(unit string) (float64, error) {
+	if simVar.Value == nil {
+		return 0, fmt.Errorf("%w: %s", ErrNoValue, simVar.Name)
+	}
+	value, err := Convert[float64](simVar.Value)
+	if err != nil {
+		return 0, err
+	}
+	return units.Convert(value, simVar.Unit, unit)
+}
@@ -11,6 +11,8 @@ type SimVarManager struct {
 	nameMap map[string]*SimVar
 	idMap   map[DWord]*SimVar
 	mutex   sync.Mutex
+
+	defineIDs *IDAllocator // the define IDs of the connection, nil to use NewDefineID
 }
 
 func NewSimVarManager() *SimVarManager {
@@ -39,7 +41,12 @@ func (mgr *SimVarManager) Add(name string, unit string, dataType DWord) DWord {
 		return simVar.DefineID
 	}
 
-	defineID := NewDefineID()
+	var defineID DWord
+	if mgr.defineIDs != nil {
+		defineID = mgr.defineIDs.New()
+	} else {
+		defineID = NewDefineID()
+	}
 	simVar := NewSimVar(defineID, name, unit, dataType)
 	mgr.Vars = append(mgr.Vars, simVar)
 	mgr.nameMap[name] = simVar
@@ -56,6 +63,9 @@ func (mgr *SimVarManager) Remove(defineID DWord) bool {
 	}
 	delete(mgr.nameMap, simVar.Name)
 	delete(mgr.idMap, simVar.DefineID)
+	if mgr.defineIDs != nil {
+		mgr.defineIDs.Release(simVar.DefineID)
+	}
 	vars := mgr.Vars
 	for i, simVar := range vars {
 		if simVar.DefineID == defineID {
@@ -77,7 +87,7 @@ func (mgr *SimVarManager) Update(requestID, defineID DWord, value interface{}) (
 	mgr.mutex.Lock()
 	defer mgr.mutex.Unlock()
 	if simVar, ok := mgr.simVarWithID(defineID); ok {
-		if simVar.Pending && simVar.RequestID == requestID {
+		if (simVar.Pending || simVar.Subscribed) && simVar.RequestID == requestID {
 			if !simVar.IsString {
 				simVar.Value = value
 			} else {
@@ -0,0 +1,97 @@
+package simconnect
+
+// System events which SimMate subscribes to for the typed callbacks of EventListener.
+// https://docs.flightsimulator.com/html/Programming_Tools/SimConnect/API_Reference/Events_And_Data/SimConnect_SubscribeToSystemEvent.htm
+const (
+	SystemEventAircraftLoaded = "AircraftLoaded" // RecvEventFilename with the path of the aircraft
+	SystemEventCrashed        = "Crashed"        // RecvEvent
+	SystemEventFlightLoaded   = "FlightLoaded"   // RecvEventFilename with the path of the flight
+	SystemEventFrame          = "Frame"          // RecvEventFrame, once per visual frame
+	SystemEventObjectAdded    = "ObjectAdded"    // RecvEventObjectAddRemove, the object ID is in Data
+	SystemEventObjectRemoved  = "ObjectRemoved"  // RecvEventObjectAddRemove, the object ID is in Data
+	SystemEventPause          = "Pause"          // RecvEvent, Data is 1 when paused and 0 when unpaused
+	SystemEventSimStart       = "SimStart"       // RecvEvent
+	SystemEventSimStop        = "SimStop"        // RecvEvent
+)
+
+// systemEventID returns the client event subscribed to a system event, subscribing if necessary.
+func (simco *SimConnect) systemEventID(name string) (DWord, error) {
+	simco.eventLock.Lock()
+	defer simco.eventLock.Unlock()
+	if eventID, ok := simco.systemEvents[name]; ok {
+		return eventID, nil
+	}
+	eventID := simco.ids.Event.New()
+	if err := simco.SubscribeToSystemEvent(eventID, name); err != nil {
+		return 0, err
+	}
+	if simco.systemEvents == nil {
+		simco.systemEvents = make(map[string]DWord)
+	}
+	simco.systemEvents[name] = eventID
+	return eventID, nil
+}
+
+// systemEventName returns the system event which systemEventID subscribed eventID to.
+func (simco *SimConnect) systemEventName(eventID DWord) (string, bool) {
+	simco.eventLock.Lock()
+	defer simco.eventLock.Unlock()
+	for name, id := range simco.systemEvents {
+		if id == eventID {
+			return name, true
+		}
+	}
+	return "", false
+}
+
+// OnSystemEvent subscribes to the named system event and hands its messages to receive as well as to the Handler,
+// on the goroutine of Run, RunSession or SimMate.Run (or Wait). The returned function unregisters receive;
+// the subscription itself is shared and stays in place.
+func (simco *SimConnect) OnSystemEvent(name string, receive func(msg Message)) (remove func(), err error) {
+	if _, err := simco.systemEventID(name); err != nil {
+		return nil, err
+	}
+	entry := &receive
+	simco.eventLock.Lock()
+	if simco.eventHooks == nil {
+		simco.eventHooks = make(map[string][]*func(Message))
+	}
+	simco.eventHooks[name] = append(simco.eventHooks[name], entry)
+	simco.eventLock.Unlock()
+	return func() {
+		simco.eventLock.Lock()
+		defer simco.eventLock.Unlock()
+		hooks := simco.eventHooks[name]
+		for i, other := range hooks {
+			if other == entry {
+				simco.eventHooks[name] = append(hooks[:i:i], hooks[i+1:]...)
+				return
+			}
+		}
+	}, nil
+}
+
+// notifySystemEvent hands an event message to the receivers registered with OnSystemEvent for its system event.
+func (simco *SimConnect) notifySystemEvent(msg Message) {
+	switch msg.(type) {
+	case *RecvEvent, *RecvEventFilename, *RecvEventFrame, *RecvEventObjectAddRemove:
+	default:
+		return
+	}
+	name, ok := simco.systemEventName(recvEvent(msg).EventID)
+	if !ok {
+		return
+	}
+	simco.eventLock.Lock()
+	hooks := append([]*func(Message){}, simco.eventHooks[name]...)
+	simco.eventLock.Unlock()
+	for _, hook := range hooks {
+		(*hook)(msg)
+	}
+}
+
+func (simco *SimConnect) resetSystemEvents() {
+	simco.eventLock.Lock()
+	defer simco.eventLock.Unlock()
+	simco.systemEvents = nil
+}
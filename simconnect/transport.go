@@ -0,0 +1,104 @@
+package simconnect
+
+import (
+	"errors"
+	"fmt"
+	"unsafe"
+)
+
+var (
+	ErrNoTransport  = errors.New("simconnect: no transport")
+	ErrNotConnected = errors.New("simconnect: not connected")
+	ErrUnsupported  = errors.New("simconnect: not supported by the transport")
+)
+
+// Transport carries SimConnect calls to the simulator and hands back the messages it sends.
+// SimConnect delegates every API function to its transport, so the DLL, the network protocol
+// or a fake simulator can be plugged in without changing any calling code.
+//
+// Call receives the name of the SimConnect function (e.g. "SimConnect_AddToDataDefinition")
+// and its arguments without the leading HANDLE, typed as in SimConnect.h:
+//
+//	DWord, int32, bool, float32, float64 -> DWORD/ENUM, int, BOOL, float, double
+//	string                               -> const char *
+//	InitPosition                         -> SIMCONNECT_DATA_INITPOSITION
+//	[]byte                               -> void * pDataSet (the slice covers the whole data set)
+//	*DWord                               -> DWORD * (out parameter)
+//	nil                                  -> NULL
+type Transport interface {
+	Open(name string, configIndex DWord) error
+	Close() error
+	Call(procName string, args ...interface{}) error
+	// GetNextDispatch returns the next pending message and its size in bytes.
+	// It returns a nil pointer and a nil error if no message is pending.
+	// The message is only valid until the next call to GetNextDispatch.
+	GetNextDispatch() (unsafe.Pointer, DWord, error)
+}
+
+// SendIDReporter is implemented by transports which know the packet ID of the last call themselves,
+// so that SimConnect does not have to ask for it with GetLastSentPacketID.
+type SendIDReporter interface {
+	LastSendID() DWord
+}
+
+// NewSimConnectWithTransport creates a SimConnect which talks to the simulator through the given transport.
+func NewSimConnectWithTransport(transport Transport) *SimConnect {
+	return &SimConnect{
+		transport: transport,
+	}
+}
+
+func (simco *SimConnect) Transport() Transport {
+	return simco.transport
+}
+
+func (simco *SimConnect) call(procName string, args ...interface{}) error {
+	_, err := simco.makeCall(procName, false, args)
+	return err
+}
+
+// callID makes a call and returns its packet ID, which is zero if the transport does not report one.
+func (simco *SimConnect) callID(procName string, args ...interface{}) (DWord, error) {
+	return simco.makeCall(procName, true, args)
+}
+
+func (simco *SimConnect) makeCall(procName string, needID bool, args []interface{}) (DWord, error) {
+	if simco.transport == nil {
+		return 0, ErrNoTransport
+	}
+	simco.callLock.Lock()
+	defer simco.callLock.Unlock()
+	if err := simco.transport.Call(procName, args...); err != nil {
+		return 0, err
+	}
+	sendID := simco.callSent(procName, args, needID)
+	simco.journal.record(procName, args)
+	return sendID, nil
+}
+
+// HResultError is returned when a SimConnect function fails with an HRESULT.
+type HResultError struct {
+	ProcName string
+	HResult  uint32
+	Err      error
+}
+
+func (e *HResultError) Error() string {
+	return fmt.Sprintf("%s error: %d %s", e.ProcName, int32(e.HResult), e.Err)
+}
+
+func (e *HResultError) Unwrap() error {
+	return e.Err
+}
+
+func hresultOf(err error) int32 {
+	var hrErr *HResultError
+	if errors.As(err, &hrErr) {
+		return toHResult(hrErr.HResult)
+	}
+	return toHResult(EUnexpected)
+}
+
+func toHResult(code uint32) int32 {
+	return int32(code)
+}
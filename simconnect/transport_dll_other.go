@@ -0,0 +1,43 @@
+//go:build !windows
+// +build !windows
+
+package simconnect
+
+import (
+	"errors"
+	"unsafe"
+)
+
+var errDLLUnsupported = errors.New("simconnect: " + SimConnectDLL + " is only available on windows")
+
+// dllTransport is a stand-in on platforms which cannot load SimConnect.dll.
+// Use another Transport, e.g. one talking to the simulator over the network.
+type dllTransport struct{}
+
+// NewDLLTransport returns a Transport which uses the SimConnect.dll loaded by Initialize.
+// On this platform every call fails.
+func NewDLLTransport() Transport {
+	return &dllTransport{}
+}
+
+func (t *dllTransport) Open(name string, configIndex DWord) error {
+	return errDLLUnsupported
+}
+
+func (t *dllTransport) Close() error {
+	return errDLLUnsupported
+}
+
+func (t *dllTransport) Call(procName string, args ...interface{}) error {
+	return errDLLUnsupported
+}
+
+func (t *dllTransport) GetNextDispatch() (unsafe.Pointer, DWord, error) {
+	return nil, 0, errDLLUnsupported
+}
+
+func loadLibrary(path string) error {
+	return errDLLUnsupported
+}
+
+func loadProcs() {}
@@ -0,0 +1,308 @@
+package simconnect
+
+import (
+	"fmt"
+	"math"
+	"runtime"
+	"syscall"
+	"time"
+	"unsafe"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// eventWaitTimeout is how long the event goroutine blocks before it checks whether the transport has been closed.
+	eventWaitTimeout uint32 = 100 // milliseconds
+)
+
+var (
+	library *syscall.LazyDLL
+	procs   map[string]*syscall.LazyProc
+
+	kernel32        = syscall.NewLazyDLL("kernel32.dll")
+	procCreateEvent = kernel32.NewProc("CreateEventW")
+)
+
+// dllTransport calls the functions exported by SimConnect.dll.
+type dllTransport struct {
+	handle unsafe.Pointer
+	event  syscall.Handle
+	notify chan struct{}
+	closed chan struct{}
+	done   chan struct{}
+}
+
+// NewDLLTransport returns a Transport which uses the SimConnect.dll loaded by Initialize.
+// SimConnect signals a Win32 event whenever a message arrives; the transport implements Notifier on top of it.
+// It is a SendIDReporter as well.
+func NewDLLTransport() Transport {
+	return &dllTransport{
+		notify: make(chan struct{}, 1),
+	}
+}
+
+func (t *dllTransport) Open(name string, configIndex DWord) error {
+	// SimConnect_Open(
+	// 	HANDLE * phSimConnect,
+	// 	LPCSTR szName,
+	// 	HWND hWnd,
+	// 	DWORD UserEventWin32,
+	// 	HANDLE hEventHandle,
+	// 	DWORD ConfigIndex)
+
+	const hwnd DWord = 0
+	const userEventWin32 = WmUserSimConnect
+
+	var namePtr *uint16
+	namePtr, namePtrErr := syscall.UTF16PtrFromString(name)
+	if namePtrErr != nil {
+		return namePtrErr
+	}
+
+	// Auto-reset event, initially not signalled.
+	eventHandle, _, err := procCreateEvent.Call(0, 0, 0, 0)
+	if eventHandle == 0 {
+		return err
+	}
+
+	args := []uintptr{
+		uintptr(unsafe.Pointer(&t.handle)),
+		uintptr(unsafe.Pointer(namePtr)),
+		uintptr(hwnd),
+		uintptr(userEventWin32),
+		eventHandle,
+		uintptr(configIndex),
+	}
+	if err := callProc(scOpen, args...); err != nil {
+		syscall.CloseHandle(syscall.Handle(eventHandle))
+		return err
+	}
+	t.event = syscall.Handle(eventHandle)
+	t.closed = make(chan struct{})
+	t.done = make(chan struct{})
+	go t.wait(t.event, t.closed, t.done)
+	return nil
+}
+
+func (t *dllTransport) Close() error {
+	// SimConnect_Close(
+	//  HANDLE hSimConnect)
+
+	args := []uintptr{
+		uintptr(t.handle),
+	}
+	err := callProc(scClose, args...)
+	if t.event != 0 {
+		close(t.closed)
+		<-t.done
+		syscall.CloseHandle(t.event)
+		t.event = 0
+	}
+	return err
+}
+
+// Notify implements Notifier.
+func (t *dllTransport) Notify() <-chan struct{} {
+	return t.notify
+}
+
+// LastSendID implements SendIDReporter. SimConnect_GetLastSentPacketID only reads a counter of the client library,
+// so it is called directly instead of going through Call.
+func (t *dllTransport) LastSendID() DWord {
+	var sendID DWord
+	if err := callProc(scGetLastSentPacketID, uintptr(t.handle), uintptr(unsafe.Pointer(&sendID))); err != nil {
+		return 0
+	}
+	return sendID
+}
+
+// wait forwards the signals of the SimConnect event to the notify channel until closed is closed.
+func (t *dllTransport) wait(event syscall.Handle, closed, done chan struct{}) {
+	defer close(done)
+	for {
+		select {
+		case <-closed:
+			return
+		default:
+		}
+		result, err := syscall.WaitForSingleObject(event, eventWaitTimeout)
+		if result == syscall.WAIT_TIMEOUT {
+			continue
+		}
+		if result == syscall.WAIT_FAILED {
+			// Degrade to polling rather than leaving the dispatcher without wake-ups.
+			log.Tracef("WaitForSingleObject error: %s", err.Error())
+			time.Sleep(time.Duration(eventWaitTimeout) * time.Millisecond)
+		}
+		select {
+		case t.notify <- struct{}{}:
+		default:
+		}
+	}
+}
+
+func (t *dllTransport) Call(procName string, args ...interface{}) error {
+	// Keep the memory behind pointer arguments alive until the call has returned.
+	keepAlive := make([]interface{}, 0, len(args))
+	procArgs := make([]uintptr, 0, len(args)+1)
+	procArgs = append(procArgs, uintptr(t.handle))
+	for _, arg := range args {
+		switch value := arg.(type) {
+		case nil:
+			procArgs = append(procArgs, 0)
+		case DWord:
+			procArgs = append(procArgs, uintptr(value))
+		case int32:
+			procArgs = append(procArgs, uintptr(value))
+		case bool:
+			procArgs = append(procArgs, toBoolPtr(value))
+		case float32:
+			procArgs = append(procArgs, uintptr(math.Float32bits(value)))
+		case float64:
+			procArgs = append(procArgs, uintptr(math.Float64bits(value)))
+		case string:
+			bytes := toNullTerminatedBytes(value)
+			keepAlive = append(keepAlive, bytes)
+			procArgs = append(procArgs, uintptr(unsafe.Pointer(&bytes[0])))
+		case InitPosition:
+			// Structs larger than 8 bytes are passed by reference.
+			initPos := value
+			keepAlive = append(keepAlive, &initPos)
+			procArgs = append(procArgs, uintptr(unsafe.Pointer(&initPos)))
+		case []byte:
+			var ptr uintptr
+			if len(value) > 0 {
+				ptr = uintptr(unsafe.Pointer(&value[0]))
+			}
+			keepAlive = append(keepAlive, value)
+			procArgs = append(procArgs, ptr)
+		case *DWord:
+			procArgs = append(procArgs, uintptr(unsafe.Pointer(value)))
+		default:
+			return fmt.Errorf("%s: unsupported argument type %T", procName, arg)
+		}
+	}
+	err := callProc(procName, procArgs...)
+	runtime.KeepAlive(keepAlive)
+	return err
+}
+
+func (t *dllTransport) GetNextDispatch() (unsafe.Pointer, DWord, error) {
+	// SimConnect_GetNextDispatch(
+	// 	HANDLE hSimConnect,
+	// 	SIMCONNECT_RECV ** ppData,
+	// 	DWORD * pcbData)
+
+	var ppData unsafe.Pointer
+	var ppDataLength DWord
+	r1, _, err := procs[scGetNextDispatch].Call(
+		uintptr(t.handle),
+		uintptr(unsafe.Pointer(&ppData)),
+		uintptr(unsafe.Pointer(&ppDataLength)),
+	)
+	if int32(r1) < 0 {
+		if uint32(r1) == EFail && ppData == nil {
+			return nil, 0, nil
+		}
+		return nil, 0, &HResultError{scGetNextDispatch, uint32(r1), err}
+	}
+	return ppData, ppDataLength, nil
+}
+
+func loadLibrary(path string) error {
+	library = syscall.NewLazyDLL(path)
+	if err := library.Load(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func loadProcs() {
+	procs = make(map[string]*syscall.LazyProc)
+	procNames := []string{
+		scOpen,
+		scClose,
+		// scCallDispatch,
+		scGetNextDispatch,
+		scRequestSystemState,
+		scMapClientEventToSimEvent,
+		scSubscribeToSystemEvent,
+		scSetSystemEventState,
+		scUnsubscribeFromSystemEvent,
+		scSetNotificationGroupPriority,
+		scText,
+		scRequestDataOnSimObject,
+		scRequestDataOnSimObjectType,
+		scAddClientEventToNotificationGroup,
+		scRemoveClientEvent,
+		scTransmitClientEvent,
+		scTransmitClientEventEx1,
+		scMapClientDataNameToID,
+		scRequestClientData,
+		scCreateClientData,
+		scAddToClientDataDefinition,
+		scAddToDataDefinition,
+		scSetClientData,
+		scSetDataOnSimObject,
+		scClearClientDataDefinition,
+		scClearDataDefinition,
+		scMapInputEventToClientEvent,
+		scRequestNotificationGroup,
+		scClearInputGroup,
+		scClearNotificationGroup,
+		// scRequestReservedKey,
+		scSetInputGroupPriority,
+		scSetInputGroupState,
+		scRemoveInputEvent,
+		scAICreateEnrouteATCAircraft,
+		scAICreateNonATCAircraft,
+		scAICreateParkedATCAircraft,
+		scAICreateSimulatedObject,
+		scAIReleaseControl,
+		scAIRemoveObject,
+		scAISetAircraftFlightPlan,
+		scFlightLoad,
+		scFlightSave,
+		scFlightPlanLoad,
+		scGetLastSentPacketID,
+		// scRequestResponseTimes,
+		// scInsertString,
+		// scRetrieveString,
+		scRequestFacilitiesList,
+		scSubscribeToFacilities,
+		scSubscribeToFacilitiesEx1,
+		scUnsubscribeToFacilities,
+		// scCompleteCustomMissionAction,
+		// scExecuteMissionAction,
+		scMenuAddItem,
+		scMenuAddSubItem,
+		scMenuDeleteItem,
+		scMenuDeleteSubItem,
+		scCameraSetRelative6DOF,
+		scSetSystemState,
+	}
+	for _, procName := range procNames {
+		procs[procName] = library.NewProc(procName)
+	}
+}
+
+func callProc(procName string, args ...uintptr) error {
+	proc, ok := procs[procName]
+	if !ok {
+		return fmt.Errorf("proc %s not defined", procName)
+	}
+	r1, _, err := proc.Call(args...)
+	if int32(r1) < 0 {
+		return &HResultError{procName, uint32(r1), err}
+	}
+	return nil
+}
+
+func toBoolPtr(value bool) uintptr {
+	v := 0
+	if value {
+		v = 1
+	}
+	return uintptr(v)
+}
@@ -0,0 +1,391 @@
+package simconnect
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net"
+	"sync"
+	"time"
+	"unsafe"
+)
+
+// The SimConnect network protocol as spoken by the server configured through SimConnect.xml
+// (simulator side) and SimConnect.cfg (client side). Every packet sent to the server starts with
+// a 16 byte header, every packet received from the server starts with a SIMCONNECT_RECV.
+//
+// Header:
+//	DWORD dwSize     // size of the packet including the header
+//	DWORD dwVersion  // protocol version
+//	DWORD dwType     // 0xF0000000 | function ID
+//	DWORD dwSendID   // sequence number, see SimConnect_GetLastSentPacketID
+
+const (
+	ProtocolVersion DWord = 4 // FSX SP2 / Acceleration, understood by MSFS2020
+
+	netHeaderSize     = 16
+	netPacketTypeMask = 0xf0000000
+	netMaxPacketSize  = 1 << 20
+	netDialTimeout    = 5 * time.Second
+)
+
+// The SimConnect version announced to the server during the handshake.
+const (
+	netVersionMajor      DWord = 10
+	netVersionMinor      DWord = 0
+	netVersionBuildMajor DWord = 61259
+	netVersionBuildMinor DWord = 0
+)
+
+type netProc struct {
+	id      DWord
+	strings []int // sizes of the fixed length string arguments, in order
+}
+
+const netOpenID DWord = 0x01
+
+var netProcs = map[string]netProc{
+	scMapClientEventToSimEvent:          {0x04, []int{256}},
+	scTransmitClientEvent:               {0x05, nil},
+	scSetSystemEventState:               {0x06, nil},
+	scAddClientEventToNotificationGroup: {0x07, nil},
+	scRemoveClientEvent:                 {0x08, nil},
+	scSetNotificationGroupPriority:      {0x09, nil},
+	scClearNotificationGroup:            {0x0a, nil},
+	scRequestNotificationGroup:          {0x0b, nil},
+	scAddToDataDefinition:               {0x0c, []int{256, 256}},
+	scClearDataDefinition:               {0x0d, nil},
+	scRequestDataOnSimObject:            {0x0e, nil},
+	scRequestDataOnSimObjectType:        {0x0f, nil},
+	scSetDataOnSimObject:                {0x10, nil},
+	scMapInputEventToClientEvent:        {0x11, []int{256}},
+	scSetInputGroupPriority:             {0x12, nil},
+	scRemoveInputEvent:                  {0x13, []int{256}},
+	scClearInputGroup:                   {0x14, nil},
+	scSetInputGroupState:                {0x15, nil},
+	scRequestReservedKey:                {0x16, []int{30, 30, 30}},
+	scSubscribeToSystemEvent:            {0x17, []int{256}},
+	scUnsubscribeFromSystemEvent:        {0x18, nil},
+	scAICreateParkedATCAircraft:         {0x27, []int{256, 12, 5}},
+	scAICreateEnrouteATCAircraft:        {0x28, []int{256, 12, 260}},
+	scAICreateNonATCAircraft:            {0x29, []int{256, 12}},
+	scAICreateSimulatedObject:           {0x2a, []int{256}},
+	scAIReleaseControl:                  {0x2b, nil},
+	scAIRemoveObject:                    {0x2c, nil},
+	scAISetAircraftFlightPlan:           {0x2d, []int{260}},
+	scCameraSetRelative6DOF:             {0x30, nil},
+	scMenuAddItem:                       {0x31, []int{256}},
+	scMenuDeleteItem:                    {0x32, nil},
+	scMenuAddSubItem:                    {0x33, []int{256}},
+	scMenuDeleteSubItem:                 {0x34, nil},
+	scRequestSystemState:                {0x35, []int{256}},
+	scSetSystemState:                    {0x36, []int{256, 256}},
+	scMapClientDataNameToID:             {0x37, []int{256}},
+	scCreateClientData:                  {0x38, nil},
+	scAddToClientDataDefinition:         {0x39, nil},
+	scClearClientDataDefinition:         {0x3a, nil},
+	scRequestClientData:                 {0x3b, nil},
+	scSetClientData:                     {0x3c, nil},
+	scFlightLoad:                        {0x3d, []int{260}},
+	scFlightSave:                        {0x3e, []int{260, 260, 2048}},
+	scFlightPlanLoad:                    {0x3f, []int{260}},
+	scText:                              {0x40, nil},
+	scSubscribeToFacilities:             {0x41, nil},
+	scUnsubscribeToFacilities:           {0x42, nil},
+	scRequestFacilitiesList:             {0x43, nil},
+}
+
+var ErrClosed = errors.New("simconnect: connection closed")
+
+// NetworkTransport talks the SimConnect network protocol over TCP, so no SimConnect.dll is needed.
+// The simulator has to expose SimConnect on an IPv4 address via SimConnect.xml.
+//
+// The transport speaks the FSX SP2 protocol, which has none of the _EX1 functions MSFS added:
+// calls to them fail with ErrUnsupported. SendTo falls back to SimConnect_TransmitClientEvent
+// for events with at most one value, facilities.Store.Subscribe to SimConnect_SubscribeToFacilities,
+// which does not report the facilities going out of range.
+type NetworkTransport struct {
+	Address     string
+	DialTimeout time.Duration
+
+	conn      net.Conn
+	writeLock sync.Mutex
+	sendID    DWord
+
+	queueLock sync.Mutex
+	queue     [][]byte
+	readErr   error
+	current   []byte
+	done      chan struct{}
+	notify    chan struct{}
+}
+
+// NewNetworkTransport returns a Transport connecting to the SimConnect server at address (host:port).
+func NewNetworkTransport(address string) *NetworkTransport {
+	return &NetworkTransport{
+		Address:     address,
+		DialTimeout: netDialTimeout,
+	}
+}
+
+func (t *NetworkTransport) Open(name string, configIndex DWord) error {
+	conn, err := net.DialTimeout("tcp", t.Address, t.DialTimeout)
+	if err != nil {
+		return err
+	}
+	t.OpenConn(conn)
+	return t.sendOpen(name)
+}
+
+// OpenConn attaches the transport to an already established connection.
+// The handshake is not sent, use Open to connect and greet the server in one go.
+func (t *NetworkTransport) OpenConn(conn net.Conn) {
+	t.queueLock.Lock()
+	t.conn = conn
+	t.queue = nil
+	t.readErr = nil
+	t.current = nil
+	t.done = make(chan struct{})
+	t.queueLock.Unlock()
+
+	t.writeLock.Lock()
+	t.sendID = 0
+	t.writeLock.Unlock()
+
+	go t.receive(conn, t.done)
+}
+
+func (t *NetworkTransport) Close() error {
+	t.queueLock.Lock()
+	conn := t.conn
+	done := t.done
+	t.conn = nil
+	t.queueLock.Unlock()
+
+	if conn == nil {
+		return ErrNotConnected
+	}
+	err := conn.Close()
+	<-done
+	return err
+}
+
+func (t *NetworkTransport) Call(procName string, args ...interface{}) error {
+	if procName == scGetLastSentPacketID {
+		return t.lastSentPacketID(args)
+	}
+	proc, ok := netProcs[procName]
+	if !ok {
+		return fmt.Errorf("%w: the network protocol has no %s", ErrUnsupported, procName)
+	}
+	var payload bytes.Buffer
+	if err := encodeNetArgs(&payload, proc.strings, args); err != nil {
+		return fmt.Errorf("%s: %w", procName, err)
+	}
+	_, err := t.send(proc.id, payload.Bytes())
+	return err
+}
+
+func (t *NetworkTransport) GetNextDispatch() (unsafe.Pointer, DWord, error) {
+	t.queueLock.Lock()
+	defer t.queueLock.Unlock()
+
+	if len(t.queue) == 0 {
+		t.current = nil
+		if t.readErr != nil {
+			return nil, 0, t.readErr
+		}
+		return nil, 0, nil
+	}
+	t.current = t.queue[0]
+	t.queue[0] = nil
+	t.queue = t.queue[1:]
+	return unsafe.Pointer(&t.current[0]), DWord(len(t.current)), nil
+}
+
+// Notify implements Notifier. The channel is signalled whenever a packet has been read from the socket or the connection has failed.
+func (t *NetworkTransport) Notify() <-chan struct{} {
+	t.queueLock.Lock()
+	defer t.queueLock.Unlock()
+	return t.notifyChannel()
+}
+
+// LastSendID implements SendIDReporter. It returns the sequence number of the last packet sent to the server.
+func (t *NetworkTransport) LastSendID() DWord {
+	t.writeLock.Lock()
+	defer t.writeLock.Unlock()
+	return t.sendID
+}
+
+func (t *NetworkTransport) sendOpen(name string) error {
+	var payload bytes.Buffer
+	writeNetString(&payload, name, 256)
+	writeNetDWord(&payload, 0)
+	payload.Write([]byte{0, 'X', 'S', 'F'})
+	writeNetDWord(&payload, netVersionMajor)
+	writeNetDWord(&payload, netVersionMinor)
+	writeNetDWord(&payload, netVersionBuildMajor)
+	writeNetDWord(&payload, netVersionBuildMinor)
+	_, err := t.send(netOpenID, payload.Bytes())
+	return err
+}
+
+func (t *NetworkTransport) send(procID DWord, payload []byte) (DWord, error) {
+	t.writeLock.Lock()
+	defer t.writeLock.Unlock()
+
+	t.queueLock.Lock()
+	conn := t.conn
+	t.queueLock.Unlock()
+	if conn == nil {
+		return 0, ErrNotConnected
+	}
+
+	t.sendID++
+	packet := make([]byte, netHeaderSize, netHeaderSize+len(payload))
+	binary.LittleEndian.PutUint32(packet[0:], uint32(netHeaderSize+len(payload)))
+	binary.LittleEndian.PutUint32(packet[4:], uint32(ProtocolVersion))
+	binary.LittleEndian.PutUint32(packet[8:], uint32(netPacketTypeMask|procID))
+	binary.LittleEndian.PutUint32(packet[12:], uint32(t.sendID))
+	packet = append(packet, payload...)
+	if _, err := conn.Write(packet); err != nil {
+		return 0, err
+	}
+	return t.sendID, nil
+}
+
+func (t *NetworkTransport) receive(conn net.Conn, done chan struct{}) {
+	defer close(done)
+	for {
+		packet, err := ReadNetPacket(conn)
+		t.queueLock.Lock()
+		if err != nil {
+			if errors.Is(err, io.EOF) || errors.Is(err, net.ErrClosed) {
+				err = ErrClosed
+			}
+			t.readErr = err
+			t.signal()
+			t.queueLock.Unlock()
+			return
+		}
+		t.queue = append(t.queue, packet)
+		t.signal()
+		t.queueLock.Unlock()
+	}
+}
+
+// notifyChannel must be called with queueLock held.
+func (t *NetworkTransport) notifyChannel() chan struct{} {
+	if t.notify == nil {
+		t.notify = make(chan struct{}, 1)
+	}
+	return t.notify
+}
+
+// signal must be called with queueLock held.
+func (t *NetworkTransport) signal() {
+	select {
+	case t.notifyChannel() <- struct{}{}:
+	default:
+	}
+}
+
+func (t *NetworkTransport) lastSentPacketID(args []interface{}) error {
+	if len(args) != 1 {
+		return fmt.Errorf("%s: expected 1 argument, got %d", scGetLastSentPacketID, len(args))
+	}
+	pdwError, ok := args[0].(*DWord)
+	if !ok || pdwError == nil {
+		return fmt.Errorf("%s: expected *DWord, got %T", scGetLastSentPacketID, args[0])
+	}
+	*pdwError = t.LastSendID()
+	return nil
+}
+
+// ReadNetPacket reads a single size-prefixed packet from r.
+// The returned buffer holds the whole packet including the size field.
+func ReadNetPacket(r io.Reader) ([]byte, error) {
+	var sizeField [4]byte
+	if _, err := io.ReadFull(r, sizeField[:]); err != nil {
+		return nil, err
+	}
+	size := binary.LittleEndian.Uint32(sizeField[:])
+	if size < uint32(unsafe.Sizeof(Recv{})) || size > netMaxPacketSize {
+		return nil, fmt.Errorf("simconnect: invalid packet size %d", size)
+	}
+	packet := make([]byte, size)
+	copy(packet, sizeField[:])
+	if _, err := io.ReadFull(r, packet[4:]); err != nil {
+		if errors.Is(err, io.EOF) {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+	return packet, nil
+}
+
+func encodeNetArgs(w *bytes.Buffer, stringSizes []int, args []interface{}) error {
+	nextString := 0
+	stringSize := func() (int, error) {
+		if nextString >= len(stringSizes) {
+			return 0, errors.New("unexpected string argument")
+		}
+		size := stringSizes[nextString]
+		nextString++
+		return size, nil
+	}
+	for _, arg := range args {
+		switch value := arg.(type) {
+		case nil:
+			size, err := stringSize()
+			if err != nil {
+				return err
+			}
+			writeNetString(w, "", size)
+		case string:
+			size, err := stringSize()
+			if err != nil {
+				return err
+			}
+			writeNetString(w, value, size)
+		case DWord:
+			writeNetDWord(w, value)
+		case int32:
+			writeNetDWord(w, DWord(value))
+		case bool:
+			if value {
+				writeNetDWord(w, 1)
+			} else {
+				writeNetDWord(w, 0)
+			}
+		case float32:
+			writeNetDWord(w, DWord(math.Float32bits(value)))
+		case float64:
+			var b [8]byte
+			binary.LittleEndian.PutUint64(b[:], math.Float64bits(value))
+			w.Write(b[:])
+		case InitPosition:
+			binary.Write(w, binary.LittleEndian, value)
+		case []byte:
+			w.Write(value)
+		default:
+			return fmt.Errorf("unsupported argument type %T", arg)
+		}
+	}
+	return nil
+}
+
+func writeNetDWord(w *bytes.Buffer, value DWord) {
+	var b [4]byte
+	binary.LittleEndian.PutUint32(b[:], uint32(value))
+	w.Write(b[:])
+}
+
+func writeNetString(w *bytes.Buffer, str string, size int) {
+	b := make([]byte, size)
+	copy(b[:size-1], str)
+	w.Write(b)
+}
@@ -0,0 +1,259 @@
+package simconnect_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/grumpypixel/msfs2020-simconnect-go/simconnect"
+	"github.com/grumpypixel/msfs2020-simconnect-go/simconnect/events"
+)
+
+// netServer is a stand-in SimConnect server which accepts a single client.
+type netServer struct {
+	t       *testing.T
+	addr    string
+	conn    chan net.Conn
+	packets chan []byte
+}
+
+func newNetServer(t *testing.T) *netServer {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := &netServer{
+		t:       t,
+		addr:    listener.Addr().String(),
+		conn:    make(chan net.Conn, 1),
+		packets: make(chan []byte, 16),
+	}
+	t.Cleanup(func() {
+		listener.Close()
+		select {
+		case conn := <-server.conn:
+			conn.Close()
+		default:
+		}
+	})
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		server.conn <- conn
+		for {
+			packet, err := simconnect.ReadNetPacket(conn)
+			if err != nil {
+				close(server.packets)
+				return
+			}
+			server.packets <- packet
+		}
+	}()
+	return server
+}
+
+// next returns the next packet the client has sent.
+func (server *netServer) next() []byte {
+	server.t.Helper()
+	select {
+	case packet, ok := <-server.packets:
+		if !ok {
+			server.t.Fatal("connection closed")
+		}
+		return packet
+	case <-time.After(time.Second):
+		server.t.Fatal("no packet")
+	}
+	return nil
+}
+
+// write sends a message to the client.
+func (server *netServer) write(msg interface{}) {
+	server.t.Helper()
+	var b bytes.Buffer
+	if err := binary.Write(&b, binary.LittleEndian, msg); err != nil {
+		server.t.Fatal(err)
+	}
+	select {
+	case conn := <-server.conn:
+		server.conn <- conn
+		if _, err := conn.Write(b.Bytes()); err != nil {
+			server.t.Fatal(err)
+		}
+	case <-time.After(time.Second):
+		server.t.Fatal("no connection")
+	}
+}
+
+func dwordAt(packet []byte, offset int) simconnect.DWord {
+	return simconnect.DWord(binary.LittleEndian.Uint32(packet[offset:]))
+}
+
+func checkHeader(t *testing.T, packet []byte, size int, procID, sendID simconnect.DWord) {
+	t.Helper()
+	if len(packet) != size || dwordAt(packet, 0) != simconnect.DWord(size) {
+		t.Fatalf("size: got %d bytes, size field %d, want %d", len(packet), dwordAt(packet, 0), size)
+	}
+	if version := dwordAt(packet, 4); version != simconnect.ProtocolVersion {
+		t.Errorf("protocol version: got %d, want %d", version, simconnect.ProtocolVersion)
+	}
+	if typ := dwordAt(packet, 8); typ != 0xf0000000|procID {
+		t.Errorf("type: got %#x, want %#x", typ, 0xf0000000|procID)
+	}
+	if id := dwordAt(packet, 12); id != sendID {
+		t.Errorf("send ID: got %d, want %d", id, sendID)
+	}
+}
+
+func openNet(t *testing.T) (*simconnect.SimConnect, *netServer) {
+	server := newNetServer(t)
+	simco := simconnect.NewSimConnectWithTransport(simconnect.NewNetworkTransport(server.addr))
+	if err := simco.Open("Transport Test"); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { simco.Close() })
+	return simco, server
+}
+
+func TestNetworkTransportOpen(t *testing.T) {
+	_, server := openNet(t)
+	packet := server.next()
+	checkHeader(t, packet, 16+256+4+4+16, 0x01, 1)
+
+	payload := packet[16:]
+	name := payload[:256]
+	if !bytes.Equal(name[:14], []byte("Transport Test")) || !bytes.Equal(name[14:], make([]byte, 256-14)) {
+		t.Errorf("name: got %q", bytes.TrimRight(name, "\x00"))
+	}
+	if configIndex := dwordAt(payload, 256); configIndex != 0 {
+		t.Errorf("config index: got %d", configIndex)
+	}
+	if !bytes.Equal(payload[260:264], []byte{0, 'X', 'S', 'F'}) {
+		t.Errorf("signature: got %q", payload[260:264])
+	}
+	version := [4]simconnect.DWord{dwordAt(payload, 264), dwordAt(payload, 268), dwordAt(payload, 272), dwordAt(payload, 276)}
+	if version != [4]simconnect.DWord{10, 0, 61259, 0} {
+		t.Errorf("version: got %v, want 10.0.61259.0", version)
+	}
+}
+
+func TestNetworkTransportCall(t *testing.T) {
+	simco, server := openNet(t)
+	server.next()
+
+	if err := simco.AddToDataDefinition(7, "PLANE ALTITUDE", "feet", simconnect.DataTypeFloat64); err != nil {
+		t.Fatal(err)
+	}
+	packet := server.next()
+	checkHeader(t, packet, 16+4+256+256+4+4+4, 0x0c, 2)
+	payload := packet[16:]
+	if defineID := dwordAt(payload, 0); defineID != 7 {
+		t.Errorf("define ID: got %d", defineID)
+	}
+	if datum := string(bytes.TrimRight(payload[4:260], "\x00")); datum != "PLANE ALTITUDE" {
+		t.Errorf("datum name: got %q", datum)
+	}
+	if unit := string(bytes.TrimRight(payload[260:516], "\x00")); unit != "feet" {
+		t.Errorf("unit name: got %q", unit)
+	}
+	if dataType := dwordAt(payload, 516); dataType != simconnect.DataTypeFloat64 {
+		t.Errorf("data type: got %d", dataType)
+	}
+
+	var sendID simconnect.DWord
+	if err := simco.GetLastSentPacketID(&sendID); err != nil {
+		t.Fatal(err)
+	}
+	if sendID != 2 {
+		t.Errorf("GetLastSentPacketID: got %d, want 2", sendID)
+	}
+
+	if err := simco.RequestDataOnSimObject(1, 7, simconnect.ObjectIDUser, simconnect.PeriodSecond, 0); err != nil {
+		t.Fatal(err)
+	}
+	checkHeader(t, server.next(), 16+8*4, 0x0e, 3)
+}
+
+func TestNetworkTransportUnsupported(t *testing.T) {
+	simco, server := openNet(t)
+	server.next()
+
+	err := simco.SubscribeToFacilitiesEx1(simconnect.FacilityListTypeAirport, 1, 2)
+	if !errors.Is(err, simconnect.ErrUnsupported) {
+		t.Fatalf("got %v, want ErrUnsupported", err)
+	}
+
+	// Key events which take several parameters fall back to SimConnect_TransmitClientEvent with a single value.
+	event := events.New("TOGGLE_GENERAL_ENG_STARTER", events.Number, events.Bool)
+	if err := simco.Send(event, 2); err != nil {
+		t.Fatal(err)
+	}
+	checkHeader(t, server.next(), 16+4+256, 0x04, 2)
+	packet := server.next()
+	checkHeader(t, packet, 16+5*4, 0x05, 3)
+	if data := dwordAt(packet, 24); data != 2 {
+		t.Errorf("data: got %d, want 2", data)
+	}
+	if err := simco.Send(event, 2, 1); !errors.Is(err, simconnect.ErrUnsupported) {
+		t.Errorf("two values: got %v, want ErrUnsupported", err)
+	}
+}
+
+func TestNetworkTransportDispatch(t *testing.T) {
+	server := newNetServer(t)
+	transport := simconnect.NewNetworkTransport(server.addr)
+	if err := transport.Open("Transport Test", 0); err != nil {
+		t.Fatal(err)
+	}
+	server.next()
+
+	if ptr, _, err := transport.GetNextDispatch(); ptr != nil || err != nil {
+		t.Fatalf("nothing received yet: got %v, %v", ptr, err)
+	}
+	var open simconnect.RecvOpen
+	open.Size = simconnect.DWord(binary.Size(open))
+	open.Version = simconnect.ProtocolVersion
+	open.ID = simconnect.RecvIDOpen
+	copy(open.ApplicationName[:], "KittyHawk")
+	open.ApplicationVersionMajor = 11
+	server.write(open)
+
+	select {
+	case <-transport.Notify():
+	case <-time.After(time.Second):
+		t.Fatal("not notified")
+	}
+	ptr, size, err := transport.GetNextDispatch()
+	if err != nil || ptr == nil {
+		t.Fatalf("got %v, %v", ptr, err)
+	}
+	if size != open.Size {
+		t.Errorf("size: got %d, want %d", size, open.Size)
+	}
+	msg, err := simconnect.Decode(ptr, size)
+	if err != nil {
+		t.Fatal(err)
+	}
+	recvOpen, ok := msg.(*simconnect.RecvOpen)
+	if !ok {
+		t.Fatalf("got %T, want *RecvOpen", msg)
+	}
+	if name := string(bytes.TrimRight(recvOpen.ApplicationName[:], "\x00")); name != "KittyHawk" || recvOpen.ApplicationVersionMajor != 11 {
+		t.Errorf("got %q %d", name, recvOpen.ApplicationVersionMajor)
+	}
+	if ptr, _, err := transport.GetNextDispatch(); ptr != nil || err != nil {
+		t.Fatalf("queue drained: got %v, %v", ptr, err)
+	}
+
+	if err := transport.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := transport.GetNextDispatch(); !errors.Is(err, simconnect.ErrClosed) {
+		t.Errorf("after Close: got %v, want ErrClosed", err)
+	}
+}
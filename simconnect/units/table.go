@@ -0,0 +1,287 @@
+package units
+
+import (
+	"math"
+)
+
+// Conversion factors to the base units (meter, kelvin, radian, pascal, ...).
+const (
+	foot      = 0.3048
+	inch      = 0.0254
+	mile      = 1609.344
+	nmile     = 1852.0
+	gallon    = 3.785411784e-3
+	pound     = 0.45359237
+	slug      = 14.59390294
+	gravity   = 9.80665
+	inHg      = 3386.389
+	mmHg      = 133.322387415
+	psi       = 6894.757293168
+	psf       = 47.880258980
+	atm       = 101325.0
+	footPound = 1.3558179483
+	degree    = math.Pi / 180
+	hour      = 3600.0
+	earth     = 6378137.0 // equatorial radius in meters, for meter latitude
+)
+
+var units = []*Unit{
+	// Length, in meters.
+	linear("meter", Length, 1, "meters", "m"),
+	linear("centimeter", Length, 0.01, "centimeters", "cm"),
+	linear("kilometer", Length, 1000, "kilometers", "km"),
+	linear("millimeter", Length, 0.001, "millimeters"),
+	linear("mile", Length, mile, "miles"),
+	linear("nmile", Length, nmile, "nmiles", "nautical mile", "nautical miles"),
+	linear("decinmile", Length, nmile/10, "decinmiles", "decimile", "decimiles"),
+	linear("foot", Length, foot, "feet", "ft"),
+	linear("inch", Length, inch, "inches", "in"),
+	linear("yard", Length, 3*foot, "yards"),
+	linear("meter scaler 256", Length, 1.0/256, "meters scaler 256"),
+
+	// Area, in square meters.
+	linear("square meter", Area, 1, "square meters", "sq m", "m2"),
+	linear("square centimeter", Area, 1e-4, "square centimeters", "sq cm", "cm2"),
+	linear("square kilometer", Area, 1e6, "square kilometers", "sq km", "km2"),
+	linear("square millimeter", Area, 1e-6, "square millimeters", "sq mm", "mm2"),
+	linear("square mile", Area, mile*mile, "square miles"),
+	linear("square feet", Area, foot*foot, "square foot", "sq ft", "ft2"),
+	linear("square inch", Area, inch*inch, "square inches", "sq in", "in2"),
+	linear("square yard", Area, 9*foot*foot, "square yards", "sq yd", "yd2"),
+
+	// Volume, in cubic meters.
+	linear("meter cubed", Volume, 1, "meters cubed", "cubic meter", "cubic meters", "cu m", "m3"),
+	linear("liter", Volume, 0.001, "liters"),
+	linear("gallon", Volume, gallon, "gallons"),
+	linear("quart", Volume, gallon/4, "quarts"),
+	linear("cubic centimeter", Volume, 1e-6, "cubic centimeters", "cu cm", "cm3"),
+	linear("cubic kilometer", Volume, 1e9, "cubic kilometers", "cu km", "km3"),
+	linear("cubic millimeter", Volume, 1e-9, "cubic millimeters", "cu mm", "mm3"),
+	linear("cubic mile", Volume, mile*mile*mile, "cubic miles"),
+	linear("cubic feet", Volume, foot*foot*foot, "cubic foot", "cu ft", "ft3"),
+	linear("cubic inch", Volume, inch*inch*inch, "cubic inches", "cu in", "in3"),
+	linear("cubic yard", Volume, 27*foot*foot*foot, "cubic yards", "cu yd", "yd3"),
+	opaque("fs7 oil quantity", Unknown),
+
+	// Temperature, in kelvin.
+	linear("kelvin", Temperature, 1),
+	linear("rankine", Temperature, 5.0/9),
+	affine("farenheit", Temperature, 5.0/9, 459.67*5/9, "fahrenheit"),
+	affine("celsius", Temperature, 1, 273.15),
+	affine("celsius scaler 16k", Temperature, 1.0/16384, 273.15),
+	affine("celsius scaler 256", Temperature, 1.0/256, 273.15),
+	affine("celsius scaler 1/256", Temperature, 256, 273.15),
+	opaque("celsius fs7 egt", Unknown),
+	opaque("celsius fs7 oil temp", Unknown),
+	opaque("GLOBALP->eng1.oil_tmp", Unknown),
+
+	// Ratios, in parts of one.
+	linear("number", Ratio, 1, "numbers"),
+	linear("part", Ratio, 1),
+	linear("half", Ratio, 0.5, "halfs"),
+	linear("third", Ratio, 1.0/3, "thirds"),
+	linear("percent", Ratio, 0.01),
+	linear("percentage", Ratio, 0.01),
+	linear("percent over 100", Ratio, 1),
+	linear("times", Ratio, 1),
+	linear("ratio", Ratio, 1),
+	linear("scaler", Ratio, 1),
+	linear("percent scaler 16k", Ratio, 1.0/16384),
+	linear("percent scaler 32k", Ratio, 1.0/32768),
+	linear("percent scaler 2pow23", Ratio, 1.0/(1<<23)),
+	linear("position", Ratio, 1),
+	linear("position 16k", Ratio, 1.0/16384),
+	linear("position 32k", Ratio, 1.0/32768),
+	linear("position 128", Ratio, 1.0/128),
+	opaque("keyframe", Unknown, "keyframes"),
+
+	// Loudness, in bels.
+	linear("bel", Loudness, 1, "bels"),
+	linear("decibel", Loudness, 0.1, "decibels"),
+
+	// Angles, in radians.
+	linear("radian", Angle, 1, "radians"),
+	linear("degree", Angle, degree, "degrees"),
+	linear("round", Angle, 2*math.Pi, "rounds"),
+	linear("grad", Angle, math.Pi/200, "grads"),
+	linear("angl16", Angle, 2*math.Pi/(1<<16), "degree angl16", "degrees angl16"),
+	linear("angl32", Angle, 2*math.Pi/(1<<32), "degree angl32", "degrees angl32"),
+	linear("degree latitude", Angle, degree, "degrees latitude"),
+	linear("degree longitude", Angle, degree, "degrees longitude"),
+	linear("meter latitude", Angle, 1/earth, "meters latitude"),
+	linear("per radian", InverseAngle, 1),
+	linear("per degree", InverseAngle, 1/degree),
+
+	// Angular velocity, in radians per second.
+	linear("radian per second", AngularVelocity, 1, "radians per second"),
+	linear("revolution per minute", AngularVelocity, 2*math.Pi/60, "revolutions per minute", "rpm", "rpms"),
+	linear("rpm 1 over 16k", AngularVelocity, 2*math.Pi/60/16384),
+	linear("degree per second", AngularVelocity, degree, "degrees per second"),
+	linear("degree per second ang16", AngularVelocity, 2*math.Pi/(1<<16), "degrees per second ang16"),
+	opaque("minute per round", Unknown, "minutes per round"),
+	opaque("nice minute per round", Unknown, "nice minutes per round"),
+	opaque("GLOBALP->delta_heading_rate", Unknown),
+
+	// Speed, in meters per second.
+	linear("meter/second", Speed, 1, "meter per second", "meters per second", "meters/second", "m/s"),
+	linear("meter per minute", Speed, 1.0/60, "meters per minute"),
+	linear("feet/second", Speed, foot, "feet per second"),
+	linear("feet/minute", Speed, foot/60, "feet per minute", "ft/min"),
+	linear("kilometer/hour", Speed, 1000/hour, "kilometers/hour", "kilometer per hour", "kilometers per hour", "kph"),
+	linear("knot", Speed, nmile/hour, "knots"),
+	linear("mile per hour", Speed, mile/hour, "miles per hour", "mph"),
+	linear("knot scaler 128", Speed, nmile/hour/128, "knots scaler 128"),
+	linear("meter per second scaler 256", Speed, 1.0/256, "meters per second scaler 256"),
+	opaque("GLOBALP->vertical_speed", Unknown),
+	linear("mach", MachNumber, 1, "machs"),
+	linear("mach 3d2 over 64k", MachNumber, 3.2/65536),
+
+	// Rates, per second.
+	linear("per second", Rate, 1),
+	linear("per minute", Rate, 1.0/60),
+	linear("per hour", Rate, 1/hour),
+
+	// Pressure, in pascals.
+	linear("pascal", Pressure, 1, "pascals", "Pa", "newton per square meter", "newtons per square meter"),
+	linear("kilopascal", Pressure, 1000, "kPa"),
+	linear("hectopascal", Pressure, 100, "hectopascals"),
+	linear("kilogram force per square centimeter", Pressure, gravity*1e4, "KgFSqCm"),
+	linear("millimeter of mercury", Pressure, mmHg, "millimeters of mercury", "mmHg"),
+	linear("centimeter of mercury", Pressure, 10*mmHg, "centimeters of mercury", "cmHg"),
+	linear("inch of mercury", Pressure, inHg, "inches of mercury", "inHg"),
+	linear("inHg 64 over 64k", Pressure, inHg*64/65536),
+	linear("atmosphere", Pressure, atm, "atmospheres", "atm"),
+	linear("millimeter of water", Pressure, gravity, "millimeters of water"),
+	linear("pound-force per square inch", Pressure, psi, "psi"),
+	linear("pound-force per square foot", Pressure, psf, "psf"),
+	linear("bar", Pressure, 1e5, "bars"),
+	linear("millibar", Pressure, 100, "millibars", "mbar", "mbars"),
+	linear("millibar scaler 16", Pressure, 100.0/16, "millibars scaler 16"),
+	linear("psf scaler 16k", Pressure, psf/16384),
+	linear("psi scaler 16k", Pressure, psi/16384),
+	linear("psi 4 over 16k", Pressure, psi*4/16384),
+	affine("boost cmHg", Pressure, 10*mmHg, atm),
+	affine("boost inHg", Pressure, inHg, atm),
+	affine("boost psi", Pressure, psi, atm),
+	opaque("psi fs7 oil pressure", Unknown),
+	opaque("GLOBALP->eng1.manifold_pressure", Unknown),
+	opaque("GLOBALP->eng1.oil_prs", Unknown),
+
+	// Time, in seconds.
+	linear("second", Time, 1, "seconds"),
+	linear("minute", Time, 60, "minutes"),
+	linear("hour", Time, hour, "hours"),
+	linear("day", Time, 24*hour, "days"),
+	linear("hour over 10", Time, hour/10, "hours over 10"),
+	linear("year", Time, 365*24*hour, "years"),
+
+	// Power, in watts.
+	linear("Watt", Power, 1, "Watts"),
+	linear("ft lb per second", Power, footPound),
+
+	// Flow, in cubic meters and kilograms per second.
+	linear("meter cubed per second", VolumeFlow, 1, "meters cubed per second"),
+	linear("gallon per hour", VolumeFlow, gallon/hour, "gallons per hour", "gph"),
+	linear("liter per hour", VolumeFlow, 0.001/hour, "liters per hour"),
+	linear("kilogram per second", MassFlow, 1, "kilograms per second"),
+	linear("pound per hour", MassFlow, pound/hour, "pounds per hour", "pph"),
+
+	// Mass, in kilograms.
+	linear("kilogram", Mass, 1, "kilograms", "kg"),
+	linear("pound", Mass, pound, "pounds", "lbs"),
+	linear("pound scaler 256", Mass, pound/256, "pounds scaler 256"),
+	linear("slug", Mass, slug, "slugs", "geepound", "geepounds"),
+
+	// Moment of inertia, in kilogram square meters.
+	linear("kilogram meter squared", MomentOfInertia, 1, "kilograms meter squared"),
+	linear("slug feet squared", MomentOfInertia, slug*foot*foot, "slugs feet squared"),
+
+	// Electricity.
+	linear("ampere", Current, 1, "amperes", "amp", "amps"),
+	opaque("fs7 charging amps", Unknown),
+	linear("volt", Voltage, 1, "volts"),
+
+	// Frequency, in hertz.
+	linear("Hertz", Frequency, 1, "Hz"),
+	linear("Kilohertz", Frequency, 1e3, "KHz"),
+	linear("Megahertz", Frequency, 1e6, "MHz"),
+	custom("Frequency BCD16", Frequency,
+		func(v float64) float64 { return (10000 + fromBCD(v)) * 1e4 },
+		func(hz float64) float64 { return toBCD(math.Round(hz/1e4) - 10000) }),
+	custom("Frequency BCD32", Frequency,
+		func(v float64) float64 { return fromBCD(v) * 100 },
+		func(hz float64) float64 { return toBCD(math.Round(hz / 100)) }),
+	custom("Frequency ADF BCD32", Frequency,
+		func(v float64) float64 { return fromBCD(v) / 10 },
+		func(hz float64) float64 { return toBCD(math.Round(hz * 10)) }),
+
+	// Acceleration, in meters per second squared.
+	linear("meter per second squared", Acceleration, 1, "meters per second squared"),
+	linear("feet per second squared", Acceleration, foot, "foot per second squared"),
+	linear("GForce", Acceleration, gravity, "G Force"),
+	linear("G Force 624 scaled", Acceleration, gravity/624),
+
+	// Density, in kilograms per cubic meter.
+	linear("kilogram per cubic meter", Density, 1, "kilograms per cubic meter"),
+	linear("Slug per cubic feet", Density, slug/(foot*foot*foot),
+		"Slug per cubic foot", "Slugs per cubic feet", "Slugs per cubic foot", "Slug/ft3"),
+
+	// Torque, in newton meters.
+	linear("newton meter", Torque, 1, "newton meters", "Nm"),
+	linear("foot pound", Torque, footPound, "foot pounds", "foot-pound", "foot-pounds", "ft-lbs", "lbf-feet"),
+	linear("kilogram meter", Torque, gravity, "kilogram meters", "kgf meter", "kgf meters"),
+	linear("poundal feet", Torque, 0.138254954376*foot),
+
+	// Units which only convert to themselves.
+	opaque("Bool", Boolean, "Boolean"),
+	opaque("more_than_a_half", Boolean),
+	opaque("Enum", Enumeration),
+	opaque("mask", Enumeration),
+	opaque("flags", Enumeration),
+	opaque("Bco16", Code),
+}
+
+func linear(name string, dimension Dimension, scale float64, aliases ...string) *Unit {
+	return affine(name, dimension, scale, 0, aliases...)
+}
+
+// affine returns a unit for which base = value*scale + offset.
+func affine(name string, dimension Dimension, scale, offset float64, aliases ...string) *Unit {
+	return custom(name, dimension,
+		func(v float64) float64 { return v*scale + offset },
+		func(v float64) float64 { return (v - offset) / scale },
+		aliases...)
+}
+
+func custom(name string, dimension Dimension, toBase, fromBase func(float64) float64, aliases ...string) *Unit {
+	return &Unit{Name: name, Dimension: dimension, aliases: aliases, toBase: toBase, fromBase: fromBase}
+}
+
+func opaque(name string, dimension Dimension, aliases ...string) *Unit {
+	return &Unit{Name: name, Dimension: dimension, aliases: aliases}
+}
+
+// fromBCD reads the hex digits of v as decimal digits, e.g. 0x2345 as 2345.
+func fromBCD(v float64) float64 {
+	bcd := uint64(v)
+	result, place := 0.0, 1.0
+	for ; bcd > 0; bcd >>= 4 {
+		result += float64(bcd&0xf) * place
+		place *= 10
+	}
+	return result
+}
+
+// toBCD is the inverse of fromBCD.
+func toBCD(v float64) float64 {
+	if v < 0 {
+		return 0
+	}
+	n := uint64(v)
+	var result uint64
+	for shift := 0; n > 0; shift += 4 {
+		result |= (n % 10) << shift
+		n /= 10
+	}
+	return float64(result)
+}
@@ -0,0 +1,198 @@
+// Package units knows the unit names SimConnect accepts (see references/units.txt), their dimensions,
+// and how to convert values between units of the same dimension.
+//
+// Names are matched without regard to case and surrounding blanks, so "feet", "Foot" and "ft" are the same unit.
+package units
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+var (
+	ErrUnknownUnit  = errors.New("units: unknown unit")
+	ErrIncompatible = errors.New("units: incompatible units")
+)
+
+// Dimension is the physical quantity a unit measures.
+type Dimension int
+
+const (
+	Unknown Dimension = iota // internal simulator units which convert only to themselves
+	Length
+	Area
+	Volume
+	Temperature
+	Angle
+	InverseAngle
+	AngularVelocity
+	Speed
+	MachNumber
+	Acceleration
+	Pressure
+	Time
+	Rate
+	Frequency
+	Mass
+	MassFlow
+	VolumeFlow
+	Density
+	Torque
+	MomentOfInertia
+	Power
+	Current
+	Voltage
+	Ratio
+	Loudness
+	Boolean
+	Enumeration
+	Code
+)
+
+var dimensionNames = [...]string{
+	Unknown:         "unknown",
+	Length:          "length",
+	Area:            "area",
+	Volume:          "volume",
+	Temperature:     "temperature",
+	Angle:           "angle",
+	InverseAngle:    "inverse angle",
+	AngularVelocity: "angular velocity",
+	Speed:           "speed",
+	MachNumber:      "mach number",
+	Acceleration:    "acceleration",
+	Pressure:        "pressure",
+	Time:            "time",
+	Rate:            "rate",
+	Frequency:       "frequency",
+	Mass:            "mass",
+	MassFlow:        "mass flow",
+	VolumeFlow:      "volume flow",
+	Density:         "density",
+	Torque:          "torque",
+	MomentOfInertia: "moment of inertia",
+	Power:           "power",
+	Current:         "current",
+	Voltage:         "voltage",
+	Ratio:           "ratio",
+	Loudness:        "loudness",
+	Boolean:         "boolean",
+	Enumeration:     "enumeration",
+	Code:            "code",
+}
+
+func (d Dimension) String() string {
+	if d >= 0 && int(d) < len(dimensionNames) {
+		return dimensionNames[d]
+	}
+	return fmt.Sprintf("Dimension(%d)", int(d))
+}
+
+// Unit is a unit of measurement. Values convert via the base unit of the dimension, e.g. meters for Length.
+type Unit struct {
+	Name      string // canonical name, as in references/unique_units.txt
+	Dimension Dimension
+	aliases   []string
+	toBase    func(float64) float64 // nil for units which only convert to themselves
+	fromBase  func(float64) float64
+}
+
+// Aliases returns the other names of the unit.
+func (u *Unit) Aliases() []string {
+	return append([]string(nil), u.aliases...)
+}
+
+// Compatible reports whether values convert between u and other.
+func (u *Unit) Compatible(other *Unit) bool {
+	if u == other {
+		return true
+	}
+	return u.Dimension == other.Dimension && u.toBase != nil && other.toBase != nil
+}
+
+// Convert converts value from u to unit to.
+func (u *Unit) Convert(value float64, to *Unit) (float64, error) {
+	if u == to {
+		return value, nil
+	}
+	if !u.Compatible(to) {
+		return 0, fmt.Errorf("%w: %s (%s) and %s (%s)", ErrIncompatible, u.Name, u.Dimension, to.Name, to.Dimension)
+	}
+	return to.fromBase(u.toBase(value)), nil
+}
+
+func (u *Unit) String() string {
+	return u.Name
+}
+
+// Lookup returns the unit with the given name or alias.
+func Lookup(name string) (*Unit, bool) {
+	u, ok := byName[normalize(name)]
+	return u, ok
+}
+
+// Parse is Lookup with an error.
+func Parse(name string) (*Unit, error) {
+	if u, ok := Lookup(name); ok {
+		return u, nil
+	}
+	return nil, fmt.Errorf("%w: %q", ErrUnknownUnit, name)
+}
+
+// Convert converts value between two units given by name, e.g. Convert(1000, "feet", "m").
+func Convert(value float64, from, to string) (float64, error) {
+	fromUnit, err := Parse(from)
+	if err != nil {
+		return 0, err
+	}
+	toUnit, err := Parse(to)
+	if err != nil {
+		return 0, err
+	}
+	return fromUnit.Convert(value, toUnit)
+}
+
+// Same reports whether two names denote the same unit.
+func Same(a, b string) bool {
+	ua, okA := Lookup(a)
+	ub, okB := Lookup(b)
+	return okA && okB && ua == ub
+}
+
+// All returns all units, sorted by name.
+func All() []*Unit {
+	result := append([]*Unit(nil), units...)
+	sort.Slice(result, func(i, j int) bool { return strings.ToLower(result[i].Name) < strings.ToLower(result[j].Name) })
+	return result
+}
+
+// InDimension returns the units of dimension d, sorted by name.
+func InDimension(d Dimension) []*Unit {
+	var result []*Unit
+	for _, u := range All() {
+		if u.Dimension == d {
+			result = append(result, u)
+		}
+	}
+	return result
+}
+
+var byName = make(map[string]*Unit)
+
+func init() {
+	for _, u := range units {
+		for _, name := range append([]string{u.Name}, u.aliases...) {
+			key := normalize(name)
+			if _, exists := byName[key]; exists {
+				panic("units: duplicate unit name " + name)
+			}
+			byName[key] = u
+		}
+	}
+}
+
+func normalize(name string) string {
+	return strings.ToLower(strings.Join(strings.Fields(name), " "))
+}
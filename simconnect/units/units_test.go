@@ -0,0 +1,107 @@
+package units_test
+
+import (
+	"bufio"
+	"errors"
+	"math"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/grumpypixel/msfs2020-simconnect-go/simconnect/units"
+)
+
+func TestConvert(t *testing.T) {
+	tests := []struct {
+		value    float64
+		from, to string
+		want     float64
+	}{
+		{1000, "feet", "meters", 304.8},
+		{304.8, "m", "ft", 1000},
+		{1, "nautical mile", "meters", 1852},
+		{100, "celsius", "fahrenheit", 212},
+		{212, "fahrenheit", "celsius", 100},
+		{-40, "celsius", "fahrenheit", -40},
+		{0, "celsius", "rankine", 491.67},
+		{29.92, "inHg", "millibars", 1013.2075888},
+		{180, "degrees", "radians", math.Pi},
+		{50, "percent", "percent over 100", 0.5},
+		{123.45, "MHz", "Frequency BCD16", 0x2345},
+		{0x2345, "Frequency BCD16", "MHz", 123.45},
+		{118.0, "MHz", "Frequency BCD16", 0x1800},
+		{344, "KHz", "Frequency ADF BCD32", 0x03440000},
+		{0x03440000, "Frequency ADF BCD32", "KHz", 344},
+		{0x01234500, "Frequency BCD32", "MHz", 123.45},
+	}
+	for _, test := range tests {
+		got, err := units.Convert(test.value, test.from, test.to)
+		if err != nil {
+			t.Errorf("%v %s to %s: %v", test.value, test.from, test.to, err)
+			continue
+		}
+		if math.Abs(got-test.want) > 1e-9*math.Max(1, math.Abs(test.want)) {
+			t.Errorf("%v %s to %s: got %v, want %v", test.value, test.from, test.to, got, test.want)
+		}
+	}
+}
+
+func TestConvertErrors(t *testing.T) {
+	if _, err := units.Convert(1, "feet", "knots"); !errors.Is(err, units.ErrIncompatible) {
+		t.Errorf("feet to knots: got %v, want ErrIncompatible", err)
+	}
+	// Units without a conversion only convert to themselves.
+	if _, err := units.Convert(1, "Bco16", "Bool"); !errors.Is(err, units.ErrIncompatible) {
+		t.Errorf("Bco16 to Bool: got %v, want ErrIncompatible", err)
+	}
+	if got, err := units.Convert(0x1200, "Bco16", "bco16"); got != 0x1200 || err != nil {
+		t.Errorf("Bco16 to Bco16: got %v, %v", got, err)
+	}
+	if _, err := units.Convert(1, "feet", "furlongs"); !errors.Is(err, units.ErrUnknownUnit) {
+		t.Errorf("furlongs: got %v, want ErrUnknownUnit", err)
+	}
+}
+
+func TestLookup(t *testing.T) {
+	foot, err := units.Parse("foot")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"ft", "feet", "Feet", "  FOOT ", "foot"} {
+		if u, ok := units.Lookup(name); !ok || u != foot {
+			t.Errorf("%q: got %v, want %v", name, u, foot)
+		}
+	}
+	if !units.Same("ft", "feet") || units.Same("ft", "m") || units.Same("ft", "furlongs") {
+		t.Error("Same does not match the aliases")
+	}
+	if foot.Dimension != units.Length || foot.Dimension.String() != "length" {
+		t.Errorf("dimension: got %v", foot.Dimension)
+	}
+	for _, u := range units.InDimension(units.Length) {
+		if u.Dimension != units.Length {
+			t.Errorf("InDimension(Length) returned %s of %s", u, u.Dimension)
+		}
+	}
+}
+
+// TestReferenceUnits checks that every unit name of the SDK reference is known.
+func TestReferenceUnits(t *testing.T) {
+	for _, path := range []string{"../../references/units.txt", "../../references/unique_units.txt"} {
+		file, err := os.Open(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		scanner := bufio.NewScanner(file)
+		for scanner.Scan() {
+			name := strings.TrimSpace(scanner.Text())
+			if name == "" || strings.HasPrefix(name, "#") {
+				continue
+			}
+			if _, ok := units.Lookup(name); !ok {
+				t.Errorf("%s: unknown unit %q", path, name)
+			}
+		}
+		file.Close()
+	}
+}
@@ -33,24 +33,31 @@ func IsStringDataType(dataType DWord) bool {
 	return false
 }
 
+// The ValueTo functions convert with Convert and return the zero value if that fails.
+
 func ValueToInt32(value interface{}) int32 {
-	return value.(int32)
+	v, _ := Convert[int32](value)
+	return v
 }
 
 func ValueToInt64(value interface{}) int64 {
-	return value.(int64)
+	v, _ := Convert[int64](value)
+	return v
 }
 
 func ValueToFloat32(value interface{}) float32 {
-	return value.(float32)
+	v, _ := Convert[float32](value)
+	return v
 }
 
 func ValueToFloat64(value interface{}) float64 {
-	return value.(float64)
+	v, _ := Convert[float64](value)
+	return v
 }
 
 func ValueToString(value interface{}) string {
-	return value.(string)
+	v, _ := Convert[string](value)
+	return v
 }
 
 func stringToDataTypeMapping() map[string]DWord {
@@ -0,0 +1,138 @@
+package simconnect
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+)
+
+var (
+	ErrUnknownSimVar = errors.New("simconnect: unknown simvar")
+	ErrNoValue       = errors.New("simconnect: simvar has no value yet")
+	ErrTypeMismatch  = errors.New("simconnect: type mismatch")
+)
+
+// Number is the set of numeric types SimVar values convert into.
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 |
+		~float32 | ~float64
+}
+
+// Value is the set of types Get, Typed and Convert return.
+type Value interface {
+	Number | ~string | ~bool
+}
+
+// Get returns the value of a SimMate SimVar as T, see Convert.
+func Get[T Value](mate *SimMate, defineID DWord) (T, error) {
+	mate.mutex.Lock()
+	defer mate.mutex.Unlock()
+	return Typed[T](mate.simVarManager, defineID)
+}
+
+// Typed returns the value of a SimVar as T, see Convert.
+func Typed[T Value](mgr *SimVarManager, defineID DWord) (T, error) {
+	var zero T
+	simVar, ok := mgr.GetSimVar(defineID)
+	if !ok {
+		return zero, fmt.Errorf("%w: define ID %d", ErrUnknownSimVar, defineID)
+	}
+	mgr.mutex.Lock()
+	value := simVar.Value
+	mgr.mutex.Unlock()
+	if value == nil {
+		return zero, fmt.Errorf("%w: %s", ErrNoValue, simVar.Name)
+	}
+	return Convert[T](value)
+}
+
+// Convert converts a SimVar value to T. Numbers convert into each other as long as the value fits into T;
+// floats are truncated toward zero when converted to integers. A bool is 1 or 0, and a number is true unless it is 0.
+// Strings only convert into strings. Everything else fails with ErrTypeMismatch.
+func Convert[T Value](value interface{}) (T, error) {
+	var result T
+	target := reflect.ValueOf(&result).Elem()
+	source := reflect.ValueOf(value)
+	mismatch := func() (T, error) {
+		var zero T
+		return zero, fmt.Errorf("%w: cannot convert %T(%v) to %T", ErrTypeMismatch, value, value, zero)
+	}
+	if !source.IsValid() {
+		return mismatch()
+	}
+
+	switch target.Kind() {
+	case reflect.String:
+		if source.Kind() != reflect.String {
+			return mismatch()
+		}
+		target.SetString(source.String())
+
+	case reflect.Bool:
+		if source.Kind() == reflect.Bool {
+			target.SetBool(source.Bool())
+			break
+		}
+		number, err := toFloat64(value)
+		if err != nil {
+			return mismatch()
+		}
+		target.SetBool(number != 0)
+
+	case reflect.Float32, reflect.Float64:
+		number, err := toFloat64(value)
+		if err != nil {
+			return mismatch()
+		}
+		if target.OverflowFloat(number) {
+			return mismatch()
+		}
+		target.SetFloat(number)
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		switch source.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			if target.OverflowInt(source.Int()) {
+				return mismatch()
+			}
+			target.SetInt(source.Int())
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			if source.Uint() > math.MaxInt64 || target.OverflowInt(int64(source.Uint())) {
+				return mismatch()
+			}
+			target.SetInt(int64(source.Uint()))
+		default:
+			number, err := toFloat64(value)
+			if err != nil || math.IsNaN(number) || number < math.MinInt64 || number >= math.MaxInt64 || target.OverflowInt(int64(number)) {
+				return mismatch()
+			}
+			target.SetInt(int64(number))
+		}
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		switch source.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			if source.Int() < 0 || target.OverflowUint(uint64(source.Int())) {
+				return mismatch()
+			}
+			target.SetUint(uint64(source.Int()))
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			if target.OverflowUint(source.Uint()) {
+				return mismatch()
+			}
+			target.SetUint(source.Uint())
+		default:
+			number, err := toFloat64(value)
+			if err != nil || math.IsNaN(number) || number <= -1 || number >= math.MaxUint64 || target.OverflowUint(uint64(number)) {
+				return mismatch()
+			}
+			target.SetUint(uint64(number))
+		}
+
+	default:
+		return mismatch()
+	}
+	return result, nil
+}
@@ -0,0 +1,120 @@
+package simconnect_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/grumpypixel/msfs2020-simconnect-go/simconnect"
+)
+
+// as returns Convert[T] with the result boxed, so conversions to different types fit into one table.
+func as[T simconnect.Value]() func(value interface{}) (interface{}, error) {
+	return func(value interface{}) (interface{}, error) {
+		return simconnect.Convert[T](value)
+	}
+}
+
+func TestConvert(t *testing.T) {
+	tests := []struct {
+		name    string
+		convert func(value interface{}) (interface{}, error)
+		value   interface{}
+		want    interface{} // nil if the conversion fails with ErrTypeMismatch
+	}{
+		{"int32 to float64", as[float64](), int32(-5), float64(-5)},
+		{"float64 to float32", as[float32](), 1.5, float32(1.5)},
+		{"float64 beyond float32", as[float32](), 1e300, nil},
+		{"float64 to int8", as[int8](), 127.9, int8(127)},
+		{"float64 beyond int8", as[int8](), 128.0, nil},
+		{"negative float64 to int", as[int](), -3.7, -3},
+		{"int64 to int16", as[int16](), int64(-32768), int16(-32768)},
+		{"int64 beyond int16", as[int16](), int64(40000), nil},
+		{"uint64 to int32", as[int32](), uint64(1 << 31), nil},
+		{"uint64 beyond int64", as[int64](), uint64(math.MaxUint64), nil},
+		{"uint32 to uint8", as[uint8](), uint32(255), uint8(255)},
+		{"uint32 beyond uint8", as[uint8](), uint32(256), nil},
+		{"int32 to uint16", as[uint16](), int32(65535), uint16(65535)},
+		{"negative int32 to uint", as[uint](), int32(-1), nil},
+		{"negative int64 to uint64", as[uint64](), int64(math.MinInt64), nil},
+		{"negative float64 to uint32", as[uint32](), -1.0, nil},
+		{"small negative float64 to uint32", as[uint32](), -0.5, uint32(0)},
+		{"float64 beyond uint32", as[uint32](), 4294967296.0, nil},
+		{"NaN to int32", as[int32](), math.NaN(), nil},
+		{"+Inf to int64", as[int64](), math.Inf(1), nil},
+		{"-Inf to int64", as[int64](), math.Inf(-1), nil},
+		{"NaN to uint64", as[uint64](), math.NaN(), nil},
+		{"+Inf to uint8", as[uint8](), math.Inf(1), nil},
+		{"2^63 to int64", as[int64](), math.Pow(2, 63), nil},
+		{"+Inf to float64", as[float64](), math.Inf(1), math.Inf(1)},
+		{"bool to int", as[int](), true, 1},
+		{"bool to float64", as[float64](), false, 0.0},
+		{"float64 to bool", as[bool](), 0.25, true},
+		{"zero to bool", as[bool](), int32(0), false},
+		{"string to string", as[string](), "Cessna Skyhawk", "Cessna Skyhawk"},
+		{"string to float64", as[float64](), "1.5", nil},
+		{"float64 to string", as[string](), 1.5, nil},
+		{"string to bool", as[bool](), "true", nil},
+		{"bytes to string", as[string](), []byte("TITLE"), nil},
+		{"nil", as[float64](), nil, nil},
+	}
+	for _, test := range tests {
+		got, err := test.convert(test.value)
+		if test.want == nil {
+			if !errors.Is(err, simconnect.ErrTypeMismatch) {
+				t.Errorf("%s: got %v (%v), want ErrTypeMismatch", test.name, got, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: %v", test.name, err)
+		} else if got != test.want {
+			t.Errorf("%s: got %T(%v), want %T(%v)", test.name, got, got, test.want, test.want)
+		}
+	}
+}
+
+func TestConvertNamedTypes(t *testing.T) {
+	type feet float64
+	type label string
+	if got, err := simconnect.Convert[feet](int32(1500)); got != 1500 || err != nil {
+		t.Errorf("feet: got %v, %v", got, err)
+	}
+	if got, err := simconnect.Convert[label]("N172SP"); got != "N172SP" || err != nil {
+		t.Errorf("label: got %q, %v", got, err)
+	}
+}
+
+func TestGet(t *testing.T) {
+	mate, sim := openMate(t)
+	sim.SetSimVar("PLANE ALTITUDE", 1234.5)
+	sim.SetSimVar("TITLE", "Cessna Skyhawk")
+	altitude := mate.AddSimVar("PLANE ALTITUDE", "feet", simconnect.DataTypeFloat64)
+	title := mate.AddSimVar("TITLE", "", simconnect.DataTypeString256)
+
+	if _, err := simconnect.Get[float64](mate, altitude); !errors.Is(err, simconnect.ErrNoValue) {
+		t.Errorf("before the first update: got %v, want ErrNoValue", err)
+	}
+	if _, err := simconnect.Get[float64](mate, 999); !errors.Is(err, simconnect.ErrUnknownSimVar) {
+		t.Errorf("unknown define ID: got %v, want ErrUnknownSimVar", err)
+	}
+
+	runMate(t, mate, &simconnect.EventListener{})
+	eventually(t, "the SimVar values", func() bool {
+		_, err1 := simconnect.Get[float64](mate, altitude)
+		_, err2 := simconnect.Get[string](mate, title)
+		return err1 == nil && err2 == nil
+	})
+	if got, err := simconnect.Get[int32](mate, altitude); got != 1234 || err != nil {
+		t.Errorf("altitude as int32: got %v, %v", got, err)
+	}
+	if got, err := simconnect.Get[uint8](mate, altitude); !errors.Is(err, simconnect.ErrTypeMismatch) {
+		t.Errorf("altitude as uint8: got %v, %v", got, err)
+	}
+	if got, err := simconnect.Get[string](mate, title); got != "Cessna Skyhawk" || err != nil {
+		t.Errorf("title: got %q, %v", got, err)
+	}
+	if _, err := simconnect.Get[float64](mate, title); !errors.Is(err, simconnect.ErrTypeMismatch) {
+		t.Errorf("title as float64: got %v, want ErrTypeMismatch", err)
+	}
+}
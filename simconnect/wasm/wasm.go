@@ -0,0 +1,381 @@
+// Package wasm reaches what SimConnect cannot: local (L:) variables, H: events and calculator code.
+// It talks to a WASM module running in the simulator over client data areas, using the channel layout of the
+// MobiFlight WASM module, so it works with the module shipped with MobiFlight and with every module speaking its protocol.
+//
+// Each client of the module has three areas. It writes commands as strings to <Client>.Command, the module answers
+// in <Client>.Response, and it writes the value of every registered variable to <Client>.LVars, as a float32 at
+// 4 times the index of the variable. The areas of DefaultClient always exist; other clients register over them first,
+// so that several programs can use the module at the same time without stepping on each other's variables.
+package wasm
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/grumpypixel/msfs2020-simconnect-go/simconnect"
+)
+
+type DWord = simconnect.DWord
+
+const (
+	DefaultClient = "MobiFlight" // the client whose areas the module creates on startup
+	MessageSize   = 1024         // the size of the command and response areas
+	MaxVariables  = int(simconnect.ClientDataMaxSize / 4)
+)
+
+// The commands and responses of the protocol.
+const (
+	CommandPing       = "MF.Ping"
+	ResponsePong      = "MF.Pong"
+	CommandDummy      = "MF.DummyCmd"      // does nothing, written after every command so that the next one is a change
+	CommandAddClient  = "MF.Clients.Add."  // followed by the client name, answered with the command and ".Finished"
+	CommandListLVars  = "MF.LVars.List"    // answered with ResponseListStart, one response per name and ResponseListEnd
+	CommandAddVar     = "MF.SimVars.Add."  // followed by the calculator code whose value is written to the LVars area
+	CommandClearVars  = "MF.SimVars.Clear" // forgets all variables of the client
+	CommandExecute    = "MF.SimVars.Set."  // followed by the calculator code to execute
+	ResponseFinished  = ".Finished"
+	ResponseListStart = "MF.LVars.List.Start"
+	ResponseListEnd   = "MF.LVars.List.End"
+)
+
+var (
+	ErrMessageTooLong   = errors.New("wasm: message too long")
+	ErrTooManyVariables = errors.New("wasm: too many variables")
+)
+
+// Message is the layout of the command and response areas: a null-terminated string.
+type Message struct {
+	Text [MessageSize]byte
+}
+
+// NewMessage returns text as a Message. Text which does not fit is truncated.
+func NewMessage(text string) Message {
+	var msg Message
+	copy(msg.Text[:MessageSize-1], text)
+	return msg
+}
+
+func (msg Message) String() string {
+	text := msg.Text[:]
+	if i := strings.IndexByte(string(text), 0); i >= 0 {
+		text = text[:i]
+	}
+	return string(text)
+}
+
+// Bridge is a client of the WASM module.
+type Bridge struct {
+	Client string
+
+	simco    *simconnect.SimConnect
+	command  *simconnect.ClientDataArea[Message]
+	response *simconnect.ClientDataArea[Message]
+	lvarsID  DWord // SIMCONNECT_CLIENT_DATA_ID of the LVars area, 0 while it is not mapped
+
+	sendLock  sync.Mutex // keeps a command and the dummy command which follows it together
+	watchLock sync.Mutex // serializes Watch and ClearVariables, so that indices match the module's
+	lock      sync.Mutex
+	waiters   []*waiter
+	variables []*Variable
+}
+
+// Variable is a value the module reads with calculator code and reports whenever it changes.
+type Variable struct {
+	Code  string // e.g. "(L:A32NX_ENGINE_N1:1)"
+	Index int    // the index of the variable in the LVars area
+
+	defineID  DWord
+	requestID DWord
+	lock      sync.Mutex
+	value     float64
+	onChange  func(value float64)
+}
+
+// Value returns the last value reported by the module, 0 before the first report.
+func (v *Variable) Value() float64 {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	return v.value
+}
+
+type waiter struct {
+	collect func(response string) bool // returns true once it has seen the last response it needs
+	done    chan struct{}
+}
+
+// Open connects to the WASM module as client, DefaultClient if it is empty. Clients other than DefaultClient are
+// registered with the module first. Open returns once the module has answered a ping.
+//
+// Open and the calls which wait for the module (Ping, ListLVars) need messages to be dispatched while they wait:
+// by Run, RunSession or SimMate.Run, or otherwise by the calls themselves, see SimConnect.Wait.
+// Without a deadline on ctx, they give up after simconnect.QueryTimeout.
+func Open(ctx context.Context, simco *simconnect.SimConnect, client string) (*Bridge, error) {
+	if client == "" {
+		client = DefaultClient
+	}
+	ctx, cancel := withTimeout(ctx)
+	defer cancel()
+
+	if client != DefaultClient {
+		registrar, err := attach(simco, DefaultClient)
+		if err != nil {
+			return nil, err
+		}
+		added := CommandAddClient + client
+		err = registrar.call(ctx, added, func(response string) bool {
+			return response == added+ResponseFinished
+		})
+		registrar.close()
+		if err != nil {
+			return nil, fmt.Errorf("wasm: registering client %s: %w", client, err)
+		}
+	}
+
+	bridge, err := attach(simco, client)
+	if err != nil {
+		return nil, err
+	}
+	bridge.lvarsID = simco.IDs().ClientData.New()
+	if err := simco.MapClientDataNameToID(client+".LVars", bridge.lvarsID); err != nil {
+		bridge.close()
+		return nil, err
+	}
+	if err := bridge.Ping(ctx); err != nil {
+		bridge.close()
+		return nil, err
+	}
+	// The variables of an earlier connection are of no use, and their indices would not match.
+	if err := bridge.send(CommandClearVars); err != nil {
+		bridge.close()
+		return nil, err
+	}
+	return bridge, nil
+}
+
+// attach attaches to the command and response areas of client and subscribes to the responses.
+func attach(simco *simconnect.SimConnect, client string) (*Bridge, error) {
+	bridge := &Bridge{Client: client, simco: simco}
+	var err error
+	if bridge.command, err = simconnect.AttachClientDataArea[Message](simco, client+".Command"); err != nil {
+		return nil, err
+	}
+	if bridge.response, err = simconnect.AttachClientDataArea[Message](simco, client+".Response"); err != nil {
+		bridge.command.Close()
+		return nil, err
+	}
+	if err := bridge.response.Subscribe(simconnect.ClientDataPeriodOnSet, simconnect.ClientDataRequestFlagDefault, bridge.receive); err != nil {
+		bridge.close()
+		return nil, err
+	}
+	return bridge, nil
+}
+
+// Ping checks that the module answers.
+func (bridge *Bridge) Ping(ctx context.Context) error {
+	return bridge.call(ctx, CommandPing, func(response string) bool {
+		return response == ResponsePong
+	})
+}
+
+// ListLVars returns the names of all local variables known to the simulator.
+func (bridge *Bridge) ListLVars(ctx context.Context) ([]string, error) {
+	var names []string
+	started := false
+	err := bridge.call(ctx, CommandListLVars, func(response string) bool {
+		switch {
+		case response == ResponseListStart:
+			started = true
+			names = nil
+		case response == ResponseListEnd:
+			return started
+		case started:
+			names = append(names, response)
+		}
+		return false
+	})
+	return names, err
+}
+
+// WatchLVar is Watch for the local variable name, e.g. "A32NX_ENGINE_N1:1".
+func (bridge *Bridge) WatchLVar(name string, onChange func(value float64)) (*Variable, error) {
+	return bridge.Watch("(L:"+name+")", onChange)
+}
+
+// Watch registers calculator code with the module, which evaluates it every frame and reports the result whenever
+// it changes. onChange may be nil; otherwise it is called with every new value on the goroutine which dispatches messages.
+func (bridge *Bridge) Watch(code string, onChange func(value float64)) (*Variable, error) {
+	bridge.watchLock.Lock()
+	defer bridge.watchLock.Unlock()
+
+	bridge.lock.Lock()
+	index := len(bridge.variables)
+	bridge.lock.Unlock()
+	if index >= MaxVariables {
+		return nil, fmt.Errorf("%w: %d", ErrTooManyVariables, index)
+	}
+
+	v := &Variable{
+		Code:     code,
+		Index:    index,
+		defineID: bridge.simco.IDs().ClientDataDefine.New(),
+		onChange: onChange,
+	}
+	if err := bridge.simco.AddToClientDataDefinition(v.defineID, DWord(4*index), simconnect.ClientDataTypeFloat32); err != nil {
+		bridge.simco.IDs().ClientDataDefine.Release(v.defineID)
+		return nil, err
+	}
+	requestID, err := bridge.simco.SubscribeClientData(bridge.lvarsID, v.defineID,
+		simconnect.ClientDataPeriodOnSet, simconnect.ClientDataRequestFlagChanged, v.receive)
+	if err != nil {
+		bridge.forget(v)
+		return nil, err
+	}
+	v.requestID = requestID
+	if err := bridge.send(CommandAddVar + code); err != nil {
+		bridge.forget(v)
+		return nil, err
+	}
+
+	bridge.lock.Lock()
+	bridge.variables = append(bridge.variables, v)
+	bridge.lock.Unlock()
+	return v, nil
+}
+
+// ClearVariables makes the module forget all variables registered with Watch.
+func (bridge *Bridge) ClearVariables() error {
+	bridge.watchLock.Lock()
+	defer bridge.watchLock.Unlock()
+
+	bridge.lock.Lock()
+	variables := bridge.variables
+	bridge.variables = nil
+	bridge.lock.Unlock()
+
+	err := bridge.send(CommandClearVars)
+	for _, v := range variables {
+		bridge.forget(v)
+	}
+	return err
+}
+
+// SetLVar sets a local variable.
+func (bridge *Bridge) SetLVar(name string, value float64) error {
+	return bridge.Execute(strconv.FormatFloat(value, 'f', -1, 64) + " (>L:" + name + ")")
+}
+
+// FireHEvent triggers an H: event, e.g. "A320_Neo_MFD_BTN_CSTR_1".
+func (bridge *Bridge) FireHEvent(name string) error {
+	return bridge.Execute("(>H:" + name + ")")
+}
+
+// Execute has the module execute calculator code, e.g. "1 (>K:TOGGLE_BEACON_LIGHTS)".
+func (bridge *Bridge) Execute(code string) error {
+	return bridge.send(CommandExecute + code)
+}
+
+// Close clears the variables and detaches from the areas of the client.
+func (bridge *Bridge) Close() error {
+	err := bridge.ClearVariables()
+	bridge.close()
+	return err
+}
+
+func (bridge *Bridge) close() {
+	bridge.response.Close()
+	bridge.command.Close()
+}
+
+// send writes a command to the command area. The module only reacts to changes of the area,
+// so a dummy command follows, which lets the same command be sent twice in a row.
+func (bridge *Bridge) send(command string) error {
+	if len(command) >= MessageSize {
+		return fmt.Errorf("%w: %d bytes", ErrMessageTooLong, len(command))
+	}
+	bridge.sendLock.Lock()
+	defer bridge.sendLock.Unlock()
+	if err := bridge.command.Publish(NewMessage(command)); err != nil {
+		return err
+	}
+	return bridge.command.Publish(NewMessage(CommandDummy))
+}
+
+// call sends a command and waits until collect has seen all responses to it.
+func (bridge *Bridge) call(ctx context.Context, command string, collect func(response string) bool) error {
+	ctx, cancel := withTimeout(ctx)
+	defer cancel()
+
+	w := &waiter{collect: collect, done: make(chan struct{})}
+	bridge.lock.Lock()
+	bridge.waiters = append(bridge.waiters, w)
+	bridge.lock.Unlock()
+
+	err := bridge.send(command)
+	if err == nil {
+		err = bridge.simco.Wait(ctx, w.done)
+	}
+	if err != nil {
+		bridge.lock.Lock()
+		for i, other := range bridge.waiters {
+			if other == w {
+				bridge.waiters = append(bridge.waiters[:i], bridge.waiters[i+1:]...)
+				break
+			}
+		}
+		bridge.lock.Unlock()
+		return fmt.Errorf("wasm: %s: %w", command, err)
+	}
+	return nil
+}
+
+// receive hands a response to the waiters.
+func (bridge *Bridge) receive(msg Message) {
+	response := msg.String()
+	bridge.lock.Lock()
+	defer bridge.lock.Unlock()
+	waiters := bridge.waiters[:0]
+	for _, w := range bridge.waiters {
+		if w.collect(response) {
+			close(w.done)
+			continue
+		}
+		waiters = append(waiters, w)
+	}
+	bridge.waiters = waiters
+}
+
+// forget drops the subscription and the definition of a variable.
+func (bridge *Bridge) forget(v *Variable) {
+	if v.requestID != 0 {
+		bridge.simco.UnsubscribeClientData(bridge.lvarsID, v.requestID, v.defineID)
+	}
+	bridge.simco.ClearClientDataDefinition(v.defineID)
+	bridge.simco.IDs().ClientDataDefine.Release(v.defineID)
+}
+
+func (v *Variable) receive(msg *simconnect.ClientDataMessage) {
+	if msg.DefineCount != 1 || len(msg.Data) < 4 {
+		return
+	}
+	value := float64(math.Float32frombits(binary.LittleEndian.Uint32(msg.Data)))
+	v.lock.Lock()
+	v.value = value
+	onChange := v.onChange
+	v.lock.Unlock()
+	if onChange != nil {
+		onChange(value)
+	}
+}
+
+func withTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if _, ok := ctx.Deadline(); ok || simconnect.QueryTimeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, simconnect.QueryTimeout)
+}
@@ -0,0 +1,203 @@
+package wasm_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/grumpypixel/msfs2020-simconnect-go/simconnect"
+	"github.com/grumpypixel/msfs2020-simconnect-go/simconnect/simconnecttest"
+	"github.com/grumpypixel/msfs2020-simconnect-go/simconnect/wasm"
+	"github.com/grumpypixel/msfs2020-simconnect-go/simconnect/wasm/wasmtest"
+)
+
+const testTimeout = 2 * time.Second
+
+// openSim connects to a fake simulator running the fake module, and dispatches its messages until the test ends.
+func openSim(t *testing.T) (*simconnect.SimConnect, *simconnecttest.Sim, *wasmtest.Module) {
+	t.Helper()
+	sim := simconnecttest.NewSim()
+	module := wasmtest.NewModule(sim)
+	simco := simconnect.NewSimConnectWithTransport(sim)
+	if err := simco.Open("WASM Test"); err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		simco.Run(ctx, nil)
+	}()
+	t.Cleanup(func() {
+		cancel()
+		<-done
+		simco.Close()
+	})
+	return simco, sim, module
+}
+
+func openBridge(t *testing.T, simco *simconnect.SimConnect, client string) *wasm.Bridge {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
+	defer cancel()
+	bridge, err := wasm.Open(ctx, simco, client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return bridge
+}
+
+// eventually fails the test unless condition holds within testTimeout.
+func eventually(t *testing.T, what string, condition func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(testTimeout)
+	for !condition() {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for %s", what)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestOpen(t *testing.T) {
+	simco, _, module := openSim(t)
+	bridge := openBridge(t, simco, "")
+	if bridge.Client != wasm.DefaultClient {
+		t.Errorf("client: got %q", bridge.Client)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
+	defer cancel()
+	if err := bridge.Ping(ctx); err != nil {
+		t.Errorf("Ping: %v", err)
+	}
+
+	other := openBridge(t, simco, "Other")
+	if other.Client != "Other" {
+		t.Errorf("client: got %q", other.Client)
+	}
+	if got := module.Clients(); !reflect.DeepEqual(got, []string{wasm.DefaultClient, "Other"}) {
+		t.Errorf("clients: got %v", got)
+	}
+	if err := other.Ping(ctx); err != nil {
+		t.Errorf("Ping of the registered client: %v", err)
+	}
+}
+
+func TestOpenWithoutModule(t *testing.T) {
+	simco, sim, _ := openSim(t)
+	sim.OnClientData(wasm.DefaultClient+".Command", nil) // the module does not answer
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if _, err := wasm.Open(ctx, simco, ""); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("got %v, want context.DeadlineExceeded", err)
+	}
+}
+
+func TestListLVars(t *testing.T) {
+	simco, _, module := openSim(t)
+	module.SetLVar("A32NX_ENGINE_N1:1", 20)
+	module.SetLVar("A32NX_ENGINE_N1:2", 21)
+	bridge := openBridge(t, simco, "")
+
+	ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
+	defer cancel()
+	names, err := bridge.ListLVars(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"A32NX_ENGINE_N1:1", "A32NX_ENGINE_N1:2"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("got %v, want %v", names, want)
+	}
+}
+
+func TestWatch(t *testing.T) {
+	simco, sim, module := openSim(t)
+	module.SetLVar("A32NX_AUTOBRAKES_ARMED_MODE", 1)
+	sim.SetSimVar("PLANE ALTITUDE", 1000.0)
+	bridge := openBridge(t, simco, "")
+
+	changes := make(chan float64, 16)
+	mode, err := bridge.WatchLVar("A32NX_AUTOBRAKES_ARMED_MODE", func(value float64) { changes <- value })
+	if err != nil {
+		t.Fatal(err)
+	}
+	altitude, err := bridge.Watch("(A:PLANE ALTITUDE, feet) 2 *", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mode.Index != 0 || altitude.Index != 1 {
+		t.Errorf("indices: got %d and %d", mode.Index, altitude.Index)
+	}
+	eventually(t, "the values", func() bool {
+		return mode.Value() == 1 && altitude.Value() == 2000
+	})
+
+	// The value makes the round trip through the module.
+	if err := bridge.SetLVar("A32NX_AUTOBRAKES_ARMED_MODE", 3); err != nil {
+		t.Fatal(err)
+	}
+	eventually(t, "the new value", func() bool { return mode.Value() == 3 })
+	if value, _ := module.LVar("A32NX_AUTOBRAKES_ARMED_MODE"); value != 3 {
+		t.Errorf("module: got %v", value)
+	}
+	module.SetLVar("A32NX_AUTOBRAKES_ARMED_MODE", 2)
+	eventually(t, "the value set by the aircraft", func() bool { return mode.Value() == 2 })
+	var got []float64
+	for len(got) < 3 {
+		select {
+		case value := <-changes:
+			got = append(got, value)
+		case <-time.After(testTimeout):
+			t.Fatalf("changes: got %v", got)
+		}
+	}
+	if want := []float64{1, 3, 2}; !reflect.DeepEqual(got, want) {
+		t.Errorf("changes: got %v, want %v", got, want)
+	}
+}
+
+func TestFireHEvent(t *testing.T) {
+	simco, _, module := openSim(t)
+	bridge := openBridge(t, simco, "")
+	// The same command twice in a row reaches the module twice.
+	for i := 0; i < 2; i++ {
+		if err := bridge.FireHEvent("A320_Neo_MFD_BTN_CSTR_1"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	eventually(t, "the H: events", func() bool { return len(module.HEvents()) == 2 })
+	if got := module.HEvents(); got[0] != "A320_Neo_MFD_BTN_CSTR_1" || got[1] != got[0] {
+		t.Errorf("got %v", got)
+	}
+}
+
+func TestClearVariables(t *testing.T) {
+	simco, _, module := openSim(t)
+	bridge := openBridge(t, simco, "Other")
+	if _, err := bridge.WatchLVar("A32NX_A", nil); err != nil {
+		t.Fatal(err)
+	}
+	eventually(t, "the variable", func() bool { return len(module.Variables("Other")) == 1 })
+	if err := bridge.ClearVariables(); err != nil {
+		t.Fatal(err)
+	}
+	eventually(t, "the variables to be cleared", func() bool { return len(module.Variables("Other")) == 0 })
+
+	// Indices start anew after clearing.
+	v, err := bridge.WatchLVar("A32NX_B", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v.Index != 0 {
+		t.Errorf("index: got %d", v.Index)
+	}
+	eventually(t, "the new variable", func() bool {
+		return reflect.DeepEqual(module.Variables("Other"), []string{"(L:A32NX_B)"})
+	})
+	if err := bridge.Close(); err != nil {
+		t.Fatal(err)
+	}
+	eventually(t, "Close to clear the variables", func() bool { return len(module.Variables("Other")) == 0 })
+}
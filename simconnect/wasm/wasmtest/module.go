@@ -0,0 +1,289 @@
+// Package wasmtest provides a fake of the MobiFlight WASM module for simconnecttest.Sim.
+//
+// A Module creates the client data areas of wasm.DefaultClient in the Sim and answers the commands written to them,
+// just as the module in the simulator would. It keeps its own local variables, records fired H: events and
+// evaluates a small subset of calculator code: numbers, + - * /, (L:name), (>L:name), (A:name, unit) read from
+// the simulation variables of the Sim, and (>H:name). Everything else is ignored.
+package wasmtest
+
+import (
+	"encoding/binary"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/grumpypixel/msfs2020-simconnect-go/simconnect"
+	"github.com/grumpypixel/msfs2020-simconnect-go/simconnect/simconnecttest"
+	"github.com/grumpypixel/msfs2020-simconnect-go/simconnect/wasm"
+)
+
+// Module is a fake WASM module.
+type Module struct {
+	sim *simconnecttest.Sim
+
+	lock      sync.Mutex
+	clients   map[string]*client
+	lvars     map[string]float64
+	lvarNames []string // in the order the variables were created
+	hEvents   []string
+	executed  []string
+}
+
+// client is a client registered with the module, and the variables it reads.
+type client struct {
+	name    string
+	last    string // the last command, which the module ignores when it is written again
+	vars    []string
+	values  []float32
+	written []bool
+}
+
+// NewModule creates the areas of wasm.DefaultClient in sim and starts answering commands.
+func NewModule(sim *simconnecttest.Sim) *Module {
+	module := &Module{
+		sim:     sim,
+		clients: make(map[string]*client),
+		lvars:   make(map[string]float64),
+	}
+	module.addClient(wasm.DefaultClient)
+	return module
+}
+
+// SetLVar sets a local variable as the aircraft would, and reports it to the clients reading it.
+func (module *Module) SetLVar(name string, value float64) {
+	module.lock.Lock()
+	defer module.lock.Unlock()
+	module.setLVar(name, value)
+	module.update()
+}
+
+// LVar returns the value of a local variable.
+func (module *Module) LVar(name string) (float64, bool) {
+	module.lock.Lock()
+	defer module.lock.Unlock()
+	value, ok := module.lvars[name]
+	return value, ok
+}
+
+// HEvents returns the H: events fired so far, in order.
+func (module *Module) HEvents() []string {
+	module.lock.Lock()
+	defer module.lock.Unlock()
+	return append([]string(nil), module.hEvents...)
+}
+
+// Executed returns the calculator code executed with wasm.CommandExecute so far, in order.
+func (module *Module) Executed() []string {
+	module.lock.Lock()
+	defer module.lock.Unlock()
+	return append([]string(nil), module.executed...)
+}
+
+// Variables returns the calculator code registered by the named client, in the order of the LVars area.
+func (module *Module) Variables(clientName string) []string {
+	module.lock.Lock()
+	defer module.lock.Unlock()
+	c, ok := module.clients[clientName]
+	if !ok {
+		return nil
+	}
+	return append([]string(nil), c.vars...)
+}
+
+// Clients returns the names of the registered clients, wasm.DefaultClient included.
+func (module *Module) Clients() []string {
+	module.lock.Lock()
+	defer module.lock.Unlock()
+	names := make([]string, 0, len(module.clients))
+	for name := range module.clients {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (module *Module) addClient(name string) {
+	c := &client{name: name}
+	module.clients[name] = c
+	module.sim.CreateClientData(name+".Command", wasm.MessageSize)
+	module.sim.CreateClientData(name+".Response", wasm.MessageSize)
+	module.sim.CreateClientData(name+".LVars", int(simconnect.ClientDataMaxSize))
+	module.sim.OnClientData(name+".Command", func(data []byte) {
+		var msg wasm.Message
+		copy(msg.Text[:], data)
+		module.command(c, msg.String())
+	})
+}
+
+func (module *Module) command(c *client, command string) {
+	module.lock.Lock()
+	defer module.lock.Unlock()
+	if command == c.last {
+		return
+	}
+	c.last = command
+
+	switch {
+	case command == wasm.CommandPing:
+		module.respond(c, wasm.ResponsePong)
+
+	case command == wasm.CommandListLVars:
+		module.respond(c, wasm.ResponseListStart)
+		for _, name := range module.lvarNames {
+			module.respond(c, name)
+		}
+		module.respond(c, wasm.ResponseListEnd)
+
+	case strings.HasPrefix(command, wasm.CommandAddClient):
+		name := strings.TrimPrefix(command, wasm.CommandAddClient)
+		if _, ok := module.clients[name]; !ok {
+			module.addClient(name)
+		}
+		module.respond(c, command+wasm.ResponseFinished)
+
+	case strings.HasPrefix(command, wasm.CommandAddVar):
+		c.vars = append(c.vars, strings.TrimPrefix(command, wasm.CommandAddVar))
+		c.values = append(c.values, 0)
+		c.written = append(c.written, false)
+		module.update()
+
+	case command == wasm.CommandClearVars:
+		c.vars, c.values, c.written = nil, nil, nil
+
+	case strings.HasPrefix(command, wasm.CommandExecute):
+		code := strings.TrimPrefix(command, wasm.CommandExecute)
+		module.executed = append(module.executed, code)
+		module.eval(code)
+		module.update()
+	}
+}
+
+func (module *Module) respond(c *client, response string) {
+	msg := wasm.NewMessage(response)
+	module.sim.WriteClientData(c.name+".Response", 0, msg.Text[:])
+}
+
+// update writes the variables whose value has changed to the LVars areas.
+func (module *Module) update() {
+	for _, c := range module.clients {
+		for i, code := range c.vars {
+			value := float32(module.eval(code))
+			if c.written[i] && c.values[i] == value {
+				continue
+			}
+			if 4*i+4 > int(simconnect.ClientDataMaxSize) {
+				break
+			}
+			c.values[i], c.written[i] = value, true
+			data := make([]byte, 4)
+			binary.LittleEndian.PutUint32(data, math.Float32bits(value))
+			module.sim.WriteClientData(c.name+".LVars", 4*i, data)
+		}
+	}
+}
+
+// eval evaluates calculator code and returns the value left on top of the stack, 0 if there is none.
+func (module *Module) eval(code string) float64 {
+	var stack []float64
+	push := func(value float64) {
+		stack = append(stack, value)
+	}
+	pop := func() float64 {
+		if len(stack) == 0 {
+			return 0
+		}
+		value := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		return value
+	}
+
+	for _, token := range tokenize(code) {
+		if value, err := strconv.ParseFloat(token, 64); err == nil {
+			push(value)
+			continue
+		}
+		switch token {
+		case "+":
+			b, a := pop(), pop()
+			push(a + b)
+			continue
+		case "-":
+			b, a := pop(), pop()
+			push(a - b)
+			continue
+		case "*":
+			b, a := pop(), pop()
+			push(a * b)
+			continue
+		case "/":
+			b, a := pop(), pop()
+			if b == 0 {
+				push(0)
+			} else {
+				push(a / b)
+			}
+			continue
+		}
+		if !strings.HasPrefix(token, "(") {
+			continue
+		}
+
+		variable := strings.TrimSuffix(token[1:], ")")
+		write := strings.HasPrefix(variable, ">")
+		variable = strings.TrimPrefix(variable, ">")
+		kind, name, ok := strings.Cut(variable, ":")
+		if !ok {
+			continue
+		}
+		name, _, _ = strings.Cut(name, ",")
+		name = strings.TrimSpace(name)
+		switch {
+		case kind == "L" && write:
+			module.setLVar(name, pop())
+		case kind == "L":
+			push(module.lvars[name])
+		case kind == "A" && !write:
+			value, _ := module.sim.SimVar(name)
+			number, _ := simconnect.Convert[float64](value)
+			push(number)
+		case kind == "H" && write:
+			module.hEvents = append(module.hEvents, name)
+		}
+	}
+	return pop()
+}
+
+func (module *Module) setLVar(name string, value float64) {
+	if _, ok := module.lvars[name]; !ok {
+		module.lvarNames = append(module.lvarNames, name)
+	}
+	module.lvars[name] = value
+}
+
+// tokenize splits calculator code into numbers, operators and parenthesized variables.
+func tokenize(code string) []string {
+	var tokens []string
+	for i := 0; i < len(code); {
+		switch code[i] {
+		case ' ', '\t', '\r', '\n':
+			i++
+		case '(':
+			end := strings.IndexByte(code[i:], ')')
+			if end < 0 {
+				end = len(code) - i - 1
+			}
+			tokens = append(tokens, code[i:i+end+1])
+			i += end + 1
+		default:
+			end := strings.IndexAny(code[i:], " \t\r\n(")
+			if end < 0 {
+				end = len(code) - i
+			}
+			tokens = append(tokens, code[i:i+end])
+			i += end
+		}
+	}
+	return tokens
+}
@@ -0,0 +1,117 @@
+package simconnect
+
+import (
+	"math"
+	"reflect"
+)
+
+// WatchFunc is called with the SimVar as it was at the previous call (or with a zero SimVar on the first call) and as it is now.
+type WatchFunc func(old, new SimVar)
+
+// WatchOption configures a watch, see Watch.
+type WatchOption func(w *watcher)
+
+// WithEpsilon is passed on to AddToDataDefinition: the simulator only reports changes larger than epsilon.
+// This requires a Subscription with DataRequestFlagChanged; if several watches of a SimVar set an epsilon, the smallest one wins.
+func WithEpsilon(epsilon float32) WatchOption {
+	return func(w *watcher) {
+		w.epsilon = epsilon
+	}
+}
+
+// WithDeadband suppresses callbacks until a numeric value has moved more than deadband away from the value of the last callback.
+func WithDeadband(deadband float64) WatchOption {
+	return func(w *watcher) {
+		w.deadband = deadband
+	}
+}
+
+type watcher struct {
+	callback WatchFunc
+	epsilon  float32
+	deadband float64
+	last     SimVar
+}
+
+// Watch adds the SimVar (or reuses it if it is already known) and calls callback whenever its value changes.
+// Callbacks run on the goroutine of Run or HandleEvents. Watch returns the DefineID of the SimVar.
+func (mate *SimMate) Watch(name, unit string, dataType DWord, callback WatchFunc, options ...WatchOption) DWord {
+	w := &watcher{callback: callback}
+	for _, option := range options {
+		option(w)
+	}
+
+	defineID := mate.AddSimVar(name, unit, dataType)
+	if w.epsilon > 0 {
+		mate.setEpsilon(defineID, w.epsilon)
+	}
+
+	mate.watchLock.Lock()
+	defer mate.watchLock.Unlock()
+	if mate.watchers == nil {
+		mate.watchers = make(map[DWord][]*watcher)
+	}
+	mate.watchers[defineID] = append(mate.watchers[defineID], w)
+	return defineID
+}
+
+// Unwatch removes all watches of the SimVar. The SimVar itself is kept; use RemoveSimVar to drop it.
+func (mate *SimMate) Unwatch(defineID DWord) {
+	mate.watchLock.Lock()
+	defer mate.watchLock.Unlock()
+	delete(mate.watchers, defineID)
+}
+
+// setEpsilon lowers the epsilon of a SimVar. A SimVar which is already registered is defined anew with the next request tick.
+func (mate *SimMate) setEpsilon(defineID DWord, epsilon float32) {
+	mate.mutex.Lock()
+	defer mate.mutex.Unlock()
+
+	simVar, ok := mate.simVarManager.GetSimVar(defineID)
+	if !ok || (simVar.Epsilon > 0 && simVar.Epsilon <= epsilon) {
+		return
+	}
+	simVar.Epsilon = epsilon
+	if simVar.Registered {
+		mate.ClearDataDefinition(simVar.DefineID)
+		simVar.Registered = false
+		simVar.Subscribed = false
+		mate.dirty = true
+	}
+}
+
+func (mate *SimMate) notifyWatchers(defineID DWord) {
+	simVar, ok := mate.SimVar(defineID)
+	if !ok {
+		return
+	}
+
+	mate.watchLock.Lock()
+	var calls []func()
+	for _, w := range mate.watchers[defineID] {
+		if !w.changed(simVar) {
+			continue
+		}
+		callback, old := w.callback, w.last
+		w.last = simVar
+		calls = append(calls, func() { callback(old, simVar) })
+	}
+	mate.watchLock.Unlock()
+
+	for _, call := range calls {
+		call()
+	}
+}
+
+// changed reports whether value differs enough from the value of the last callback.
+func (w *watcher) changed(simVar SimVar) bool {
+	if w.last.Value == nil {
+		return simVar.Value != nil
+	}
+	last, lastErr := toFloat64(w.last.Value)
+	value, err := toFloat64(simVar.Value)
+	if lastErr == nil && err == nil {
+		return math.Abs(value-last) > w.deadband
+	}
+	return !reflect.DeepEqual(w.last.Value, simVar.Value)
+}
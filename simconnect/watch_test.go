@@ -0,0 +1,70 @@
+package simconnect_test
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/grumpypixel/msfs2020-simconnect-go/simconnect"
+)
+
+func TestWatchWhileRunning(t *testing.T) {
+	mate, sim := openMate(t)
+	sim.SetSimVar("PLANE ALTITUDE", 1000.0)
+	stop := runMate(t, mate, nil)
+
+	// Watches are added from several goroutines while Run registers and requests the SimVars.
+	const watches = 8
+	changes := make(chan float64, watches)
+	var wg sync.WaitGroup
+	for i := 0; i < watches; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			name, unit := "PLANE ALTITUDE", "feet"
+			if i > 0 {
+				name, unit = fmt.Sprintf("GENERAL ENG RPM:%d", i), "rpm"
+			}
+			mate.Watch(name, unit, simconnect.DataTypeFloat64, func(old, new simconnect.SimVar) {
+				if old.Value == nil && new.Name == "PLANE ALTITUDE" {
+					changes <- new.Value.(float64)
+				}
+			})
+		}(i)
+	}
+	wg.Wait()
+	if got := receive(t, changes); got != 1000 {
+		t.Errorf("first change: got %v", got)
+	}
+	eventually(t, "all SimVars to be registered", func() bool {
+		return len(sim.CallsTo("SimConnect_AddToDataDefinition")) == watches
+	})
+	stop()
+}
+
+func TestWatchDeadband(t *testing.T) {
+	mate, sim := openMate(t)
+	sim.SetSimVar("PLANE ALTITUDE", 1000.0)
+	changes := make(chan [2]interface{}, 16)
+	mate.Watch("PLANE ALTITUDE", "feet", simconnect.DataTypeFloat64, func(old, new simconnect.SimVar) {
+		changes <- [2]interface{}{old.Value, new.Value}
+	}, simconnect.WithDeadband(50))
+	mate.Subscribe(simconnect.Subscription{Period: simconnect.PeriodSimFrame})
+	stop := runMate(t, mate, nil)
+	eventually(t, "the subscription", func() bool {
+		return len(sim.CallsTo("SimConnect_RequestDataOnSimObject")) > 0
+	})
+
+	sim.Tick()
+	if got := receive(t, changes); got != [2]interface{}{nil, 1000.0} {
+		t.Errorf("first change: got %v", got)
+	}
+	sim.SetSimVar("PLANE ALTITUDE", 1040.0) // within the deadband
+	sim.Tick()
+	sim.SetSimVar("PLANE ALTITUDE", 1060.0)
+	sim.Tick()
+	if got := receive(t, changes); got != [2]interface{}{1000.0, 1060.0} {
+		t.Errorf("second change: got %v", got)
+	}
+	stop()
+}